@@ -2,25 +2,36 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 	"time"
+	// cron schedules need zone data even on images without /usr/share/zoneinfo
+	_ "time/tzdata"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
 	handlerHttp "github.com/RealEskalate/G6-NewsBrief/internal/handler/http"
 	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/config"
 	database "github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/database"
 	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/external_services"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/extractor"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/imageproxy"
 	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/jwt"
 	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/logger"
 	passwordservice "github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/password_service"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/prompts"
 	randomgenerator "github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/random_generator"
 	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/repository/mongodb"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/secretbox"
 	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/seeder"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/translation"
 	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/uuidgen"
 	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/validator"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/vectorindex"
 	"github.com/RealEskalate/G6-NewsBrief/internal/usecase"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
@@ -100,6 +111,7 @@ func main() {
 	sourceRepo := mongodb.NewSourceRepository(mongoClient.Client.Database(dbName).Collection("sources"))
 	bookmarkRepo := mongodb.NewBookmarkRepository(mongoClient.Client.Database(dbName))
 	analyticRepo := mongodb.NewAnalyticRepository(mongoClient.Client.Database(dbName).Collection("analytics"))
+	summaryRepo := mongodb.NewSummaryRepository(mongoClient.Client.Database(dbName).Collection("summaries"))
 
 	// -------------- initialize analytics document if not present --------------
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -128,9 +140,42 @@ func main() {
 	if summarizerAPI == "" {
 		log.Fatal("GEMINI_API_URL environment variable not set")
 	}
-	geminiClient := external_services.NewGeminiClient(GeminiAPIKey, summarizerAPI)
-	translatorClient := external_services.NewTranslatorClient()
+	// AI usage accounting and daily budgets, enforced by the Gemini and translation clients
+	usageRepo := mongodb.NewLLMUsageRepository(mongoClient.Client.Database(dbName).Collection("llm_usage"), mongoClient.Client.Database(dbName).Collection("llm_usage_daily"))
+	usageUC := usecase.NewUsageUsecase(usageRepo, uuidGenerator, usageBudgetFromEnv(), appLogger)
+	geminiClient := external_services.NewGeminiClient(GeminiAPIKey, summarizerAPI).WithMeter(usageUC).WithConcurrency(geminiMaxConcurrency())
+	revisionRepo := mongodb.NewRevisionRepository(mongoClient.Client.Database(dbName).Collection("news_revisions"))
+	translationMemoryRepo := mongodb.NewTranslationMemoryRepository(mongoClient.Client.Database(dbName).Collection("translation_memory"))
+	// Prompt templates: built-in defaults, overridable per version from the admin API
+	builtinPrompts, err := prompts.Defaults()
+	if err != nil {
+		log.Fatalf("prompts: %v", err)
+	}
+	// Safety guard: isolates scraped text in prompts, screens chat input and output
+	safetyEventRepo := mongodb.NewSafetyEventRepository(mongoClient.Client.Database(dbName).Collection("safety_events"))
+	safetyUC := usecase.NewSafetyUsecase(safetyEventRepo, uuidGenerator, appLogger)
+	promptRepo := mongodb.NewPromptRepository(mongoClient.Client.Database(dbName).Collection("prompt_templates"))
+	promptUC := usecase.NewPromptUsecase(promptRepo, builtinPrompts, uuidGenerator, safetyUC)
+	translatorClient, err := translation.NewFromEnv(geminiClient, promptUC, translationMemoryRepo, usageUC)
+	if err != nil {
+		log.Fatalf("translation backend: %v", err)
+	}
 	providerClient := external_services.NewNewsProviderClient()
+	// Vector index: Mongo-backed by default (Atlas $vectorSearch when ATLAS_VECTOR_INDEX is set),
+	// or purely in-process with VECTOR_INDEX=memory
+	var vectorIndex contract.IVectorIndex
+	if strings.ToLower(os.Getenv("VECTOR_INDEX")) == "memory" {
+		vectorIndex = vectorindex.NewMemoryIndex()
+	} else {
+		vectorIndex = mongodb.NewEmbeddingRepository(mongoClient.Client.Database(dbName).Collection("news_embeddings"), os.Getenv("ATLAS_VECTOR_INDEX"))
+	}
+	embeddingUC := usecase.NewEmbeddingUsecase(geminiClient, vectorIndex, newsRepo)
+	storyRepo := mongodb.NewStoryRepository(mongoClient.Client.Database(dbName).Collection("stories"))
+	storyUC := usecase.NewStoryUsecase(storyRepo, newsRepo, vectorIndex, geminiClient, promptUC, uuidGenerator)
+	namedEntityRepo := mongodb.NewNamedEntityRepository(mongoClient.Client.Database(dbName).Collection("entities"))
+	translationPipeline := usecase.NewTranslationPipeline(translatorClient, newsRepo, embeddingUC, revisionRepo, uuidGenerator)
+	editorialUC := usecase.NewEditorialUsecase(newsRepo, revisionRepo, translationMemoryRepo, embeddingUC, summaryRepo, uuidGenerator)
+	namedEntityUC := usecase.NewNamedEntityUsecase(namedEntityRepo, newsRepo, userRepo, geminiClient, promptUC, uuidGenerator)
 
 	// Dependency Injection: Usecases
 	emailUsecase := usecase.NewEmailVerificationUseCase(tokenRepo, userRepo, mailService, randomGenerator, uuidGenerator, appConfig)
@@ -138,28 +183,82 @@ func main() {
 	topicUsecase := usecase.NewTopicUsecase(topicRepo, analyticRepo)
 	sourceUsecase := usecase.NewSourceUsecase(sourceRepo, analyticRepo)
 	subscriptionUsecase := usecase.NewSubscriptionUsecase(userRepo, sourceRepo)
-	providerIngestionUC := usecase.NewProviderIngestionUsecase(providerClient, geminiClient, translatorClient, topicRepo, newsRepo, uuidGenerator, sourceRepo)
+	// Article images and source logos are fetched once into a disk cache and served resized
+	imageProxy, err := imageproxy.NewFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize image proxy: %v", err)
+	}
+	mediaUC := usecase.NewMediaUsecase(imageProxy, newsRepo, sourceRepo)
+	// dry-run ingestions wait here for an admin to approve their items
+	previewRepo := mongodb.NewIngestionPreviewRepository(mongoClient.Client.Database(dbName).Collection("ingestion_previews"))
+	providerIngestionUC := usecase.NewProviderIngestionUsecase(providerClient, geminiClient, translatorClient, topicRepo, newsRepo, uuidGenerator, sourceRepo, embeddingUC, storyUC, namedEntityUC, translationPipeline, promptUC, articleExtractorFromEnv(), mediaUC, previewRepo, ingestionOptionsFromEnv())
+	// Native feed ingestion: RSS/Atom/JSON feeds configured on sources, polled directly
+	feedStateRepo := mongodb.NewFeedStateRepository(mongoClient.Client.Database(dbName).Collection("feed_states"))
+	// machine credentials for integrations pushing articles; signed keys need WEBHOOK_SECRET_KEY
+	secretBox, err := secretbox.NewFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to initialize webhook secret box: %v", err)
+	}
+	apiKeyRepo := mongodb.NewAPIKeyRepository(mongoClient.Client.Database(dbName).Collection("api_keys"))
+	replayGuard := mongodb.NewReplayGuard(mongoClient.Client.Database(dbName).Collection("webhook_nonces"))
+	apiKeyUC := usecase.NewAPIKeyUsecase(apiKeyRepo, replayGuard, secretBox, sourceRepo, uuidGenerator, randomGenerator)
+	feedUC := usecase.NewFeedIngestionUsecase(external_services.NewFeedClient(), feedStateRepo, sourceRepo, providerIngestionUC, appLogger)
 	// Pass Prometheus metrics to handlers or usecases as needed (import from metrics package)
 
 	//---------------------- Admin seeder-------------------------------------
 	adminSeeder(userUsecase, userRepo)
 	//---------------------- end of admin seeder-------------------------------------
 
+	// Cron scheduler shared by all replicas; a lease on each job document lets one replica run each firing
+	schedulerLoc, err := time.LoadLocation(appConfig.GetSchedulerTimezone())
+	if err != nil {
+		log.Fatalf("Invalid SCHEDULER_TIMEZONE: %v", err)
+	}
+	jobRepo := mongodb.NewScheduledJobRepository(mongoClient.Client.Database(dbName).Collection("scheduled_jobs"))
+	replicaOwner := schedulerOwner(uuidGenerator)
+	scheduler := usecase.NewScheduler(jobRepo, appLogger, schedulerLoc, replicaOwner)
+	providerSyncRepo := mongodb.NewProviderSyncRepository(mongoClient.Client.Database(dbName).Collection("provider_sync"))
+	providerSyncUC := usecase.NewProviderSyncUsecase(providerClient, providerSyncRepo, providerIngestionUC, appLogger, strings.TrimSpace(os.Getenv("PROVIDER_INGEST_QUERY")))
+	// Source reliability scores from feed health, article quality and reader reports
+	newsReportRepo := mongodb.NewNewsReportRepository(mongoClient.Client.Database(dbName).Collection("news_reports"))
+	reliabilityUC := usecase.NewReliabilityUsecase(sourceRepo, newsRepo, feedStateRepo, newsReportRepo, uuidGenerator, appLogger)
+	// Bulk reprocessing of stored articles; jobs resume after a restart
+	reprocessJobRepo := mongodb.NewReprocessJobRepository(mongoClient.Client.Database(dbName).Collection("reprocess_jobs"))
+	reprocessUC := usecase.NewReprocessUsecase(reprocessJobRepo, newsRepo, summaryRepo, topicRepo, sourceRepo, geminiClient, promptUC, translationPipeline, embeddingUC, uuidGenerator, appLogger, replicaOwner)
+	registerJobs(scheduler, providerSyncUC, feedUC, reliabilityUC, reprocessUC, embeddingUC)
+
 	// Setup API routes
 	appRouter := handlerHttp.NewRouter(
 		userUsecase, emailUsecase,
 		userRepo, tokenRepo, analyticRepo, topicRepo, hasher, jwtService, mailService,
 		appLogger, appConfig, appValidator, uuidGenerator, randomGenerator, sourceUsecase, topicUsecase, subscriptionUsecase,
-		sourceRepo, newsRepo, bookmarkRepo, geminiClient,
+		sourceRepo, newsRepo, bookmarkRepo, summaryRepo, geminiClient, translatorClient, translationPipeline, embeddingUC, storyUC, namedEntityUC, editorialUC, usageUC, promptUC, safetyUC, feedUC, apiKeyUC, providerIngestionUC, scheduler, providerSyncUC, mediaUC, reliabilityUC, reprocessUC,
 	)
 
 	// Initialize Gin router
 	router := gin.Default()
+	// ClientIP keys anonymous AI budgets, so X-Forwarded-For is honoured only from known proxies
+	if err := router.SetTrustedProxies(trustedProxiesFromEnv()); err != nil {
+		log.Fatalf("TRUSTED_PROXIES: %v", err)
+	}
 
 	appRouter.SetupRoutes(router)
 
-	if enabled := strings.ToLower(os.Getenv("PROVIDER_INGEST_SCHEDULED")); enabled == "" || enabled == "true" {
-		go runDailyIngestion(providerIngestionUC, appLogger)
+	go scheduler.Start(context.Background())
+
+	// Background worker filling counterpart-language fields
+	translationInterval := 30 * time.Second
+	if d, err := time.ParseDuration(os.Getenv("TRANSLATION_WORKER_INTERVAL")); err == nil && d > 0 {
+		translationInterval = d
+	}
+	go runTranslationWorker(translationPipeline, appLogger, translationInterval)
+
+	// Embed news stored before embeddings were introduced; replicas starting
+	// together share the job lease, so only the first one runs it
+	if strings.ToLower(os.Getenv("EMBEDDINGS_BACKFILL_ON_START")) == "true" {
+		if _, err := scheduler.Trigger(context.Background(), contract.EmbeddingBackfillJob, "startup"); err != nil && !errors.Is(err, contract.ErrConflict) {
+			appLogger.Errorf("embedding backfill on start: %v", err)
+		}
 	}
 
 	// Start the server
@@ -174,32 +273,177 @@ func main() {
 
 }
 
-// runDailyIngestion schedules provider ingestion at fixed local times (07:00, 13:00, 19:00).
-func runDailyIngestion(uc contract.IProviderIngestionUsecase, logger contract.IAppLogger) {
-	times := []struct{ hour, min int }{{7, 0}, {13, 0}, {19, 0}}
-	scheduleNext := func(target time.Time) time.Duration { return time.Until(target) }
-	// Launch one goroutine per scheduled time
-	for _, t := range times {
-		go func(hour, minute int) {
-			for {
-				now := time.Now()
-				next := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, 0, 0, now.Location())
-				if !next.After(now) { // passed -> tomorrow
-					next = next.Add(24 * time.Hour)
-				}
-				d := scheduleNext(next)
-				timer := time.NewTimer(d)
-				<-timer.C
-				ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
-				start := time.Now()
-				ids, skipped, err := uc.IngestFromProvider(ctx, "general", 20)
-				cancel()
-				if err != nil {
-					logger.Errorf("scheduled provider ingestion failed: %v", err)
-				} else {
-					logger.Infof("scheduled provider ingestion at %02d:%02d done: ingested=%d skipped=%d duration=%s", hour, minute, len(ids), skipped, time.Since(start))
-				}
-			}
-		}(t.hour, t.min)
+// geminiMaxConcurrency reads GEMINI_MAX_CONCURRENCY (default 8, 0 for no bound).
+func geminiMaxConcurrency() int {
+	if v, err := strconv.Atoi(os.Getenv("GEMINI_MAX_CONCURRENCY")); err == nil && v >= 0 {
+		return v
+	}
+	return 8
+}
+
+// ingestionOptionsFromEnv reads INGESTION_WORKERS and INGESTION_ITEM_TIMEOUT;
+// unset values keep the usecase defaults.
+func ingestionOptionsFromEnv() usecase.IngestionOptions {
+	var opts usecase.IngestionOptions
+	if v, err := strconv.Atoi(os.Getenv("INGESTION_WORKERS")); err == nil && v > 0 {
+		opts.Workers = v
+	}
+	if d, err := time.ParseDuration(os.Getenv("INGESTION_ITEM_TIMEOUT")); err == nil && d > 0 {
+		opts.ItemTimeout = d
+	}
+	return opts
+}
+
+// articleExtractorFromEnv fetches full text for items without a body unless
+// ARTICLE_EXTRACTION_ENABLED=false. ARTICLE_HOST_INTERVAL is the least time
+// between two requests to one publisher (default 2s).
+func articleExtractorFromEnv() contract.IArticleExtractor {
+	if strings.ToLower(os.Getenv("ARTICLE_EXTRACTION_ENABLED")) == "false" {
+		return nil
+	}
+	interval := 2 * time.Second
+	if d, err := time.ParseDuration(os.Getenv("ARTICLE_HOST_INTERVAL")); err == nil && d >= 0 {
+		interval = d
+	}
+	return extractor.NewFetcher(interval)
+}
+
+// registerJobs adds the ingestion, scoring and maintenance jobs. Schedules are cron expressions in
+// SCHEDULER_TIMEZONE; a job disabled by its *_ENABLED/*_SCHEDULED flag is not
+// registered at all.
+func registerJobs(scheduler contract.IScheduler, provider contract.IProviderSyncUsecase, feeds contract.IFeedIngestionUsecase, reliability contract.IReliabilityUsecase, reprocess contract.IReprocessUsecase, embeddings contract.IEmbeddingService) {
+	enabled := func(key string) bool {
+		v := strings.ToLower(os.Getenv(key))
+		return v == "" || v == "true"
+	}
+	if enabled("PROVIDER_INGEST_SCHEDULED") {
+		mustRegister(scheduler, contract.JobSpec{
+			Name:        "provider_ingestion",
+			Schedule:    envOr("PROVIDER_INGEST_SCHEDULE", "0 7,13,19 * * *"),
+			Description: "Fetch provider items published since the sync cursor",
+			Timeout:     10 * time.Minute,
+			Run: func(ctx context.Context) (string, error) {
+				res, err := provider.Sync(ctx)
+				// articles finished before an error are already saved
+				return fmt.Sprintf("pages=%d fetched=%d ingested=%d skipped=%d caught_up=%t", res.Pages, res.Fetched, len(res.Ingested), res.Skipped, res.CaughtUp), err
+			},
+		})
+	}
+	if enabled("FEED_POLL_ENABLED") {
+		mustRegister(scheduler, contract.JobSpec{
+			Name:        "feed_poll",
+			Schedule:    envOr("FEED_POLL_SCHEDULE", "* * * * *"),
+			Description: "Poll source feeds whose interval has elapsed",
+			Timeout:     10 * time.Minute,
+			Run: func(ctx context.Context) (string, error) {
+				res, err := feeds.PollDue(ctx)
+				return fmt.Sprintf("feeds=%d not_modified=%d failed=%d ingested=%d skipped=%d", res.Feeds, res.NotModified, res.Failed, len(res.Ingested), res.Skipped), err
+			},
+		})
+	}
+	if enabled("SOURCE_RELIABILITY_ENABLED") {
+		mustRegister(scheduler, contract.JobSpec{
+			Name:        "source_reliability",
+			Schedule:    envOr("SOURCE_RELIABILITY_SCHEDULE", "30 3 * * *"),
+			Description: "Rescore source reliability from feed health, article quality and reader reports",
+			Timeout:     15 * time.Minute,
+			Run: func(ctx context.Context) (string, error) {
+				res, err := reliability.RecomputeAll(ctx)
+				return fmt.Sprintf("sources=%d scored=%d overridden=%d failed=%d", res.Sources, res.Scored, res.Overridden, res.Failed), err
+			},
+		})
+	}
+	if enabled("REPROCESS_RESUME_ENABLED") {
+		mustRegister(scheduler, contract.JobSpec{
+			Name:        "reprocess_resume",
+			Schedule:    envOr("REPROCESS_RESUME_SCHEDULE", "*/5 * * * *"),
+			Description: "Restart reprocessing jobs left running by a replica that stopped",
+			Timeout:     time.Minute,
+			Run: func(ctx context.Context) (string, error) {
+				n, err := reprocess.ResumeStale(ctx)
+				return fmt.Sprintf("resumed=%d", n), err
+			},
+		})
+	}
+	if enabled("EMBEDDINGS_BACKFILL_ENABLED") {
+		mustRegister(scheduler, contract.JobSpec{
+			Name:        contract.EmbeddingBackfillJob,
+			Schedule:    envOr("EMBEDDINGS_BACKFILL_SCHEDULE", "0 4 * * *"),
+			Description: "Embed stored news missing from the vector index",
+			Timeout:     2 * time.Hour,
+			Run: func(ctx context.Context) (string, error) {
+				indexed, failed, err := embeddings.Backfill(ctx, 50)
+				return fmt.Sprintf("indexed=%d failed=%d", indexed, failed), err
+			},
+		})
+	}
+}
+
+func mustRegister(scheduler contract.IScheduler, spec contract.JobSpec) {
+	if err := scheduler.Register(spec); err != nil {
+		log.Fatalf("Failed to register job: %v", err)
+	}
+}
+
+func envOr(key, fallback string) string {
+	if v := strings.TrimSpace(os.Getenv(key)); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// schedulerOwner names this replica in job leases.
+func schedulerOwner(uuidGen contract.IUUIDGenerator) string {
+	host, _ := os.Hostname()
+	if host == "" {
+		host = "replica"
+	}
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), uuidGen.NewUUID()[:8])
+}
+
+// runTranslationWorker drains the translation queue at a fixed interval.
+func runTranslationWorker(p contract.ITranslationPipeline, logger contract.IAppLogger, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	base := contract.WithUsageActor(context.Background(), contract.UsageActor{Job: "translation_worker"})
+	for range ticker.C {
+		ctx, cancel := context.WithTimeout(base, 5*time.Minute)
+		n, err := p.ProcessDue(ctx, 20)
+		cancel()
+		if err != nil {
+			logger.Errorf("translation worker: %v", err)
+		} else if n > 0 {
+			logger.Infof("translation worker processed %d articles", n)
+		}
+	}
+}
+
+// trustedProxiesFromEnv reads TRUSTED_PROXIES, comma-separated IPs or CIDRs of
+// the load balancers in front of the API; unset trusts none.
+func trustedProxiesFromEnv() []string {
+	var proxies []string
+	for _, p := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			proxies = append(proxies, p)
+		}
+	}
+	return proxies
+}
+
+// usageBudgetFromEnv reads the daily AI limits; 0 disables a limit.
+func usageBudgetFromEnv() usecase.UsageBudget {
+	limit := func(key string, def int64) int64 {
+		if v, err := strconv.ParseInt(os.Getenv(key), 10, 64); err == nil && v >= 0 {
+			return v
+		}
+		return def
+	}
+	return usecase.UsageBudget{
+		UserTokens:      limit("AI_BUDGET_USER_DAILY_TOKENS", 200000),
+		UserCalls:       limit("AI_BUDGET_USER_DAILY_CALLS", 300),
+		AnonymousTokens: limit("AI_BUDGET_ANON_DAILY_TOKENS", 30000),
+		AnonymousCalls:  limit("AI_BUDGET_ANON_DAILY_CALLS", 50),
+		GlobalTokens:    limit("AI_BUDGET_GLOBAL_DAILY_TOKENS", 5000000),
+		GlobalCalls:     limit("AI_BUDGET_GLOBAL_DAILY_CALLS", 10000),
 	}
 }
@@ -0,0 +1,215 @@
+// Command eval runs the labeled fixtures in internal/eval/testdata through the
+// production summarize, classify and translate steps and writes a JSON and a
+// Markdown report. Pass -baseline with an earlier report to see the change a
+// prompt or model edit makes.
+//
+//	go run ./cmd/eval -out eval-report
+//	go run ./cmd/eval -prompts candidate.json -baseline eval-report.json -out candidate
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"regexp"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/eval"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/external_services"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/logger"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/prompts"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/translation"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/uuidgen"
+	"github.com/RealEskalate/G6-NewsBrief/internal/usecase"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	fixturesDir := flag.String("fixtures", "", "directory of fixture *.json files (default: the embedded set)")
+	out := flag.String("out", "eval-report", "output path prefix; writes <out>.json and <out>.md")
+	baselinePath := flag.String("baseline", "", "earlier report (.json) to compare against")
+	lang := flag.String("lang", "", "only run fixtures of this language (en or am)")
+	limit := flag.Int("limit", 0, "run at most this many fixtures")
+	promptsPath := flag.String("prompts", "", "JSON array of candidate prompt templates that replace the built-ins")
+	timeout := flag.Duration("timeout", 2*time.Minute, "time limit per fixture")
+	skipTranslation := flag.Bool("skip-translation", false, "do not translate summaries into the other language")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found, using environment variables")
+	}
+	apiURL := os.Getenv("GEMINI_API_URL")
+	if apiURL == "" {
+		log.Fatal("GEMINI_API_URL environment variable not set")
+	}
+
+	// The harness never writes to Mongo: usage is not metered, overrides come
+	// from -prompts and safety events only go to the log.
+	geminiClient := external_services.NewGeminiClient(os.Getenv("GEMINI_API_KEY"), apiURL)
+	builtins, err := prompts.Defaults()
+	if err != nil {
+		log.Fatalf("prompts: %v", err)
+	}
+	repo := &candidatePrompts{}
+	if *promptsPath != "" {
+		if err := repo.load(*promptsPath); err != nil {
+			log.Fatalf("prompts: %v", err)
+		}
+	}
+	uuidGenerator := uuidgen.NewGenerator()
+	appLogger := logger.NewStdLogger()
+	safety := usecase.NewSafetyUsecase(discardSafetyEvents{}, uuidGenerator, appLogger)
+	registry := usecase.NewPromptUsecase(repo, builtins, uuidGenerator, safety)
+	translator, err := translation.NewFromEnv(geminiClient, registry, nil, nil)
+	if err != nil {
+		log.Fatalf("translation backend: %v", err)
+	}
+	pipeline := usecase.NewEvalPipeline(geminiClient, translator, registry)
+
+	fixtures, err := eval.LoadFixtures(*fixturesDir, pipeline.Topics())
+	if err != nil {
+		log.Fatalf("fixtures: %v", err)
+	}
+	if *lang != "" {
+		var kept []eval.Fixture
+		for _, f := range fixtures {
+			if f.Language == *lang {
+				kept = append(kept, f)
+			}
+		}
+		fixtures = kept
+	}
+	if *limit > 0 && len(fixtures) > *limit {
+		fixtures = fixtures[:*limit]
+	}
+	if len(fixtures) == 0 {
+		log.Fatal("no fixtures selected")
+	}
+
+	var baseline *eval.Report
+	if *baselinePath != "" {
+		if baseline, err = eval.ReadReport(*baselinePath); err != nil {
+			log.Fatalf("baseline: %v", err)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	report := eval.Run(ctx, pipeline, fixtures, modelName(apiURL), eval.Options{
+		SkipTranslation: *skipTranslation,
+		Timeout:         *timeout,
+		Progress: func(done, total int, item eval.ItemResult) {
+			status := "ok"
+			if len(item.Errors) > 0 {
+				status = fmt.Sprintf("%d error(s)", len(item.Errors))
+			}
+			log.Printf("[%d/%d] %s: rougeL=%.3f topics=%v %s (%dms)", done, total, item.ID, item.RougeL.F1, item.Predicted, status, item.LatencyMS)
+		},
+	})
+
+	if err := report.WriteJSON(*out + ".json"); err != nil {
+		log.Fatalf("write report: %v", err)
+	}
+	if err := os.WriteFile(*out+".md", []byte(report.Markdown(baseline)), 0o644); err != nil {
+		log.Fatalf("write report: %v", err)
+	}
+	all := report.Summary["all"]
+	log.Printf("wrote %s.json and %s.md: rougeL=%.3f length_ok=%.2f language_ok=%.2f topic_f1=%.3f",
+		*out, *out, all.RougeLF, all.LengthOK, all.LanguageOK, report.Classification.F1)
+}
+
+var modelPattern = regexp.MustCompile(`models/([^:/]+)`)
+
+// modelName extracts the model from the generateContent URL.
+func modelName(apiURL string) string {
+	if m := modelPattern.FindStringSubmatch(apiURL); m != nil {
+		return m[1]
+	}
+	return apiURL
+}
+
+// candidatePrompts serves the templates of a -prompts file as the active
+// overrides, so a candidate can be scored before it is created in the admin API.
+type candidatePrompts struct {
+	templates []*entity.PromptTemplate
+}
+
+func (r *candidatePrompts) load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var list []*entity.PromptTemplate
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for i, t := range list {
+		if t.Name == "" || t.Text == "" {
+			return fmt.Errorf("%s: template %d needs a name and text", path, i)
+		}
+		t.ID = fmt.Sprintf("candidate-%d", i)
+		t.Origin = entity.PromptOverride
+		t.Active = true
+		if t.Weight <= 0 {
+			t.Weight = 100
+		}
+	}
+	r.templates = list
+	return nil
+}
+
+func (r *candidatePrompts) Create(ctx context.Context, t *entity.PromptTemplate) error {
+	return fmt.Errorf("read-only prompt repository")
+}
+
+func (r *candidatePrompts) FindByID(ctx context.Context, id string) (*entity.PromptTemplate, error) {
+	for _, t := range r.templates {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return nil, contract.ErrNotFound
+}
+
+func (r *candidatePrompts) List(ctx context.Context, name string) ([]*entity.PromptTemplate, error) {
+	var out []*entity.PromptTemplate
+	for _, t := range r.templates {
+		if name == "" || t.Name == name {
+			out = append(out, t)
+		}
+	}
+	return out, nil
+}
+
+func (r *candidatePrompts) ListActive(ctx context.Context) ([]*entity.PromptTemplate, error) {
+	return r.templates, nil
+}
+
+func (r *candidatePrompts) LatestVersion(ctx context.Context, name string) (int, error) {
+	latest := 0
+	for _, t := range r.templates {
+		if t.Name == name && t.Version > latest {
+			latest = t.Version
+		}
+	}
+	return latest, nil
+}
+
+func (r *candidatePrompts) SetActive(ctx context.Context, id string, active bool, weight int) error {
+	return fmt.Errorf("read-only prompt repository")
+}
+
+// discardSafetyEvents drops events; the guard still logs them.
+type discardSafetyEvents struct{}
+
+func (discardSafetyEvents) Save(ctx context.Context, e *entity.SafetyEvent) error { return nil }
+
+func (discardSafetyEvents) List(ctx context.Context, stage entity.SafetyStage, page, limit int) ([]*entity.SafetyEvent, int64, int, error) {
+	return nil, 0, 0, nil
+}
@@ -0,0 +1,62 @@
+// Command extract runs the article extractor on a live page or a saved HTML
+// file. The saved fixtures in internal/infrastructure/extractor/testdata are
+// checked by go test ./internal/infrastructure/extractor.
+//
+//	go run ./cmd/extract -file page.html
+//	go run ./cmd/extract -url https://example.com/news/1
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/extractor"
+)
+
+func main() {
+	pageURL := flag.String("url", "", "fetch and extract this page (honours robots.txt)")
+	file := flag.String("file", "", "extract a saved HTML page")
+	base := flag.String("base", "", "URL a -file page was saved from, to resolve relative image links")
+	flag.Parse()
+
+	switch {
+	case *file != "":
+		f, err := os.Open(*file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		a, err := extractor.Extract(f, *base)
+		if err != nil {
+			log.Fatalf("extract: %v", err)
+		}
+		printJSON(a)
+	case *pageURL != "":
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		a, err := extractor.NewFetcher(0).Extract(ctx, *pageURL)
+		if err != nil {
+			log.Fatalf("extract: %v", err)
+		}
+		printJSON(a)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func printJSON(v interface{}) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		log.Fatal(err)
+	}
+}
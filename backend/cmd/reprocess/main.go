@@ -0,0 +1,224 @@
+// Command reprocess re-runs pipeline stages (summarize, classify, translate,
+// localize_date, embed) over stored articles, as the admin reprocess API
+// does, but in the foreground. Jobs are shared with the API: they show up in
+// GET /api/v1/admin/reprocess and can be resumed from either side. Ctrl-C
+// pauses the job after saving its progress.
+//
+//	go run ./cmd/reprocess -stages summarize,translate -from 2025-01-01 -source addis-standard
+//	go run ./cmd/reprocess -stages classify -missing topics -rate 60
+//	go run ./cmd/reprocess -resume <job id>
+//	go run ./cmd/reprocess -list
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	database "github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/database"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/external_services"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/logger"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/prompts"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/repository/mongodb"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/translation"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/uuidgen"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/vectorindex"
+	"github.com/RealEskalate/G6-NewsBrief/internal/usecase"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	stages := flag.String("stages", "", "comma-separated stages: "+strings.Join(entity.ReprocessStages, ","))
+	from := flag.String("from", "", "only articles published at or after this date (YYYY-MM-DD or RFC 3339)")
+	to := flag.String("to", "", "only articles published before this date (YYYY-MM-DD or RFC 3339)")
+	source := flag.String("source", "", "only articles of this source slug")
+	topic := flag.String("topic", "", "only articles of this topic slug")
+	language := flag.String("language", "", "only articles in this original language (en or am)")
+	missing := flag.String("missing", "", "only articles missing this field: summary, topics, translation or localized_date")
+	rate := flag.Int("rate", 0, "articles per minute (default 30)")
+	resume := flag.String("resume", "", "resume the paused, failed or abandoned job with this ID")
+	list := flag.Bool("list", false, "list recent jobs and exit")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found, using environment variables")
+	}
+	uc, closeDB := newReprocessUsecase()
+	defer closeDB()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	ctx = contract.WithUsageActor(ctx, contract.UsageActor{Job: "news_reprocess"})
+
+	if *list {
+		jobs, err := uc.List(ctx, "", 20)
+		if err != nil {
+			log.Fatalf("list: %v", err)
+		}
+		for _, j := range jobs {
+			fmt.Printf("%s  %-9s %d/%d updated=%d failed=%d stages=%s created=%s\n", j.ID, j.Status, j.Processed, j.Total, j.Updated, j.Failed, strings.Join(j.Stages, ","), j.CreatedAt.Format(time.RFC3339))
+		}
+		return
+	}
+
+	id := *resume
+	if id == "" {
+		if *stages == "" {
+			flag.Usage()
+			os.Exit(2)
+		}
+		req := contract.ReprocessRequest{
+			Filter: entity.ReprocessFilter{
+				From:     parseDate("from", *from),
+				To:       parseDate("to", *to),
+				Source:   *source,
+				Topic:    *topic,
+				Language: *language,
+				Missing:  *missing,
+			},
+			Stages:     strings.Split(*stages, ","),
+			RatePerMin: *rate,
+		}
+		j, err := uc.Create(ctx, req, "cli")
+		if err != nil {
+			log.Fatalf("create job: %v", err)
+		}
+		id = j.ID
+		log.Printf("job %s: %d articles, stages %s, %d per minute", j.ID, j.Total, strings.Join(j.Stages, ","), j.RatePerMin)
+	}
+
+	done := make(chan struct{})
+	go reportProgress(uc, id, done)
+	j, err := uc.Run(ctx, id)
+	close(done)
+	if err != nil {
+		// a conflict means the job is done, cancelled or run by the API server
+		log.Fatalf("job %s: %v", id, err)
+	}
+	log.Printf("job %s %s: processed=%d/%d updated=%d failed=%d stages=%v", j.ID, j.Status, j.Processed, j.Total, j.Updated, j.Failed, j.StageCounts)
+	for _, f := range j.Failures {
+		log.Printf("  %s %s: %s", f.NewsID, f.Stage, f.Error)
+	}
+	if j.Status == entity.ReprocessPaused {
+		log.Printf("paused (%s); continue with -resume %s", j.Error, j.ID)
+	}
+	if j.Status == entity.ReprocessFailed {
+		os.Exit(1)
+	}
+}
+
+// newReprocessUsecase wires the same Mongo-backed pipeline as the API
+// server, so AI usage is metered against the shared budgets.
+func newReprocessUsecase() (contract.IReprocessUsecase, func()) {
+	mongoURI, dbName := os.Getenv("MONGODB_URI"), os.Getenv("MONGODB_DB_NAME")
+	if mongoURI == "" || dbName == "" {
+		log.Fatal("MONGODB_URI and MONGODB_DB_NAME environment variables must be set")
+	}
+	apiURL := os.Getenv("GEMINI_API_URL")
+	if apiURL == "" {
+		log.Fatal("GEMINI_API_URL environment variable not set")
+	}
+	mongoClient, err := database.NewMongoDBClient(mongoURI)
+	if err != nil {
+		log.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+	db := mongoClient.Client.Database(dbName)
+	appLogger := logger.NewStdLogger()
+	uuidGenerator := uuidgen.NewGenerator()
+
+	newsRepo := mongodb.NewNewsRepositoryMongo(db.Collection("news"))
+	usageRepo := mongodb.NewLLMUsageRepository(db.Collection("llm_usage"), db.Collection("llm_usage_daily"))
+	usageUC := usecase.NewUsageUsecase(usageRepo, uuidGenerator, globalBudgetFromEnv(), appLogger)
+	geminiClient := external_services.NewGeminiClient(os.Getenv("GEMINI_API_KEY"), apiURL).WithMeter(usageUC)
+	builtins, err := prompts.Defaults()
+	if err != nil {
+		log.Fatalf("prompts: %v", err)
+	}
+	safetyUC := usecase.NewSafetyUsecase(mongodb.NewSafetyEventRepository(db.Collection("safety_events")), uuidGenerator, appLogger)
+	promptUC := usecase.NewPromptUsecase(mongodb.NewPromptRepository(db.Collection("prompt_templates")), builtins, uuidGenerator, safetyUC)
+	translator, err := translation.NewFromEnv(geminiClient, promptUC, mongodb.NewTranslationMemoryRepository(db.Collection("translation_memory")), usageUC)
+	if err != nil {
+		log.Fatalf("translation backend: %v", err)
+	}
+	var vectorIndex contract.IVectorIndex
+	if strings.ToLower(os.Getenv("VECTOR_INDEX")) == "memory" {
+		vectorIndex = vectorindex.NewMemoryIndex()
+	} else {
+		vectorIndex = mongodb.NewEmbeddingRepository(db.Collection("news_embeddings"), os.Getenv("ATLAS_VECTOR_INDEX"))
+	}
+	embeddingUC := usecase.NewEmbeddingUsecase(geminiClient, vectorIndex, newsRepo)
+	pipeline := usecase.NewTranslationPipeline(translator, newsRepo, embeddingUC, mongodb.NewRevisionRepository(db.Collection("news_revisions")), uuidGenerator)
+
+	host, _ := os.Hostname()
+	owner := fmt.Sprintf("cli-%s-%d", host, os.Getpid())
+	uc := usecase.NewReprocessUsecase(
+		mongodb.NewReprocessJobRepository(db.Collection("reprocess_jobs")),
+		newsRepo,
+		mongodb.NewSummaryRepository(db.Collection("summaries")),
+		mongodb.NewTopicRepository(db.Collection("topics")),
+		mongodb.NewSourceRepository(db.Collection("sources")),
+		geminiClient, promptUC, pipeline, embeddingUC, uuidGenerator, appLogger, owner,
+	)
+	return uc, func() { _ = mongoClient.Disconnect() }
+}
+
+// globalBudgetFromEnv reads the daily limits shared with the API server;
+// per-user limits do not apply to batch jobs.
+func globalBudgetFromEnv() usecase.UsageBudget {
+	limit := func(key string, def int64) int64 {
+		if v, err := strconv.ParseInt(os.Getenv(key), 10, 64); err == nil && v >= 0 {
+			return v
+		}
+		return def
+	}
+	return usecase.UsageBudget{
+		GlobalTokens: limit("AI_BUDGET_GLOBAL_DAILY_TOKENS", 5000000),
+		GlobalCalls:  limit("AI_BUDGET_GLOBAL_DAILY_CALLS", 10000),
+	}
+}
+
+// reportProgress logs the saved progress of the job until done is closed.
+func reportProgress(uc contract.IReprocessUsecase, id string, done <-chan struct{}) {
+	ticker := time.NewTicker(15 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			j, err := uc.Get(ctx, id)
+			cancel()
+			if err != nil {
+				continue
+			}
+			pct := 100.0
+			if j.Total > 0 {
+				pct = 100 * float64(j.Processed) / float64(j.Total)
+			}
+			log.Printf("%d/%d (%.0f%%) updated=%d failed=%d", j.Processed, j.Total, pct, j.Updated, j.Failed)
+		}
+	}
+}
+
+// parseDate accepts a calendar date (UTC midnight) or an RFC 3339 time.
+func parseDate(name, s string) *time.Time {
+	if s == "" {
+		return nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &t
+		}
+	}
+	log.Fatalf("-%s: %q is neither YYYY-MM-DD nor RFC 3339", name, s)
+	return nil
+}
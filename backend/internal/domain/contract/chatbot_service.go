@@ -1,12 +1,33 @@
 package contract
 
 import (
+	"context"
+
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
 )
 
+// ArticleSet selects the articles of a multi-article chat or comparison.
+// Exactly one selector is used, in this order: NewsIDs, StoryID, TopicID,
+// BookmarksOf (a user ID).
+type ArticleSet struct {
+	NewsIDs     []string
+	StoryID     string
+	TopicID     string
+	BookmarksOf string
+}
+
 type IChatbotService interface {
-	ChatGeneral(sessionID, message string) (string, error)
-	ChatForNews(newsID, sessionID, message string) (string, error)
+	// ChatGeneral answers a news question grounded in the most relevant stored articles.
+	ChatGeneral(ctx context.Context, sessionID, message string) (entity.ChatReply, error)
+	// ChatForNews answers a question about one article only.
+	ChatForNews(ctx context.Context, newsID, sessionID, message string) (entity.ChatReply, error)
+	// ChatArticles answers a question over a set of articles, attributing every
+	// statement to the article and source it came from.
+	ChatArticles(ctx context.Context, set ArticleSet, sessionID, message string) (entity.ChatReply, error)
+	// CompareCoverage summarizes how different sources reported the same event.
+	CompareCoverage(ctx context.Context, set ArticleSet, lang string) (*entity.CoverageComparison, error)
+	// SuggestedQuestions returns the starter questions of an article in both
+	// languages, generating and caching them on first use.
+	SuggestedQuestions(ctx context.Context, newsID string) (entity.SuggestedQuestions, error)
 	GetHistory(sessionID string) ([]entity.ChatMessage, error)
 }
-
@@ -6,4 +6,18 @@ import "errors"
 var (
 	// ErrAlreadyBookmarked indicates the user already bookmarked the given news
 	ErrAlreadyBookmarked = errors.New("already bookmarked")
+	// ErrNotFound indicates the requested record does not exist
+	ErrNotFound = errors.New("not found")
+	// ErrBudgetExceeded indicates the caller or the service used up today's AI budget
+	ErrBudgetExceeded = errors.New("daily AI budget exceeded")
+	// ErrInvalidInput wraps validation failures of user-supplied content
+	ErrInvalidInput = errors.New("invalid input")
+	// ErrUnsafeContent indicates the safety guard or the model refused a request or reply
+	ErrUnsafeContent = errors.New("unsafe content")
+	// ErrUnauthorized indicates missing or invalid machine credentials
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden indicates valid credentials without the required scope
+	ErrForbidden = errors.New("forbidden")
+	// ErrConflict indicates the request clashes with work already in progress
+	ErrConflict = errors.New("conflict")
 )
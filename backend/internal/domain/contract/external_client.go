@@ -1,14 +1,59 @@
 package contract
 
+import (
+	"context"
+	"time"
+)
+
 type IGeminiClient interface {
-	Summarize(text, lang string) (string, error)
-	Chat(messages []string, context string) (string, error)
-	// ClassifyTopics analyzes text and returns up to topK topic labels in the given language
-	ClassifyTopics(text, lang string, topK int) ([]string, error)
+	// Chat answers the latest message under the given system instruction
+	Chat(ctx context.Context, messages []string, system string) (string, error)
+	// Generate sends a free-form prompt and returns the model's text reply
+	Generate(ctx context.Context, prompt string) (string, error)
+	// Model returns the configured model name (recorded on generated content)
+	Model() string
+	// ChatWithTools runs one step of a function-calling conversation: the model
+	// either answers in text or asks for some of the declared tools to be called.
+	ChatWithTools(ctx context.Context, turns []ChatTurn, system string, tools []ToolSpec) (ToolStep, error)
+}
+
+// ToolSpec declares a function the model may call. Parameters is a JSON
+// schema object (type, properties, required).
+type ToolSpec struct {
+	Name        string
+	Description string
+	Parameters  map[string]interface{}
+}
+
+// ToolCall is a function call requested by the model.
+type ToolCall struct {
+	Name string
+	Args map[string]interface{}
+}
+
+// ToolResult answers a ToolCall.
+type ToolResult struct {
+	Name     string
+	Response map[string]interface{}
+}
+
+// ChatTurn is one entry of a function-calling conversation: user text, the
+// model's text or calls, or the results of those calls.
+type ChatTurn struct {
+	Role    string // "user", "model" or "tool"
+	Text    string
+	Calls   []ToolCall
+	Results []ToolResult
+}
+
+// ToolStep is the model's response to a ChatWithTools request.
+type ToolStep struct {
+	Text  string
+	Calls []ToolCall
 }
 
 type ITranslationClient interface {
-	Translate(text, sourceLang, targetLang string) (string, error)
+	Translate(ctx context.Context, text, sourceLang, targetLang string) (string, error)
 }
 
 type IScraperClient interface {
@@ -18,7 +63,62 @@ type IScraperClient interface {
 
 // INewsProviderClient queries the external news provider service for latest items
 type INewsProviderClient interface {
-	Search(query string, topK int) ([]ProviderItem, error)
+	Search(ctx context.Context, query string, topK int) ([]ProviderItem, error)
+	// FetchPage returns one page of stored items, newest first. Providers that
+	// ignore the date bounds are filtered by the caller.
+	FetchPage(ctx context.Context, q ProviderQuery) ([]ProviderItem, error)
+}
+
+// ProviderQuery selects a page of provider items. Zero Since/Until leave that
+// end open.
+type ProviderQuery struct {
+	Query  string
+	Limit  int
+	Offset int
+	Since  time.Time
+	Until  time.Time
+}
+
+// IFeedClient fetches and parses RSS 2.0, Atom and JSON Feed documents.
+type IFeedClient interface {
+	// Fetch sends a conditional GET with the validators from the previous poll;
+	// an unchanged feed returns NotModified and no items.
+	Fetch(ctx context.Context, url, etag, lastModified string) (FeedResult, error)
+}
+
+// FeedResult is one fetched feed with the validators for the next poll.
+type FeedResult struct {
+	Items        []ProviderItem
+	ETag         string
+	LastModified string
+	NotModified  bool
+}
+
+// IArticleExtractor fetches an article page and returns its main text,
+// honouring robots.txt and a per-host request interval.
+type IArticleExtractor interface {
+	Extract(ctx context.Context, url string) (*ExtractedArticle, error)
+}
+
+// ExtractedArticle is the main content of a fetched page.
+type ExtractedArticle struct {
+	Title string
+	// Text holds the article paragraphs separated by blank lines
+	Text      string
+	Lang      string
+	SiteName  string
+	Published string
+	// Images holds the lead image (og:image or the first large picture) first, then the gallery
+	Images []ImageRef
+}
+
+// ImageRef is an image found in provider data, a feed entry or an article
+// page. Zero dimensions are unknown.
+type ImageRef struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width,omitempty"`
+	Height int    `json:"height,omitempty"`
+	Alt    string `json:"alt,omitempty"`
 }
 
 // ProviderItem is a minimal shape returned by the provider search API
@@ -31,4 +131,9 @@ type ProviderItem struct {
 	SourceType    string `json:"source_type"`
 	PublishedDate string `json:"published_date"`
 	Lang          string `json:"lang"`
+	// SourceID links the item to a known source (set for feed items)
+	SourceID string `json:"source_id,omitempty"`
+	// ImageURL is the provider's lead image; Images adds any further pictures
+	ImageURL string     `json:"image_url,omitempty"`
+	Images   []ImageRef `json:"images,omitempty"`
 }
@@ -0,0 +1,68 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+type IAPIKeyRepository interface {
+	Create(ctx context.Context, k *entity.APIKey) error
+	FindByID(ctx context.Context, id string) (*entity.APIKey, error)
+	FindByPrefix(ctx context.Context, prefix string) (*entity.APIKey, error)
+	// List returns keys newest first; revoked keys only when includeRevoked is set.
+	List(ctx context.Context, includeRevoked bool) ([]*entity.APIKey, error)
+	Revoke(ctx context.Context, id string, at time.Time) error
+	// MarkRotated sets the successor of a key and the end of its grace period.
+	MarkRotated(ctx context.Context, id, rotatedTo string, expiresAt time.Time) error
+	TouchLastUsed(ctx context.Context, id string, at time.Time, ip string) error
+}
+
+// IReplayGuard remembers signed requests until their timestamp expires.
+type IReplayGuard interface {
+	// Claim records the nonce and returns false when it was already seen.
+	Claim(ctx context.Context, nonce string, expiresAt time.Time) (bool, error)
+}
+
+// ISecretBox encrypts secrets stored at rest.
+type ISecretBox interface {
+	Seal(plain []byte) (string, error)
+	Open(sealed string) ([]byte, error)
+}
+
+// IssueAPIKeyInput describes a key to issue.
+type IssueAPIKeyInput struct {
+	Name   string
+	Kind   entity.APIKeyKind
+	Scopes []string
+	// SourceSlug, when set, restricts the key to pushing articles of that source
+	SourceSlug string
+	ExpiresAt  *time.Time
+	CreatedBy  string
+}
+
+// MachineRequest carries the credentials of an incoming machine request.
+// Bearer keys set APIKey; signed requests set KeyPrefix, Timestamp and Signature.
+type MachineRequest struct {
+	APIKey    string
+	KeyPrefix string
+	Timestamp string
+	Signature string
+	Body      []byte
+	ClientIP  string
+}
+
+// IAPIKeyUsecase issues machine credentials and authenticates requests made with them.
+type IAPIKeyUsecase interface {
+	// Issue creates a key and returns it with the full secret key, shown only once.
+	Issue(ctx context.Context, in IssueAPIKeyInput) (*entity.APIKey, string, error)
+	// Rotate issues a replacement with the same settings; the old key keeps
+	// working for the grace period.
+	Rotate(ctx context.Context, id string, grace time.Duration, by string) (*entity.APIKey, string, error)
+	Revoke(ctx context.Context, id string) error
+	List(ctx context.Context, includeRevoked bool) ([]*entity.APIKey, error)
+	// Authenticate returns the active key behind the request if it holds the
+	// scope; failures wrap ErrUnauthorized or ErrForbidden.
+	Authenticate(ctx context.Context, req MachineRequest, scope string) (*entity.APIKey, error)
+}
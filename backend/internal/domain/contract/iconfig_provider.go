@@ -11,4 +11,5 @@ type IConfigProvider interface {
 	GetPasswordResetTokenExpiry() time.Duration
 	GetEmailVerificationTokenExpiry() time.Duration
 	GetAIServiceAPIKey() string
+	GetSchedulerTimezone() string
 }
@@ -0,0 +1,25 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// SegmentCorrection pairs a source phrase with its corrected translation.
+type SegmentCorrection struct {
+	Source string
+	Target string
+}
+
+type IEditorialUsecase interface {
+	// GetArticle returns the article with its full revision history for side-by-side review.
+	GetArticle(ctx context.Context, newsID string) (*entity.News, []*entity.FieldRevision, error)
+	// CorrectField replaces a bilingual field, records a revision and feeds the
+	// corrected segments into translation memory.
+	CorrectField(ctx context.Context, newsID, field, content, editorID, note string, segments []SegmentCorrection) (*entity.News, error)
+	ListRevisions(ctx context.Context, newsID, field string) ([]*entity.FieldRevision, error)
+	// AddMemoryEntry records an approved phrase translation outside of an article.
+	AddMemoryEntry(ctx context.Context, sourceLang, targetLang, source, target, editorID string) (*entity.TranslationMemoryEntry, error)
+	ListMemory(ctx context.Context, sourceLang, targetLang string, limit int) ([]*entity.TranslationMemoryEntry, error)
+}
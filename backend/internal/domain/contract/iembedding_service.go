@@ -0,0 +1,23 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// EmbeddingBackfillJob is the scheduled job running Backfill.
+const EmbeddingBackfillJob = "embedding_backfill"
+
+// IEmbeddingService embeds news articles into the vector index and runs semantic queries over it.
+type IEmbeddingService interface {
+	// IndexNews computes and stores the embedding of a news article.
+	IndexNews(ctx context.Context, news *entity.News) error
+	// SearchText embeds a free-text query and returns the nearest articles.
+	SearchText(ctx context.Context, query string, k int, filter VectorFilter) ([]VectorMatch, error)
+	// SimilarTo returns the nearest articles to the given one, embedding it first if needed.
+	SimilarTo(ctx context.Context, news *entity.News, k int, filter VectorFilter) ([]VectorMatch, error)
+	// Backfill embeds stored news that have no vector yet, batchSize items at a time in ID
+	// order. Run it through the EmbeddingBackfillJob so only one runs at a time.
+	Backfill(ctx context.Context, batchSize int) (indexed, failed int, err error)
+}
@@ -0,0 +1,16 @@
+package contract
+
+import "context"
+
+// IEvalPipeline exposes the production summarize, classify and translate
+// steps to the offline evaluation harness (cmd/eval).
+type IEvalPipeline interface {
+	// Summarize returns the post-processed summary and the prompt version used.
+	Summarize(ctx context.Context, key, text, lang string) (string, string, error)
+	// Classify returns the whitelisted topic slugs ingestion would assign,
+	// including the keyword fallbacks used when the model finds fewer than two.
+	Classify(ctx context.Context, key, title, text, lang string) ([]string, error)
+	Translate(ctx context.Context, text, sourceLang, targetLang string) (string, error)
+	// Topics returns the topic whitelist classification is mapped onto.
+	Topics() []string
+}
@@ -0,0 +1,36 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+type IFeedStateRepository interface {
+	// Get returns the state of a feed, or ErrNotFound before its first poll.
+	Get(ctx context.Context, url string) (*entity.FeedState, error)
+	Save(ctx context.Context, s *entity.FeedState) error
+	List(ctx context.Context) ([]*entity.FeedState, error)
+}
+
+// FeedPollResult summarizes one polling pass.
+type FeedPollResult struct {
+	Feeds       int      `json:"feeds"`
+	NotModified int      `json:"not_modified"`
+	Failed      int      `json:"failed"`
+	Items       int      `json:"items"`
+	Ingested    []string `json:"ingested"`
+	Skipped     int      `json:"skipped"`
+}
+
+// IFeedIngestionUsecase polls the RSS/Atom/JSON feeds configured on sources
+// and sends new entries through provider ingestion.
+type IFeedIngestionUsecase interface {
+	// PollDue polls every feed whose source interval has elapsed.
+	PollDue(ctx context.Context) (FeedPollResult, error)
+	// PollSource polls all feeds of one source now.
+	PollSource(ctx context.Context, slug string) (FeedPollResult, error)
+	// SetFeeds replaces the feed URLs and poll interval of a source.
+	SetFeeds(ctx context.Context, slug string, urls []string, intervalMinutes int) (*entity.Source, error)
+	ListStates(ctx context.Context) ([]*entity.FeedState, error)
+}
@@ -0,0 +1,38 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// IImageProxy fetches publisher images once, keeps them in a disk cache and
+// serves resized copies, so clients never hotlink publisher servers.
+type IImageProxy interface {
+	// Get returns the image at url scaled down to at most width pixels
+	// (0 keeps the original). Unreachable or non-image URLs are ErrNotFound.
+	Get(ctx context.Context, url string, width int) (*ProxiedImage, error)
+	// Probe returns the pixel size of the image at url, caching the original.
+	Probe(ctx context.Context, url string) (width, height int, err error)
+}
+
+// ProxiedImage is an image ready to be served.
+type ProxiedImage struct {
+	Data        []byte
+	ContentType string
+	ETag        string
+	ModTime     time.Time
+}
+
+// IMediaUsecase serves article images and source logos through the proxy and
+// prepares the images of newly ingested articles.
+type IMediaUsecase interface {
+	// NewsImage returns image index (0 is the lead image) of an article
+	NewsImage(ctx context.Context, newsID string, index, width int) (*ProxiedImage, error)
+	// SourceLogo returns the logo of a source
+	SourceLogo(ctx context.Context, slug string, width int) (*ProxiedImage, error)
+	// PrepareImages drops duplicates and decorative pictures, keeps the lead
+	// image first and fills in missing dimensions; unreachable images are dropped.
+	PrepareImages(ctx context.Context, refs []ImageRef) []entity.NewsImage
+}
@@ -0,0 +1,21 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+type INamedEntityRepository interface {
+	// Resolve returns the stored entity matching the slug or one of the aliases of
+	// the given type, creating it when none exists; new aliases and missing labels
+	// are merged into the stored document.
+	Resolve(ctx context.Context, e *entity.NamedEntity) (*entity.NamedEntity, error)
+	// IncrementArticleCount adds delta to the article counters of the given entities.
+	IncrementArticleCount(ctx context.Context, ids []string, delta int) error
+	// FindByID returns ErrNotFound when the entity does not exist.
+	FindByID(ctx context.Context, id string) (*entity.NamedEntity, error)
+	FindByIDs(ctx context.Context, ids []string) ([]*entity.NamedEntity, error)
+	// List returns entities ordered by article count, optionally filtered by type and a label prefix.
+	List(ctx context.Context, entityType entity.NamedEntityType, query string, page, limit int) ([]*entity.NamedEntity, int64, int, error)
+}
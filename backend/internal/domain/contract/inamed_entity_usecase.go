@@ -0,0 +1,21 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+type INamedEntityUsecase interface {
+	// ExtractForNews extracts people, organizations and places from the article,
+	// resolves them in the entities collection and records their IDs on the news.
+	ExtractForNews(ctx context.Context, news *entity.News) ([]*entity.NamedEntity, error)
+	GetEntity(ctx context.Context, id string) (*entity.NamedEntity, error)
+	ListEntities(ctx context.Context, entityType entity.NamedEntityType, query string, page, limit int) ([]*entity.NamedEntity, int64, int, error)
+	// ListNews returns paginated news mentioning the entity.
+	ListNews(ctx context.Context, entityID string, page, limit int) ([]*entity.News, int64, int, error)
+	// FollowEntities adds entities to the user's followed list (idempotent per entity).
+	FollowEntities(ctx context.Context, userID string, entityIDs []string) error
+	UnfollowEntity(ctx context.Context, userID, entityID string) error
+	GetFollowedEntities(ctx context.Context, userID string) ([]*entity.NamedEntity, error)
+}
@@ -2,6 +2,7 @@ package contract
 
 import (
 	"context"
+	"time"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
 )
@@ -9,6 +10,11 @@ import (
 type INewsRepository interface {
 	Save(news *entity.News) error
 	Update(news *entity.News) error
+	// UpdateFields writes only the named fields of news (bson names; "translations.title_am"
+	// addresses one entry), unsetting those left empty, and bumps updated_at. When expect is
+	// set, the write applies only while every path in it still holds the given value ("" also
+	// matches a missing field), and ErrConflict is returned otherwise.
+	UpdateFields(ctx context.Context, news *entity.News, fields []string, expect map[string]interface{}) error
 	FindByID(id string) (*entity.News, error)
 	FindAll(page, limit int) ([]*entity.News, int64, int, error)
 	// FindTrending returns paginated news sorted by published_at desc
@@ -23,5 +29,22 @@ type INewsRepository interface {
 	FindByIDs(ctx context.Context, ids []string) ([]*entity.News, error)
 	// FindByTopicID returns paginated news filtered by a given topic ID
 	FindByTopicID(ctx context.Context, topicID string, page, limit int) ([]*entity.News, int64, int, error)
+	// FindByEntityID returns paginated news mentioning the given named entity
+	FindByEntityID(ctx context.Context, entityID string, page, limit int) ([]*entity.News, int64, int, error)
+	// ExistsBySourceURL reports whether an article with this original URL is stored
+	ExistsBySourceURL(ctx context.Context, url string) (bool, error)
+	// ClaimTranslationDue takes the article whose translation_due_at is earliest and at or before
+	// the given time, moving translation_due_at to leaseUntil so other workers skip it until then.
+	// It returns nil when nothing is due.
+	ClaimTranslationDue(ctx context.Context, before, leaseUntil time.Time) (*entity.News, error)
+	// FindBySourceSince returns the newest articles of a source ingested since the given time
+	FindBySourceSince(ctx context.Context, sourceID string, since time.Time, limit int) ([]*entity.News, error)
+	// FindForReprocess returns up to limit articles matching f with an ID after afterID, in ID
+	// order, with only their IDs loaded
+	FindForReprocess(ctx context.Context, f entity.ReprocessFilter, afterID string, limit int) ([]*entity.News, error)
+	// FindAfterID returns up to limit articles with an ID after afterID, in ID order
+	FindAfterID(ctx context.Context, afterID string, limit int) ([]*entity.News, error)
+	// CountForReprocess counts the articles matching f
+	CountForReprocess(ctx context.Context, f entity.ReprocessFilter) (int64, error)
 	// Delete(id string) error
 }
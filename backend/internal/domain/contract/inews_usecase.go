@@ -2,6 +2,7 @@ package contract
 
 import (
 	"context"
+	"time"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
 )
@@ -13,10 +14,13 @@ type INewsUsecase interface {
 	ListForYou(ctx context.Context, userID string, page, limit int) ([]*entity.News, int64, int, error)
 	// ListByTopicID returns paginated news for a given topic ID
 	ListByTopicID(ctx context.Context, topicID string, page, limit int) ([]*entity.News, int64, int, error)
-	// ListTrending returns paginated trending news sorted by recency
-	ListTrending(page, limit int) ([]*entity.News, int64, int, error)
+	// ListTrending returns paginated trending news ranked by recency and source reliability
+	ListTrending(ctx context.Context, page, limit int) ([]*entity.News, int64, int, error)
 	// ListToday returns top-N news for today only (fixed 4 by default)
-	ListToday(limit int) ([]*entity.News, int64, int, error)
+	ListToday(ctx context.Context, limit int) ([]*entity.News, int64, int, error)
+	// ListRelated returns the articles most similar to the given one, published within
+	// the window around it and excluding copies of the same source URL
+	ListRelated(ctx context.Context, newsID string, limit int, window time.Duration) ([]*entity.News, error)
 	// allow admin to create news
 	AdminCreateNews(ctx context.Context, title, body, language, sourceID string, topicIDs []string) (*entity.News, error)
 }
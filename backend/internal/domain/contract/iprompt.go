@@ -0,0 +1,38 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// IPromptRepository stores admin overrides of the built-in prompt templates.
+type IPromptRepository interface {
+	Create(ctx context.Context, t *entity.PromptTemplate) error
+	FindByID(ctx context.Context, id string) (*entity.PromptTemplate, error)
+	// List returns the overrides of one prompt ("" for all), newest version first.
+	List(ctx context.Context, name string) ([]*entity.PromptTemplate, error)
+	ListActive(ctx context.Context) ([]*entity.PromptTemplate, error)
+	// LatestVersion returns the highest stored version of the prompt (0 if none).
+	LatestVersion(ctx context.Context, name string) (int, error)
+	SetActive(ctx context.Context, id string, active bool, weight int) error
+}
+
+// IPromptRegistry renders the prompts sent to the model.
+type IPromptRegistry interface {
+	// Render fills the served version of the named prompt for the output
+	// language. When several versions are active, traffic is split by weight
+	// and the same key (e.g. a news ID) always gets the same version.
+	Render(ctx context.Context, name, lang, key string, vars map[string]string) (entity.RenderedPrompt, error)
+}
+
+type IPromptUsecase interface {
+	IPromptRegistry
+	// List returns the built-in templates followed by the stored overrides.
+	List(ctx context.Context, name string) ([]entity.PromptTemplate, error)
+	// CreateVersion validates and stores a new version of a built-in prompt.
+	CreateVersion(ctx context.Context, t entity.PromptTemplate, activate bool) (*entity.PromptTemplate, error)
+	// SetActive serves or withdraws a stored version; rolling back is
+	// deactivating the newer one or re-activating an older one.
+	SetActive(ctx context.Context, id string, active bool, weight int) (*entity.PromptTemplate, error)
+}
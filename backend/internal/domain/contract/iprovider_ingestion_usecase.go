@@ -1,9 +1,68 @@
 package contract
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
 
 // IProviderIngestionUsecase coordinates fetching news from external provider,
 // summarizing, topic classification/creation, and persistence.
 type IProviderIngestionUsecase interface {
 	IngestFromProvider(ctx context.Context, query string, topK int) (ingestedIDs []string, skipped int, err error)
+	// IngestItems runs already fetched items through the same pipeline; items
+	// whose URL is already stored are skipped. When ctx ends mid-run the IDs
+	// saved so far are returned together with ctx.Err().
+	IngestItems(ctx context.Context, items []ProviderItem) (ingestedIDs []string, skipped int, err error)
+	// IngestBatch is IngestItems reporting why each skipped item was dropped.
+	IngestBatch(ctx context.Context, items []ProviderItem) (IngestReport, error)
+	// PreviewFromProvider runs the pipeline without saving articles, topics or
+	// sources and stores the outcome as a preview to approve.
+	PreviewFromProvider(ctx context.Context, query string, topK int, userID string) (*entity.IngestionPreview, error)
+	GetPreview(ctx context.Context, id string) (*entity.IngestionPreview, error)
+	// ApprovePreview saves the selected articles of a preview, creating the
+	// topics and sources they need. Items already saved are left alone.
+	ApprovePreview(ctx context.Context, id string, newsIDs []string) (*PreviewApproval, error)
+	DiscardPreview(ctx context.Context, id string) error
+}
+
+// IngestReport is the outcome of an ingested batch.
+type IngestReport struct {
+	IDs []string
+	// Skipped counts the dropped items by reason: "already_stored",
+	// "repeated_in_batch", "summary_failed" or "save_failed"
+	Skipped map[string]int
+}
+
+// SkippedTotal is the number of dropped items.
+func (r IngestReport) SkippedTotal() int {
+	total := 0
+	for _, n := range r.Skipped {
+		total += n
+	}
+	return total
+}
+
+// PreviewApproval summarizes the approval of preview items.
+type PreviewApproval struct {
+	Saved []string `json:"saved"`
+	// Duplicates were stored by another run after the preview was made
+	Duplicates int                      `json:"duplicates"`
+	Failed     int                      `json:"failed"`
+	Preview    *entity.IngestionPreview `json:"preview"`
+}
+
+type IIngestionPreviewRepository interface {
+	Create(ctx context.Context, p *entity.IngestionPreview) error
+	// Get returns a preview, or ErrNotFound once it was discarded or expired.
+	Get(ctx context.Context, id string) (*entity.IngestionPreview, error)
+	// ClaimApproval reserves the preview for one approval until the given
+	// time and returns it as stored then; it returns nil while another
+	// approval holds it or once the preview is gone.
+	ClaimApproval(ctx context.Context, id string, until time.Time) (*entity.IngestionPreview, error)
+	// FinishApproval stores the item outcomes and releases the claim taken
+	// until the given time, unless the claim lapsed and was taken over.
+	FinishApproval(ctx context.Context, id string, until time.Time, items []entity.PreviewItem) error
+	Delete(ctx context.Context, id string) error
 }
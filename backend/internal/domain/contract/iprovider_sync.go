@@ -0,0 +1,39 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// IProviderSyncRepository persists the sync cursor and backfill progress.
+type IProviderSyncRepository interface {
+	Get(ctx context.Context, provider string) (*entity.ProviderSyncState, error)
+	// SaveSync writes the cursor and last-sync fields, leaving the backfill alone
+	SaveSync(ctx context.Context, s *entity.ProviderSyncState) error
+	// StartBackfill records b unless a backfill is running and before its deadline
+	StartBackfill(ctx context.Context, provider string, b *entity.ProviderBackfill) (bool, error)
+	SaveBackfill(ctx context.Context, provider string, b *entity.ProviderBackfill) error
+}
+
+// ProviderSyncResult summarizes one incremental sync.
+type ProviderSyncResult struct {
+	Pages    int
+	Fetched  int
+	Ingested []string
+	Skipped  int
+	CaughtUp bool
+}
+
+// IProviderSyncUsecase pulls provider items newer than the persisted cursor.
+type IProviderSyncUsecase interface {
+	// Sync pages back from the newest item until it reaches the cursor, then
+	// advances the cursor. A sync that fails, or fails to ingest some item,
+	// keeps the old cursor; articles it saved are skipped as duplicates next time.
+	Sync(ctx context.Context) (ProviderSyncResult, error)
+	// StartBackfill re-fetches items published in [from, to) in the
+	// background. It fails with ErrConflict while another backfill runs.
+	StartBackfill(ctx context.Context, from, to time.Time, by string) (*entity.ProviderBackfill, error)
+	State(ctx context.Context) (*entity.ProviderSyncState, error)
+}
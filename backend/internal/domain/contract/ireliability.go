@@ -0,0 +1,45 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// ReportCount sums the reader reports against one source.
+type ReportCount struct {
+	Reports int `bson:"reports" json:"reports"`
+	// Articles is the number of distinct articles reported
+	Articles int `bson:"articles" json:"articles"`
+}
+
+type INewsReportRepository interface {
+	// Create stores a report; a second report of an article by the same user is ErrConflict.
+	Create(ctx context.Context, r *entity.NewsReport) error
+	// CountBySource counts the reports made since the given time, keyed by source ID.
+	CountBySource(ctx context.Context, since time.Time) (map[string]ReportCount, error)
+}
+
+// ReliabilityRunResult summarizes one scoring pass.
+type ReliabilityRunResult struct {
+	Sources    int `json:"sources"`
+	Scored     int `json:"scored"`
+	Overridden int `json:"overridden"`
+	Failed     int `json:"failed"`
+}
+
+// IReliabilityUsecase scores sources from their ingestion health, article
+// quality and reader reports; editors may override a score.
+type IReliabilityUsecase interface {
+	// List returns every source, least reliable first.
+	List(ctx context.Context) ([]entity.Source, error)
+	// RecomputeAll rescores every source.
+	RecomputeAll(ctx context.Context) (ReliabilityRunResult, error)
+	// Recompute rescores one source now.
+	Recompute(ctx context.Context, slug string) (*entity.Source, error)
+	// SetOverride pins the score of a source; a nil score removes the override.
+	SetOverride(ctx context.Context, slug string, score *float64, note, userID string) (*entity.Source, error)
+	// Report records a reader's complaint about an article.
+	Report(ctx context.Context, userID, newsID string, reason entity.ReportReason, note string) (*entity.NewsReport, error)
+}
@@ -0,0 +1,59 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// IReprocessJobRepository persists reprocess jobs. The lease on the job
+// document elects the runner, as for scheduled jobs.
+type IReprocessJobRepository interface {
+	Create(ctx context.Context, j *entity.ReprocessJob) error
+	Get(ctx context.Context, id string) (*entity.ReprocessJob, error)
+	// List returns the newest jobs, only those with status when it is set
+	List(ctx context.Context, status string, limit int) ([]*entity.ReprocessJob, error)
+	// Claim takes the lease of a running job unless a live runner holds it
+	Claim(ctx context.Context, id, owner string, until time.Time) (bool, error)
+	// SaveProgress records the progress of j and extends the lease held by
+	// owner. It returns the stored status, so the runner sees pause and
+	// cancel requests, and ErrConflict when owner lost the lease.
+	SaveProgress(ctx context.Context, j *entity.ReprocessJob, owner string, until time.Time) (string, error)
+	// Release records the final progress and status of j and frees the lease held by owner
+	Release(ctx context.Context, j *entity.ReprocessJob, owner string) error
+	// SetStatus moves a job whose status is one of from to status
+	SetStatus(ctx context.Context, id, status string, from []string) (bool, error)
+}
+
+// ReprocessRequest selects articles and the stages to run on them.
+type ReprocessRequest struct {
+	Filter entity.ReprocessFilter
+	Stages []string
+	// RatePerMin caps the articles processed per minute (default 30)
+	RatePerMin int
+}
+
+// IReprocessUsecase re-runs pipeline stages over stored articles as a
+// throttled, resumable job.
+type IReprocessUsecase interface {
+	// Create validates the request, counts the matching articles and stores
+	// the job without running it.
+	Create(ctx context.Context, req ReprocessRequest, by string) (*entity.ReprocessJob, error)
+	// Start creates a job and runs it in the background.
+	Start(ctx context.Context, req ReprocessRequest, by string) (*entity.ReprocessJob, error)
+	// Run processes a job until it is done, paused or cancelled, or ctx ends;
+	// a job cut off by ctx is paused. Paused jobs are resumed first. It fails
+	// with ErrConflict while another runner holds the job.
+	Run(ctx context.Context, id string) (*entity.ReprocessJob, error)
+	Get(ctx context.Context, id string) (*entity.ReprocessJob, error)
+	List(ctx context.Context, status string, limit int) ([]*entity.ReprocessJob, error)
+	// Pause stops a running job when it next saves its progress, within seconds.
+	Pause(ctx context.Context, id string) (*entity.ReprocessJob, error)
+	// Resume continues a paused, failed or abandoned job in the background.
+	Resume(ctx context.Context, id string) (*entity.ReprocessJob, error)
+	// Cancel stops a job for good; articles already processed keep their changes.
+	Cancel(ctx context.Context, id string) (*entity.ReprocessJob, error)
+	// ResumeStale restarts running jobs whose runner died and returns how many it restarted.
+	ResumeStale(ctx context.Context) (int, error)
+}
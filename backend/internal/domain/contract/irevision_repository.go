@@ -0,0 +1,13 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+type IRevisionRepository interface {
+	Save(ctx context.Context, rev *entity.FieldRevision) error
+	// ListByNews returns revisions newest first, optionally for one field only.
+	ListByNews(ctx context.Context, newsID, field string) ([]*entity.FieldRevision, error)
+}
@@ -0,0 +1,40 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+type ISafetyEventRepository interface {
+	Save(ctx context.Context, e *entity.SafetyEvent) error
+	// List returns events newest first, optionally filtered by stage.
+	List(ctx context.Context, stage entity.SafetyStage, page, limit int) ([]*entity.SafetyEvent, int64, int, error)
+}
+
+// ISafetyGuard protects the AI features from prompt injection and unsafe
+// output. Every match is logged as a SafetyEvent.
+type ISafetyGuard interface {
+	// Isolate prepares untrusted text (scraped article content) for a prompt:
+	// injection attempts are redacted and the text is enclosed in delimiters
+	// the model is told to treat as data.
+	Isolate(ctx context.Context, feature, key, text string) string
+	// Redact removes injection attempts from untrusted text without adding
+	// delimiters and reports whether it found any.
+	Redact(ctx context.Context, feature, key, text string) (string, bool)
+	// CheckInput returns ErrUnsafeContent when a user message tries to
+	// override the instructions or asks for harmful content.
+	CheckInput(ctx context.Context, feature, key, message string) error
+	// ModerateOutput returns ErrUnsafeContent when a model reply leaks the
+	// prompt or contains harmful content.
+	ModerateOutput(ctx context.Context, feature, key, reply string) error
+	// Refusal is the message shown instead of a blocked answer, in the given language.
+	Refusal(lang string) string
+	// Log records an event detected elsewhere, e.g. a reply blocked by the model's own filters.
+	Log(ctx context.Context, e *entity.SafetyEvent)
+}
+
+type ISafetyUsecase interface {
+	ISafetyGuard
+	ListEvents(ctx context.Context, stage entity.SafetyStage, page, limit int) ([]*entity.SafetyEvent, int64, int, error)
+}
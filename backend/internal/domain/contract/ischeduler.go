@@ -0,0 +1,59 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// JobFunc performs one run of a scheduled job and returns a short summary
+// for the job's status.
+type JobFunc func(ctx context.Context) (string, error)
+
+// JobSpec registers a named job with the scheduler.
+type JobSpec struct {
+	Name string
+	// Schedule is a cron expression evaluated in the scheduler's timezone
+	Schedule    string
+	Description string
+	// Timeout bounds one run; the cluster lease lasts a little longer
+	Timeout time.Duration
+	Run     JobFunc
+}
+
+// IJobRepository persists job state and the per-job lease that elects the
+// replica running each firing.
+type IJobRepository interface {
+	// Ensure creates the job document if missing and records its schedule
+	Ensure(ctx context.Context, name, schedule string) error
+	Get(ctx context.Context, name string) (*entity.ScheduledJob, error)
+	List(ctx context.Context) ([]*entity.ScheduledJob, error)
+	SetPaused(ctx context.Context, name string, paused bool, by string) error
+	// Claim takes the lease for the firing at tick unless another replica holds
+	// it or already claimed that tick. Paused jobs are only claimed when force is set.
+	Claim(ctx context.Context, name, owner string, tick, leaseUntil time.Time, force bool) (bool, error)
+	// Finish records the run and releases the lease held by owner.
+	Finish(ctx context.Context, name, owner string, run entity.JobRun) error
+}
+
+// JobStatus is a registered job with its persisted state.
+type JobStatus struct {
+	entity.ScheduledJob
+	Description string
+	Timezone    string
+	NextRunAt   *time.Time
+	Running     bool
+}
+
+// IScheduler fires registered jobs on their cron schedules, once per cluster.
+type IScheduler interface {
+	Register(spec JobSpec) error
+	// Start runs the scheduling loop until ctx ends.
+	Start(ctx context.Context)
+	List(ctx context.Context) ([]JobStatus, error)
+	SetPaused(ctx context.Context, name string, paused bool, by string) (*JobStatus, error)
+	// Trigger starts a run now in the background, even when the job is paused.
+	// It fails with ErrConflict while a run is in progress.
+	Trigger(ctx context.Context, name, by string) (*JobStatus, error)
+}
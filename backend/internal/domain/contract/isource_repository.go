@@ -16,4 +16,10 @@ type ISourceRepository interface {
 
 	// GetAll retrieves all sources from the collection.
 	GetAll(ctx context.Context) ([]entity.Source, error)
+	// UpdateFeeds replaces the feed URLs and poll interval of a source.
+	UpdateFeeds(ctx context.Context, slug string, urls []string, intervalMinutes int) error
+	// UpdateReliability stores the score of a source and its breakdown.
+	UpdateReliability(ctx context.Context, slug string, score float64, breakdown *entity.ReliabilityBreakdown) error
+	// SetReliabilityOverride sets or, when nil, removes the editorial override.
+	SetReliabilityOverride(ctx context.Context, slug string, override *entity.ReliabilityOverride) error
 }
@@ -0,0 +1,27 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// IStoryRepository persists story clusters.
+type IStoryRepository interface {
+	Save(ctx context.Context, story *entity.Story) error
+	// Update replaces the story unless it was written since it was read, in
+	// which case it returns ErrConflict; it bumps story.Version on success.
+	Update(ctx context.Context, story *entity.Story) error
+	// SetSynthesis stores the neutral headline and summary of a story with the
+	// version of the prompt that wrote them.
+	SetSynthesis(ctx context.Context, id string, headline, summary entity.BilingualField, promptVersion string) error
+	FindByID(ctx context.Context, id string) (*entity.Story, error)
+	// FindActiveSince returns stories that received an article at or after since.
+	FindActiveSince(ctx context.Context, since time.Time) ([]*entity.Story, error)
+	// List returns stories updated at or after since (zero = all), newest first, paginated.
+	List(ctx context.Context, since time.Time, page, limit int) ([]*entity.Story, int64, int, error)
+	// ListTrending returns stories active since the given time, those covered by the most
+	// sources first, then by the most articles, then the newest.
+	ListTrending(ctx context.Context, since time.Time, page, limit int) ([]*entity.Story, int64, int, error)
+}
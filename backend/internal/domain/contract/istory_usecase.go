@@ -0,0 +1,21 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// IStoryUsecase clusters incoming articles into stories and serves them.
+type IStoryUsecase interface {
+	// AssignNews attaches a saved (and embedded) article to a matching story or starts a new one.
+	AssignNews(ctx context.Context, news *entity.News) (*entity.Story, error)
+	// GetStory returns a story with its member articles (newest first).
+	GetStory(ctx context.Context, id string) (*entity.Story, []*entity.News, error)
+	// ListStories returns stories active since the given time (zero = all), newest first.
+	ListStories(ctx context.Context, since time.Time, page, limit int) ([]*entity.Story, int64, int, error)
+	// TrendingStories returns stories active since the given time (zero = the last 48 hours),
+	// those covered by the most sources first.
+	TrendingStories(ctx context.Context, since time.Time, page, limit int) ([]*entity.Story, int64, int, error)
+}
@@ -0,0 +1,19 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// ISummaryRepository persists generated summary variants per news, type and language.
+type ISummaryRepository interface {
+	// Upsert stores the summary, replacing any existing variant for the same news/type/language.
+	Upsert(ctx context.Context, summary *entity.Summary) error
+	// Find returns the cached variant or ErrNotFound.
+	Find(ctx context.Context, newsID string, summaryType entity.SummaryType, lang string) (*entity.Summary, error)
+	// ListByNews returns all cached variants for a news item.
+	ListByNews(ctx context.Context, newsID string) ([]entity.Summary, error)
+	// DeleteByNews drops the cached variants of a news item whose text was rewritten.
+	DeleteByNews(ctx context.Context, newsID string) error
+}
@@ -0,0 +1,15 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+type ITranslationMemoryRepository interface {
+	// Upsert stores the entry, replacing the target of an existing entry with the same key and direction.
+	Upsert(ctx context.Context, e *entity.TranslationMemoryEntry) error
+	// Find returns ErrNotFound when no entry matches the key.
+	Find(ctx context.Context, sourceLang, targetLang, key string) (*entity.TranslationMemoryEntry, error)
+	List(ctx context.Context, sourceLang, targetLang string, limit int) ([]*entity.TranslationMemoryEntry, error)
+}
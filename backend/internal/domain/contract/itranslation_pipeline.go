@@ -0,0 +1,20 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// ITranslationPipeline translates bilingual news fields in the background.
+type ITranslationPipeline interface {
+	// Enqueue marks every empty counterpart field as pending; the caller persists the news.
+	Enqueue(news *entity.News)
+	// ProcessDue translates pending fields and retries failed ones whose backoff elapsed,
+	// returning the number of articles processed.
+	ProcessDue(ctx context.Context, limit int) (int, error)
+	// Retranslate translates the given counterpart fields now, replacing machine
+	// translations; fields corrected by an editor are kept. Fields that fail are
+	// left to ProcessDue. It returns the fields it changed; the caller persists the news.
+	Retranslate(ctx context.Context, news *entity.News, fields []string) []string
+}
@@ -0,0 +1,87 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// UsageActor identifies whom an AI call is made for: a background job, an
+// authenticated user or an anonymous client (by IP address). A job started by
+// an admin carries both and is budgeted as the job.
+type UsageActor struct {
+	UserID   string
+	ClientIP string
+	Job      string
+}
+
+// Key returns the budget bucket of the actor, or "" when it is unknown.
+func (a UsageActor) Key() string {
+	switch {
+	case a.Job != "":
+		return "job:" + a.Job
+	case a.UserID != "":
+		return "user:" + a.UserID
+	case a.ClientIP != "":
+		return "ip:" + a.ClientIP
+	}
+	return ""
+}
+
+type usageActorKey struct{}
+
+type usageOperationKey struct{}
+
+// WithUsageActor attributes AI calls made with the returned context to the actor.
+func WithUsageActor(ctx context.Context, actor UsageActor) context.Context {
+	return context.WithValue(ctx, usageActorKey{}, actor)
+}
+
+// UsageActorFrom returns the actor attached to ctx (zero value if none).
+func UsageActorFrom(ctx context.Context) UsageActor {
+	a, _ := ctx.Value(usageActorKey{}).(UsageActor)
+	return a
+}
+
+// WithUsageOperation labels AI calls made with the returned context with the
+// feature that triggered them, e.g. "summary_bullets" or "story_summary".
+func WithUsageOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, usageOperationKey{}, operation)
+}
+
+// UsageOperationFrom returns the operation attached to ctx, or fallback.
+func UsageOperationFrom(ctx context.Context, fallback string) string {
+	if op, ok := ctx.Value(usageOperationKey{}).(string); ok && op != "" {
+		return op
+	}
+	return fallback
+}
+
+// IUsageMeter enforces AI budgets and records consumption. It is called by the
+// Gemini and translation clients around every upstream request.
+type IUsageMeter interface {
+	// Allow returns ErrBudgetExceeded when the actor in ctx or the whole service
+	// has used up today's budget.
+	Allow(ctx context.Context) error
+	// Record stores a finished call, filling the actor and operation from ctx.
+	Record(ctx context.Context, usage *entity.LLMUsage)
+}
+
+type IUsageRepository interface {
+	Save(ctx context.Context, usage *entity.LLMUsage) error
+	// IncrementDaily adds calls and tokens to the day's counters of the given keys.
+	IncrementDaily(ctx context.Context, day string, keys []string, calls, tokens int64) error
+	// GetDaily returns the day's counters of the given keys; missing keys are zero.
+	GetDaily(ctx context.Context, day string, keys []string) (map[string]entity.UsageCounter, error)
+	// Report aggregates usage by day, kind and operation between from and to (inclusive days).
+	Report(ctx context.Context, from, to string) ([]entity.UsageReportRow, error)
+	// TopConsumers returns the actors with the most tokens between from and to.
+	TopConsumers(ctx context.Context, from, to string, limit int) ([]entity.UsageConsumer, error)
+}
+
+type IUsageUsecase interface {
+	IUsageMeter
+	// Report summarizes usage by feature and day for the admin dashboard.
+	Report(ctx context.Context, from, to time.Time) (entity.UsageReport, error)
+}
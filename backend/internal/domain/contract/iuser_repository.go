@@ -31,5 +31,10 @@ type IUserRepository interface {
 	// UnsubscribeTopic pulls a topic ID from preferences.topics
 	UnsubscribeTopic(ctx context.Context, userID, topicID string) error
 	GetUserSubscribedTopicsByID(ctx context.Context, userID string) ([]string, error)
+	// FollowEntities adds entity IDs to preferences.entities using $addToSet with $each
+	FollowEntities(ctx context.Context, userID string, entityIDs []string) error
+	// UnfollowEntity pulls an entity ID from preferences.entities
+	UnfollowEntity(ctx context.Context, userID, entityID string) error
+	GetFollowedEntityIDs(ctx context.Context, userID string) ([]string, error)
 	// UnsubscribeTopic(ctx context.Context, userID, topicSlug string) error
 }
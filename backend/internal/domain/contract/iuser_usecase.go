@@ -18,6 +18,8 @@ type IUserUseCase interface {
 	Logout(ctx context.Context, refreshToken string) error
 	PromoteUser(ctx context.Context, userID string) (*entity.User, error)
 	DemoteUser(ctx context.Context, userID string) (*entity.User, error)
+	// SetRole assigns any known role (user, editor, admin) to a user.
+	SetRole(ctx context.Context, userID string, role entity.UserRole) (*entity.User, error)
 	UpdateProfile(ctx context.Context, userID string, updates map[string]interface{}) (*entity.User, error)
 	LoginWithOAuth(ctx context.Context, fullname, email string) (string, string, error)
 	GetUserByID(ctx context.Context, userID string) (*entity.User, error)
@@ -0,0 +1,47 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// IEmbeddingClient turns text into a dense vector.
+type IEmbeddingClient interface {
+	Embed(ctx context.Context, text string) ([]float32, error)
+	// EmbeddingModel returns the embedding model name stored with each vector
+	EmbeddingModel() string
+}
+
+// IVectorIndex stores news embeddings and answers nearest-neighbour queries.
+type IVectorIndex interface {
+	Upsert(ctx context.Context, e *entity.NewsEmbedding) error
+	// Search returns up to k matches ordered by descending similarity
+	Search(ctx context.Context, vector []float32, k int, filter VectorFilter) ([]VectorMatch, error)
+	// Get returns the stored embedding of a news item or ErrNotFound.
+	Get(ctx context.Context, newsID string) (*entity.NewsEmbedding, error)
+	// ExistingIDs reports which of the given news IDs already have an embedding.
+	ExistingIDs(ctx context.Context, newsIDs []string) (map[string]bool, error)
+}
+
+// VectorFilter narrows a similarity search.
+type VectorFilter struct {
+	// PublishedAfter excludes older articles when non-zero
+	PublishedAfter time.Time
+	// PublishedBefore excludes newer articles when non-zero
+	PublishedBefore time.Time
+	// ExcludeNewsIDs removes specific articles from the results
+	ExcludeNewsIDs []string
+	// ExcludeSourceURL removes articles pointing at the same original URL
+	ExcludeSourceURL string
+}
+
+// VectorMatch is a single search hit.
+type VectorMatch struct {
+	NewsID      string
+	Title       string
+	SourceURL   string
+	PublishedAt time.Time
+	Score       float64
+}
@@ -1,11 +1,16 @@
 package contract
 
 import (
+	"context"
+
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
 )
 
 type ISummarizerService interface {
 	// Summarize generates a summary for a news item identified by its ID,
 	// updates the stored record, and returns the created summary metadata.
-	Summarize(newsID string) (entity.Summary, error)
+	Summarize(ctx context.Context, newsID string) (entity.Summary, error)
+	// GetSummary returns the requested summary variant in the given language,
+	// generating and caching it on first request.
+	GetSummary(ctx context.Context, newsID string, summaryType entity.SummaryType, lang string) (entity.Summary, error)
 }
@@ -1,10 +1,12 @@
 package contract
 
 import (
+	"context"
+
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
 )
 
 type ITranslatorService interface {
-	Translate(text, sourceLang, targetLang string) (string, error)
-	TranslateNews(news entity.News) (entity.News, error)
+	Translate(ctx context.Context, text, sourceLang, targetLang string) (string, error)
+	TranslateNews(ctx context.Context, news entity.News) (entity.News, error)
 }
\ No newline at end of file
@@ -0,0 +1,67 @@
+package entity
+
+import "time"
+
+// APIKeyKind selects how a machine client authenticates.
+type APIKeyKind string
+
+const (
+	// APIKeyBearer keys are sent as-is in the X-API-Key header; only a hash is stored.
+	APIKeyBearer APIKeyKind = "bearer"
+	// APIKeySigned keys sign each request body with HMAC-SHA256; the secret is
+	// stored encrypted because the server needs it to verify signatures.
+	APIKeySigned APIKeyKind = "signed"
+)
+
+// API key scopes.
+const (
+	// ScopeNewsIngest allows pushing articles to POST /news/ingest
+	ScopeNewsIngest = "news:ingest"
+)
+
+// APIKeyScopes lists the scopes a key may be issued with.
+var APIKeyScopes = []string{ScopeNewsIngest}
+
+// APIKey is a credential for an integration such as the news-provider service
+// or a partner newsroom. The secret is shown once when the key is issued.
+// It maps to a document in the 'api_keys' collection.
+type APIKey struct {
+	ID   string     `bson:"_id,omitempty" json:"id"`
+	Name string     `bson:"name" json:"name"`
+	Kind APIKeyKind `bson:"kind" json:"kind"`
+	// Prefix is the public identifier, e.g. "nbk_3f9a1c2e"; the full key is "<prefix>_<secret>"
+	Prefix string `bson:"prefix" json:"prefix"`
+	// SecretHash is the SHA-256 of the secret (bearer keys)
+	SecretHash string `bson:"secret_hash,omitempty" json:"-"`
+	// SecretSealed is the encrypted secret (signed keys)
+	SecretSealed string   `bson:"secret_sealed,omitempty" json:"-"`
+	Scopes       []string `bson:"scopes" json:"scopes"`
+	// SourceID, when set, restricts pushed articles to one source
+	SourceID   string     `bson:"source_id,omitempty" json:"source_id,omitempty"`
+	CreatedBy  string     `bson:"created_by,omitempty" json:"created_by,omitempty"`
+	CreatedAt  time.Time  `bson:"created_at" json:"created_at"`
+	ExpiresAt  *time.Time `bson:"expires_at,omitempty" json:"expires_at,omitempty"`
+	RevokedAt  *time.Time `bson:"revoked_at,omitempty" json:"revoked_at,omitempty"`
+	LastUsedAt *time.Time `bson:"last_used_at,omitempty" json:"last_used_at,omitempty"`
+	LastUsedIP string     `bson:"last_used_ip,omitempty" json:"last_used_ip,omitempty"`
+	// RotatedTo is the ID of the key that replaced this one
+	RotatedTo string `bson:"rotated_to,omitempty" json:"rotated_to,omitempty"`
+}
+
+// Active reports whether the key may be used at the given time.
+func (k *APIKey) Active(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+// HasScope reports whether the key was issued with the scope.
+func (k *APIKey) HasScope(scope string) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -3,9 +3,66 @@ package entity
 import "time"
 
 type ChatMessage struct {
-	ID string `bson:"_id,omitempty" json:"id"`
-	ContextID string `bson:"context_id,omitempty" json:"context_id,omitempty"`
-	Role string `bson:"role" json:"role"`
-	Text string `bson:"text" json:"text"`
+	ID        string    `bson:"_id,omitempty" json:"id"`
+	ContextID string    `bson:"context_id,omitempty" json:"context_id,omitempty"`
+	Role      string    `bson:"role" json:"role"`
+	Text      string    `bson:"text" json:"text"`
 	Timestamp time.Time `bson:"timestamp" json:"timestamp"`
-}
\ No newline at end of file
+}
+
+// Citation references a news article used to ground a chatbot answer.
+type Citation struct {
+	NewsID    string `json:"news_id"`
+	Title     string `json:"title"`
+	SourceURL string `json:"source_url,omitempty"`
+	// Source is the outlet name, set when answers are attributed per source
+	Source string `json:"source,omitempty"`
+}
+
+// ChatReply is the chatbot answer together with the articles it relied on.
+// Refused replies are a fixed refusal from the safety guard.
+type ChatReply struct {
+	Reply     string     `json:"reply"`
+	Citations []Citation `json:"citations,omitempty"`
+	Refused   bool       `json:"refused,omitempty"`
+	// FollowUps are questions the user may ask next, in the language of the reply
+	FollowUps []string `json:"follow_ups,omitempty"`
+}
+
+// SuggestedQuestions are starter questions for the chat about one article,
+// generated once in both languages and cached on the article.
+type SuggestedQuestions struct {
+	EN            []string  `bson:"en" json:"en"`
+	AM            []string  `bson:"am" json:"am"`
+	PromptVersion string    `bson:"prompt_version,omitempty" json:"prompt_version,omitempty"`
+	GeneratedAt   time.Time `bson:"generated_at" json:"generated_at"`
+}
+
+// ForLanguage returns the questions in the given language ("en" or "am").
+func (q SuggestedQuestions) ForLanguage(lang string) []string {
+	if lang == "am" {
+		return q.AM
+	}
+	return q.EN
+}
+
+// CoverageComparison contrasts how several sources reported the same event.
+type CoverageComparison struct {
+	Event       string           `json:"event"`
+	Summary     string           `json:"summary"`
+	Agreements  []string         `json:"agreements"`
+	Differences []string         `json:"differences"`
+	Sources     []SourceCoverage `json:"sources"`
+	Language    string           `json:"language"`
+}
+
+// SourceCoverage is one source's take on the compared event.
+type SourceCoverage struct {
+	NewsID     string `json:"news_id"`
+	Title      string `json:"title"`
+	SourceID   string `json:"source_id,omitempty"`
+	SourceName string `json:"source_name,omitempty"`
+	SourceURL  string `json:"source_url,omitempty"`
+	// Emphasis is what this source foregrounds compared to the others
+	Emphasis string `json:"emphasis"`
+}
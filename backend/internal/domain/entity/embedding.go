@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// NewsEmbedding is the vector representation of a news article used for
+// semantic retrieval. It maps to a document in the 'news_embeddings' collection
+// and carries enough metadata to filter and cite results without a news lookup.
+type NewsEmbedding struct {
+	ID          string    `bson:"_id" json:"id"` // same as NewsID
+	NewsID      string    `bson:"news_id" json:"news_id"`
+	Title       string    `bson:"title" json:"title"`
+	SourceID    string    `bson:"source_id,omitempty" json:"source_id,omitempty"`
+	SourceURL   string    `bson:"source_url,omitempty" json:"source_url,omitempty"`
+	Language    string    `bson:"language" json:"language"`
+	Vector      []float32 `bson:"vector" json:"-"`
+	Model       string    `bson:"model" json:"model"`
+	PublishedAt time.Time `bson:"published_at" json:"published_at"`
+	CreatedAt   time.Time `bson:"created_at" json:"created_at"`
+}
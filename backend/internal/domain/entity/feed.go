@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// FeedState tracks the polling of one source feed: the validators used for
+// conditional GET and the outcome of the last poll. It maps to a document in
+// the 'feed_states' collection, keyed by the feed URL.
+type FeedState struct {
+	URL          string    `bson:"_id" json:"url"`
+	SourceID     string    `bson:"source_id" json:"source_id"`
+	ETag         string    `bson:"etag,omitempty" json:"etag,omitempty"`
+	LastModified string    `bson:"last_modified,omitempty" json:"last_modified,omitempty"`
+	LastPolledAt time.Time `bson:"last_polled_at" json:"last_polled_at"`
+	// LastStatus is "ok", "not_modified" or "error"
+	LastStatus string `bson:"last_status" json:"last_status"`
+	LastError  string `bson:"last_error,omitempty" json:"last_error,omitempty"`
+	// Failures counts consecutive failed polls
+	Failures      int `bson:"failures" json:"failures"`
+	LastItems     int `bson:"last_items" json:"last_items"`
+	LastIngested  int `bson:"last_ingested" json:"last_ingested"`
+	TotalIngested int `bson:"total_ingested" json:"total_ingested"`
+	// TotalPolls and TotalFailures count every poll since the feed was added
+	TotalPolls    int `bson:"total_polls" json:"total_polls"`
+	TotalFailures int `bson:"total_failures" json:"total_failures"`
+}
@@ -0,0 +1,72 @@
+package entity
+
+import "time"
+
+// Preview item statuses
+const (
+	PreviewPending = "pending"
+	PreviewSaved   = "saved"
+	// PreviewDuplicate items were stored by another run before approval
+	PreviewDuplicate = "duplicate"
+	PreviewFailed    = "failed"
+)
+
+// IngestionPreview is the outcome of a dry-run ingestion: the articles that
+// would be saved and the topics and sources that would be created, none of
+// them persisted yet. Admins approve a subset of the items to save them. It
+// maps to a document in the 'ingestion_previews' collection, removed once
+// expired.
+type IngestionPreview struct {
+	ID          string        `bson:"_id" json:"id"`
+	Query       string        `bson:"query" json:"query"`
+	TopK        int           `bson:"top_k" json:"top_k"`
+	RequestedBy string        `bson:"requested_by,omitempty" json:"requested_by,omitempty"`
+	Items       []PreviewItem `bson:"items" json:"items"`
+	// Skipped lists the fetched items that would not be ingested, with the reason
+	Skipped []PreviewSkip `bson:"skipped" json:"skipped"`
+	// TopicsToCreate and SourcesToCreate are the side effects of saving every item
+	TopicsToCreate  []PlannedTopic  `bson:"topics_to_create" json:"topics_to_create"`
+	SourcesToCreate []PlannedSource `bson:"sources_to_create" json:"sources_to_create"`
+	CreatedAt       time.Time       `bson:"created_at" json:"created_at"`
+	ExpiresAt       time.Time       `bson:"expires_at" json:"expires_at"`
+	// ApprovingUntil is set while an approval saves items, so two approvals cannot overlap
+	ApprovingUntil *time.Time `bson:"approving_until,omitempty" json:"-"`
+}
+
+// PreviewItem is one would-be article and the catalog entries it needs. The
+// article keeps its ID when approved, so items are selected by news ID.
+type PreviewItem struct {
+	News   News           `bson:"news" json:"news"`
+	Topics []PlannedTopic `bson:"topics" json:"topics"`
+	Source *PlannedSource `bson:"source,omitempty" json:"source,omitempty"`
+	Status string         `bson:"status" json:"status"`
+	Error  string         `bson:"error,omitempty" json:"error,omitempty"`
+}
+
+// PreviewSkip is a fetched item left out of the preview.
+type PreviewSkip struct {
+	Title     string `bson:"title" json:"title"`
+	SourceURL string `bson:"source_url,omitempty" json:"source_url,omitempty"`
+	// Reason is "already_stored", "repeated_in_batch", "summary_failed" or "timed_out"
+	Reason string `bson:"reason" json:"reason"`
+}
+
+// PlannedTopic is a topic an article would be tagged with; Create marks one
+// that does not exist yet.
+type PlannedTopic struct {
+	ID     string         `bson:"id" json:"id"`
+	Slug   string         `bson:"slug" json:"slug"`
+	Label  BilingualField `bson:"label" json:"label"`
+	Create bool           `bson:"create" json:"create"`
+}
+
+// PlannedSource is the source an article would be attributed to; Create marks
+// one that does not exist yet.
+type PlannedSource struct {
+	ID        string       `bson:"id" json:"id"`
+	Slug      string       `bson:"slug" json:"slug"`
+	Name      string       `bson:"name" json:"name"`
+	URL       string       `bson:"url" json:"url"`
+	Languages LanguageType `bson:"languages" json:"languages"`
+	Create    bool         `bson:"create" json:"create"`
+}
@@ -0,0 +1,84 @@
+package entity
+
+import "time"
+
+// UsageKind separates the upstream services whose calls are metered.
+type UsageKind string
+
+const (
+	// UsageLLM is a Gemini generateContent call; token counts come from usageMetadata.
+	UsageLLM UsageKind = "llm"
+	// UsageEmbedding is a Gemini embedContent call.
+	UsageEmbedding UsageKind = "embedding"
+	// UsageTranslation is a call to a machine translation backend; it is measured in characters.
+	UsageTranslation UsageKind = "translation"
+)
+
+// UsageDayLayout formats the UTC day buckets used by budgets and reports.
+const UsageDayLayout = "2006-01-02"
+
+// LLMUsage records one metered call to an AI service.
+// It maps to a document in the 'llm_usage' collection.
+type LLMUsage struct {
+	ID        string    `bson:"_id,omitempty" json:"id"`
+	Kind      UsageKind `bson:"kind" json:"kind"`
+	Operation string    `bson:"operation" json:"operation"`
+	// Provider is the model or translation backend that served the call
+	Provider string `bson:"provider" json:"provider"`
+	UserID   string `bson:"user_id,omitempty" json:"user_id,omitempty"`
+	ClientIP string `bson:"client_ip,omitempty" json:"client_ip,omitempty"`
+	Job      string `bson:"job,omitempty" json:"job,omitempty"`
+
+	PromptTokens int `bson:"prompt_tokens" json:"prompt_tokens"`
+	OutputTokens int `bson:"output_tokens" json:"output_tokens"`
+	TotalTokens  int `bson:"total_tokens" json:"total_tokens"`
+	Characters   int `bson:"characters,omitempty" json:"characters,omitempty"`
+
+	LatencyMS int64     `bson:"latency_ms" json:"latency_ms"`
+	Success   bool      `bson:"success" json:"success"`
+	Error     string    `bson:"error,omitempty" json:"error,omitempty"`
+	Day       string    `bson:"day" json:"day"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+// UsageCounter is the running daily total of one budget bucket
+// ("global", "user:<id>", "ip:<addr>" or "job:<name>").
+type UsageCounter struct {
+	Key    string `bson:"key" json:"key"`
+	Day    string `bson:"day" json:"day"`
+	Calls  int64  `bson:"calls" json:"calls"`
+	Tokens int64  `bson:"tokens" json:"tokens"`
+}
+
+// UsageReportRow aggregates usage of one operation on one day.
+type UsageReportRow struct {
+	Day          string    `bson:"day" json:"day"`
+	Kind         UsageKind `bson:"kind" json:"kind"`
+	Operation    string    `bson:"operation" json:"operation"`
+	Calls        int64     `bson:"calls" json:"calls"`
+	Errors       int64     `bson:"errors" json:"errors"`
+	PromptTokens int64     `bson:"prompt_tokens" json:"prompt_tokens"`
+	OutputTokens int64     `bson:"output_tokens" json:"output_tokens"`
+	TotalTokens  int64     `bson:"total_tokens" json:"total_tokens"`
+	Characters   int64     `bson:"characters" json:"characters"`
+	AvgLatencyMS float64   `bson:"avg_latency_ms" json:"avg_latency_ms"`
+}
+
+// UsageConsumer is the total consumption of one user, client or job in a period.
+type UsageConsumer struct {
+	Key         string `bson:"_id" json:"key"`
+	Calls       int64  `bson:"calls" json:"calls"`
+	TotalTokens int64  `bson:"total_tokens" json:"total_tokens"`
+}
+
+// UsageReport is the admin view of AI consumption in a period.
+type UsageReport struct {
+	From          string           `json:"from"`
+	To            string           `json:"to"`
+	Rows          []UsageReportRow `json:"rows"`
+	TopConsumers  []UsageConsumer  `json:"top_consumers"`
+	TotalCalls    int64            `json:"total_calls"`
+	TotalTokens   int64            `json:"total_tokens"`
+	Today         UsageCounter     `json:"today"`
+	DailyTokenCap int64            `json:"daily_token_cap"`
+}
@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+// NamedEntityType classifies an extracted entity.
+type NamedEntityType string
+
+const (
+	NamedEntityPerson       NamedEntityType = "person"
+	NamedEntityOrganization NamedEntityType = "organization"
+	NamedEntityPlace        NamedEntityType = "place"
+)
+
+// ParseNamedEntityType maps a raw string to a known NamedEntityType.
+func ParseNamedEntityType(s string) (NamedEntityType, bool) {
+	switch NamedEntityType(s) {
+	case NamedEntityPerson, NamedEntityOrganization, NamedEntityPlace:
+		return NamedEntityType(s), true
+	}
+	return "", false
+}
+
+// NamedEntity is a person, organization or place mentioned in the news.
+// It maps to a document in the 'entities' collection; Slug and Type together
+// identify it, and Aliases holds every surface form seen in articles (EN and AM)
+// so that later mentions resolve to the same document.
+type NamedEntity struct {
+	ID           string          `bson:"_id,omitempty" json:"id"`
+	Slug         string          `bson:"slug" json:"slug"`
+	Type         NamedEntityType `bson:"type" json:"type"`
+	Label        BilingualField  `bson:"label" json:"label"`
+	Aliases      []string        `bson:"aliases,omitempty" json:"aliases,omitempty"`
+	ArticleCount int             `bson:"article_count" json:"article_count"`
+	CreatedAt    time.Time       `bson:"created_at" json:"created_at"`
+	UpdatedAt    time.Time       `bson:"updated_at" json:"updated_at"`
+}
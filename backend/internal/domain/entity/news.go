@@ -14,13 +14,38 @@ type News struct {
 	BodyEN  string `bson:"body_en,omitempty" json:"body_en,omitempty"`
 	BodyAM  string `bson:"body_am,omitempty" json:"body_am,omitempty"`
 	// Localized (Ethiopian) date string precomputed
-	PublishedDateLocalized string    `bson:"published_date_localized,omitempty" json:"published_date_localized,omitempty"`
-	SummaryEN              string    `bson:"summary_en,omitempty" json:"summary_en,omitempty"`
-	SummaryAM              string    `bson:"summary_am,omitempty" json:"summary_am,omitempty"`
-	Language               string    `bson:"language" json:"language"`
-	SourceID               string    `bson:"source_id" json:"source_id"`
-	Topics                 []string  `bson:"topics,omitempty" json:"topics,omitempty"`
-	PublishedAt            time.Time `bson:"published_at" json:"published_at"`
-	CreatedAt              time.Time `bson:"created_at" json:"created_at"`
-	UpdatedAt              time.Time `bson:"updated_at" json:"updated_at"`
+	PublishedDateLocalized string   `bson:"published_date_localized,omitempty" json:"published_date_localized,omitempty"`
+	SummaryEN              string   `bson:"summary_en,omitempty" json:"summary_en,omitempty"`
+	SummaryAM              string   `bson:"summary_am,omitempty" json:"summary_am,omitempty"`
+	Language               string   `bson:"language" json:"language"`
+	SourceID               string   `bson:"source_id" json:"source_id"`
+	Topics                 []string `bson:"topics,omitempty" json:"topics,omitempty"`
+	// SummaryPromptVersion is the version of the "summarize" prompt that wrote the original-language summary
+	SummaryPromptVersion string `bson:"summary_prompt_version,omitempty" json:"summary_prompt_version,omitempty"`
+	// Translations records the status of machine-translated fields, keyed by field (e.g. "title_am")
+	Translations map[string]FieldTranslation `bson:"translations,omitempty" json:"translations,omitempty"`
+	// TranslationDueAt is set while some field awaits the translation worker
+	TranslationDueAt *time.Time `bson:"translation_due_at,omitempty" json:"-"`
+	// EntityIDs references the people, organizations and places mentioned
+	EntityIDs []string `bson:"entity_ids,omitempty" json:"entity_ids,omitempty"`
+	// EntityPromptVersion is the version of the "extract_entities" prompt that found them
+	EntityPromptVersion string `bson:"entity_prompt_version,omitempty" json:"entity_prompt_version,omitempty"`
+	// SuggestedQuestions are generated on first request and reused by every reader
+	SuggestedQuestions *SuggestedQuestions `bson:"suggested_questions,omitempty" json:"suggested_questions,omitempty"`
+	// Images are served through the image proxy; the first is the lead image
+	Images []NewsImage `bson:"images,omitempty" json:"images,omitempty"`
+	// StoryID links the article to its multi-source story cluster
+	StoryID     string    `bson:"story_id,omitempty" json:"story_id,omitempty"`
+	PublishedAt time.Time `bson:"published_at" json:"published_at"`
+	CreatedAt   time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt   time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+// NewsImage is a picture of an article as published by the source.
+// Width and Height are in pixels, zero when unknown.
+type NewsImage struct {
+	URL    string `bson:"url" json:"url"`
+	Width  int    `bson:"width,omitempty" json:"width,omitempty"`
+	Height int    `bson:"height,omitempty" json:"height,omitempty"`
+	Alt    string `bson:"alt,omitempty" json:"alt,omitempty"`
 }
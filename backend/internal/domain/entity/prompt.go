@@ -0,0 +1,86 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// PromptOrigin tells built-in templates from admin overrides.
+type PromptOrigin string
+
+const (
+	// PromptBuiltin templates ship with the binary and cannot be edited.
+	PromptBuiltin PromptOrigin = "builtin"
+	// PromptOverride templates are stored in Mongo and take precedence when active.
+	PromptOverride PromptOrigin = "override"
+)
+
+// PromptTemplate is one version of a named prompt. Placeholders are written as
+// {{name}} and must be declared in Variables; Required lists the ones a new
+// version may not drop, Untrusted the ones filled with scraped text that is
+// isolated before rendering. Overrides map to documents in 'prompt_templates'.
+type PromptTemplate struct {
+	ID       string       `bson:"_id,omitempty" json:"id"`
+	Name     string       `bson:"name" json:"name"`
+	Language string       `bson:"language,omitempty" json:"language,omitempty"` // "" applies to every language
+	Version  int          `bson:"version" json:"version"`
+	Text     string       `bson:"text" json:"text"`
+	Origin   PromptOrigin `bson:"origin" json:"origin"`
+	// Active overrides are served; several active versions split traffic by Weight
+	Active    bool      `bson:"active" json:"active"`
+	Weight    int       `bson:"weight" json:"weight"`
+	Variables []string  `bson:"-" json:"variables,omitempty"`
+	Required  []string  `bson:"-" json:"required,omitempty"`
+	Untrusted []string  `bson:"-" json:"untrusted,omitempty"`
+	Note      string    `bson:"note,omitempty" json:"note,omitempty"`
+	CreatedBy string    `bson:"created_by,omitempty" json:"created_by,omitempty"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+// VersionLabel identifies the template on generated content, e.g. "v3".
+func (t PromptTemplate) VersionLabel() string {
+	return fmt.Sprintf("v%d", t.Version)
+}
+
+// RenderedPrompt is a template filled with its variables.
+type RenderedPrompt struct {
+	Name     string
+	Language string
+	Version  string
+	Text     string
+}
+
+var promptPlaceholder = regexp.MustCompile(`\{\{\s*([a-z_]+)\s*\}\}`)
+
+// PromptPlaceholders returns the distinct placeholder names used in text.
+func PromptPlaceholders(text string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, m := range promptPlaceholder.FindAllStringSubmatch(text, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			out = append(out, m[1])
+		}
+	}
+	return out
+}
+
+// Render substitutes every placeholder; a placeholder without a value is an error.
+func (t PromptTemplate) Render(vars map[string]string) (RenderedPrompt, error) {
+	var missing []string
+	text := promptPlaceholder.ReplaceAllStringFunc(t.Text, func(m string) string {
+		name := promptPlaceholder.FindStringSubmatch(m)[1]
+		v, ok := vars[name]
+		if !ok {
+			missing = append(missing, name)
+		}
+		return v
+	})
+	if len(missing) > 0 {
+		return RenderedPrompt{}, fmt.Errorf("prompt %s %s: missing variables %s", t.Name, t.VersionLabel(), strings.Join(missing, ", "))
+	}
+	return RenderedPrompt{Name: t.Name, Language: t.Language, Version: t.VersionLabel(), Text: text}, nil
+}
@@ -0,0 +1,50 @@
+package entity
+
+import "time"
+
+// ProviderSyncState is the high-water mark of incremental provider sync and
+// the progress of the latest backfill. It maps to a document in the
+// 'provider_sync' collection keyed by provider name.
+type ProviderSyncState struct {
+	Provider string `bson:"_id" json:"provider"`
+	// CursorPublishedAt is the newest publish time seen by a completed sync
+	CursorPublishedAt time.Time `bson:"cursor_published_at" json:"cursor_published_at"`
+	// CursorID is the provider ID of the item at the cursor
+	CursorID      string    `bson:"cursor_id,omitempty" json:"cursor_id,omitempty"`
+	LastSyncAt    time.Time `bson:"last_sync_at" json:"last_sync_at"`
+	LastStatus    string    `bson:"last_status,omitempty" json:"last_status,omitempty"`
+	LastError     string    `bson:"last_error,omitempty" json:"last_error,omitempty"`
+	LastPages     int       `bson:"last_pages" json:"last_pages"`
+	LastFetched   int       `bson:"last_fetched" json:"last_fetched"`
+	LastIngested  int       `bson:"last_ingested" json:"last_ingested"`
+	LastSkipped   int       `bson:"last_skipped" json:"last_skipped"`
+	TotalIngested int       `bson:"total_ingested" json:"total_ingested"`
+	// CaughtUp is false when the last sync stopped at the page limit
+	CaughtUp bool              `bson:"caught_up" json:"caught_up"`
+	Backfill *ProviderBackfill `bson:"backfill,omitempty" json:"backfill,omitempty"`
+}
+
+// Backfill statuses
+const (
+	BackfillRunning = "running"
+	BackfillDone    = "done"
+	BackfillFailed  = "failed"
+)
+
+// ProviderBackfill re-fetches a past date range, e.g. to recover a gap left
+// by an outage. It does not move the sync cursor.
+type ProviderBackfill struct {
+	From        time.Time  `bson:"from" json:"from"`
+	To          time.Time  `bson:"to" json:"to"`
+	Status      string     `bson:"status" json:"status"`
+	RequestedBy string     `bson:"requested_by,omitempty" json:"requested_by,omitempty"`
+	StartedAt   time.Time  `bson:"started_at" json:"started_at"`
+	FinishedAt  *time.Time `bson:"finished_at,omitempty" json:"finished_at,omitempty"`
+	// Deadline frees the slot if the replica running the backfill dies
+	Deadline time.Time `bson:"deadline" json:"-"`
+	Pages    int       `bson:"pages" json:"pages"`
+	Fetched  int       `bson:"fetched" json:"fetched"`
+	Ingested int       `bson:"ingested" json:"ingested"`
+	Skipped  int       `bson:"skipped" json:"skipped"`
+	Error    string    `bson:"error,omitempty" json:"error,omitempty"`
+}
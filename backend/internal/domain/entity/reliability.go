@@ -0,0 +1,71 @@
+package entity
+
+import "time"
+
+// ReportReason is why a reader flagged an article.
+type ReportReason string
+
+const (
+	ReportMisleading ReportReason = "misleading"
+	ReportClickbait  ReportReason = "clickbait"
+	ReportInaccurate ReportReason = "inaccurate"
+	ReportSpam       ReportReason = "spam"
+	ReportOffensive  ReportReason = "offensive"
+	ReportOther      ReportReason = "other"
+)
+
+// ValidReportReason reports whether r is one of the known reasons.
+func ValidReportReason(r ReportReason) bool {
+	switch r {
+	case ReportMisleading, ReportClickbait, ReportInaccurate, ReportSpam, ReportOffensive, ReportOther:
+		return true
+	}
+	return false
+}
+
+// NewsReport is a reader's complaint about an article; each reader may report
+// an article once. It maps to a document in the 'news_reports' collection.
+type NewsReport struct {
+	ID       string       `bson:"_id,omitempty" json:"id"`
+	NewsID   string       `bson:"news_id" json:"news_id"`
+	SourceID string       `bson:"source_id" json:"source_id"`
+	UserID   string       `bson:"user_id" json:"user_id"`
+	Reason   ReportReason `bson:"reason" json:"reason"`
+	Note     string       `bson:"note,omitempty" json:"note,omitempty"`
+	// CreatedAt places the report in the scoring window
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+// ReliabilityBreakdown explains a source's reliability score. Score is what
+// ranking uses: the editorial override when one is set, otherwise the
+// computed score.
+type ReliabilityBreakdown struct {
+	Score         float64 `bson:"score" json:"score"`
+	ComputedScore float64 `bson:"computed_score" json:"computed_score"`
+	// Confidence grows from 0 to 1 with the number of articles scored; with
+	// little data the score leans towards the neutral prior
+	Confidence float64                `bson:"confidence" json:"confidence"`
+	WindowDays int                    `bson:"window_days" json:"window_days"`
+	Articles   int                    `bson:"articles" json:"articles"`
+	Components []ReliabilityComponent `bson:"components" json:"components"`
+	ComputedAt time.Time              `bson:"computed_at" json:"computed_at"`
+}
+
+// ReliabilityComponent is one signal of the score, from 0 (bad) to 100 (good).
+// Unavailable components carry no weight.
+type ReliabilityComponent struct {
+	Name      string  `bson:"name" json:"name"`
+	Score     float64 `bson:"score" json:"score"`
+	Weight    float64 `bson:"weight" json:"weight"`
+	Available bool    `bson:"available" json:"available"`
+	Detail    string  `bson:"detail" json:"detail"`
+}
+
+// ReliabilityOverride pins a source's score to an editor's judgement; the
+// computed score is still recorded next to it.
+type ReliabilityOverride struct {
+	Score float64   `bson:"score" json:"score"`
+	Note  string    `bson:"note,omitempty" json:"note,omitempty"`
+	SetBy string    `bson:"set_by,omitempty" json:"set_by,omitempty"`
+	SetAt time.Time `bson:"set_at" json:"set_at"`
+}
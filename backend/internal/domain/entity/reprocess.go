@@ -0,0 +1,109 @@
+package entity
+
+import "time"
+
+// Reprocessing stages, run on each article in this order
+const (
+	StageSummarize    = "summarize"
+	StageClassify     = "classify"
+	StageTranslate    = "translate"
+	StageLocalizeDate = "localize_date"
+	StageEmbed        = "embed"
+)
+
+// ReprocessStages lists the stages in the order they run.
+var ReprocessStages = []string{StageSummarize, StageClassify, StageTranslate, StageLocalizeDate, StageEmbed}
+
+// ValidReprocessStage reports whether s is a known stage.
+func ValidReprocessStage(s string) bool {
+	for _, stage := range ReprocessStages {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
+
+// Fields a reprocessing filter can select articles by absence of
+const (
+	MissingSummary       = "summary"
+	MissingTopics        = "topics"
+	MissingTranslation   = "translation"
+	MissingLocalizedDate = "localized_date"
+)
+
+// ValidMissingField reports whether f can be used in ReprocessFilter.Missing.
+func ValidMissingField(f string) bool {
+	switch f {
+	case MissingSummary, MissingTopics, MissingTranslation, MissingLocalizedDate:
+		return true
+	}
+	return false
+}
+
+// Reprocess job statuses
+const (
+	ReprocessRunning   = "running"
+	ReprocessPaused    = "paused"
+	ReprocessDone      = "done"
+	ReprocessFailed    = "failed"
+	ReprocessCancelled = "cancelled"
+)
+
+// ReprocessFilter selects stored articles. Empty fields do not filter.
+type ReprocessFilter struct {
+	// From and To bound published_at as [From, To)
+	From *time.Time `bson:"from,omitempty" json:"from,omitempty"`
+	To   *time.Time `bson:"to,omitempty" json:"to,omitempty"`
+	// Source and Topic are slugs, resolved to SourceID and TopicID when the job is created
+	Source   string `bson:"source,omitempty" json:"source,omitempty"`
+	SourceID string `bson:"source_id,omitempty" json:"source_id,omitempty"`
+	Topic    string `bson:"topic,omitempty" json:"topic,omitempty"`
+	TopicID  string `bson:"topic_id,omitempty" json:"topic_id,omitempty"`
+	Language string `bson:"language,omitempty" json:"language,omitempty"`
+	// Missing keeps articles lacking this field (one of the Missing* values)
+	Missing string `bson:"missing,omitempty" json:"missing,omitempty"`
+}
+
+// ReprocessJob re-runs pipeline stages over the stored articles matched by a
+// filter. It maps to a document in the 'reprocess_jobs' collection. Articles
+// are visited in ID order and Cursor holds the last one finished, so a
+// paused or interrupted job resumes where it stopped.
+type ReprocessJob struct {
+	ID          string          `bson:"_id" json:"id"`
+	Filter      ReprocessFilter `bson:"filter" json:"filter"`
+	Stages      []string        `bson:"stages" json:"stages"`
+	RatePerMin  int             `bson:"rate_per_min" json:"rate_per_min"`
+	Status      string          `bson:"status" json:"status"`
+	RequestedBy string          `bson:"requested_by,omitempty" json:"requested_by,omitempty"`
+	// Total is the number of matching articles when the job was created
+	Total     int `bson:"total" json:"total"`
+	Processed int `bson:"processed" json:"processed"`
+	Updated   int `bson:"updated" json:"updated"`
+	Failed    int `bson:"failed" json:"failed"`
+	// StageCounts counts the articles each stage changed
+	StageCounts map[string]int `bson:"stage_counts" json:"stage_counts"`
+	Cursor      string         `bson:"cursor,omitempty" json:"-"`
+	// Failures keeps the latest per-article errors
+	Failures   []ReprocessFailure `bson:"failures,omitempty" json:"failures,omitempty"`
+	Error      string             `bson:"error,omitempty" json:"error,omitempty"`
+	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt  time.Time          `bson:"updated_at" json:"updated_at"`
+	FinishedAt *time.Time         `bson:"finished_at,omitempty" json:"finished_at,omitempty"`
+	// LeaseOwner runs the job while LeaseUntil is in the future
+	LeaseOwner string     `bson:"lease_owner,omitempty" json:"-"`
+	LeaseUntil *time.Time `bson:"lease_until,omitempty" json:"-"`
+}
+
+// ReprocessFailure is an article a stage failed on.
+type ReprocessFailure struct {
+	NewsID string    `bson:"news_id" json:"news_id"`
+	Stage  string    `bson:"stage" json:"stage"`
+	Error  string    `bson:"error" json:"error"`
+	At     time.Time `bson:"at" json:"at"`
+}
+
+// Active reports whether a replica is running the job at now.
+func (j *ReprocessJob) Active(now time.Time) bool {
+	return j.Status == ReprocessRunning && j.LeaseUntil != nil && j.LeaseUntil.After(now)
+}
@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// RevisionOrigin says who produced the text of a revision.
+type RevisionOrigin string
+
+const (
+	RevisionMachine RevisionOrigin = "machine"
+	RevisionEditor  RevisionOrigin = "editor"
+)
+
+// FieldRevision is one entry in the edit history of a bilingual news field.
+// It maps to a document in the 'news_revisions' collection.
+type FieldRevision struct {
+	ID       string         `bson:"_id,omitempty" json:"id"`
+	NewsID   string         `bson:"news_id" json:"news_id"`
+	Field    string         `bson:"field" json:"field"`
+	Previous string         `bson:"previous" json:"previous"`
+	Content  string         `bson:"content" json:"content"`
+	Origin   RevisionOrigin `bson:"origin" json:"origin"`
+	// PreviousStatus is the translation status the field had before this edit
+	PreviousStatus TranslationStatus `bson:"previous_status,omitempty" json:"previous_status,omitempty"`
+	EditorID       string            `bson:"editor_id,omitempty" json:"editor_id,omitempty"`
+	Note           string            `bson:"note,omitempty" json:"note,omitempty"`
+	CreatedAt      time.Time         `bson:"created_at" json:"created_at"`
+}
+
+// TranslationMemoryEntry is an editor-approved translation of a phrase. Entries
+// are applied like glossary terms so later translations reuse the correction.
+// It maps to a document in the 'translation_memory' collection.
+type TranslationMemoryEntry struct {
+	ID         string `bson:"_id,omitempty" json:"id"`
+	SourceLang string `bson:"source_lang" json:"source_lang"`
+	TargetLang string `bson:"target_lang" json:"target_lang"`
+	// Key is the lower-cased, whitespace-collapsed source used for lookups
+	Key       string    `bson:"key" json:"-"`
+	Source    string    `bson:"source" json:"source"`
+	Target    string    `bson:"target" json:"target"`
+	EditorID  string    `bson:"editor_id,omitempty" json:"editor_id,omitempty"`
+	NewsID    string    `bson:"news_id,omitempty" json:"news_id,omitempty"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+// TranslationMemoryKey normalizes a phrase for translation memory lookups.
+func TranslationMemoryKey(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
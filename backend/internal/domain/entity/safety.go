@@ -0,0 +1,41 @@
+package entity
+
+import "time"
+
+// SafetyStage is where in an AI request a safety rule matched.
+type SafetyStage string
+
+const (
+	// SafetyInput is a message typed by the user.
+	SafetyInput SafetyStage = "input"
+	// SafetyContent is untrusted article text placed in a prompt.
+	SafetyContent SafetyStage = "content"
+	// SafetyOutput is the model's reply.
+	SafetyOutput SafetyStage = "output"
+)
+
+// SafetyAction is what the guard did about a match.
+type SafetyAction string
+
+const (
+	// SafetyBlocked requests are refused; blocked replies are replaced by a refusal.
+	SafetyBlocked SafetyAction = "blocked"
+	// SafetyRedacted content had the offending passage removed and was still used.
+	SafetyRedacted SafetyAction = "redacted"
+)
+
+// SafetyEvent records one match of a safety rule.
+// It maps to a document in the 'safety_events' collection.
+type SafetyEvent struct {
+	ID       string       `bson:"_id,omitempty" json:"id"`
+	Stage    SafetyStage  `bson:"stage" json:"stage"`
+	Action   SafetyAction `bson:"action" json:"action"`
+	Rule     string       `bson:"rule" json:"rule"`
+	Feature  string       `bson:"feature" json:"feature"`
+	Key      string       `bson:"key,omitempty" json:"key,omitempty"` // news ID or chat session
+	Excerpt  string       `bson:"excerpt" json:"excerpt"`
+	UserID   string       `bson:"user_id,omitempty" json:"user_id,omitempty"`
+	ClientIP string       `bson:"client_ip,omitempty" json:"client_ip,omitempty"`
+	// CreatedAt drives retention of the collection
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
@@ -0,0 +1,41 @@
+package entity
+
+import "time"
+
+// ScheduledJob is the cluster-wide state of a named background job. It maps
+// to a document in the 'scheduled_jobs' collection keyed by the job name; the
+// lease fields make sure one replica runs each firing.
+type ScheduledJob struct {
+	Name     string `bson:"_id" json:"name"`
+	Schedule string `bson:"schedule" json:"schedule"`
+	Paused   bool   `bson:"paused" json:"paused"`
+	PausedBy string `bson:"paused_by,omitempty" json:"paused_by,omitempty"`
+	// LeaseOwner holds the job while LeaseUntil is in the future
+	LeaseOwner string     `bson:"lease_owner,omitempty" json:"lease_owner,omitempty"`
+	LeaseUntil *time.Time `bson:"lease_until,omitempty" json:"lease_until,omitempty"`
+	// LastTick is the scheduled instant of the last claimed run
+	LastTick       time.Time  `bson:"last_tick" json:"last_tick"`
+	LastRunAt      *time.Time `bson:"last_run_at,omitempty" json:"last_run_at,omitempty"`
+	LastDurationMS int64      `bson:"last_duration_ms" json:"last_duration_ms"`
+	// LastStatus is "ok" or "error"
+	LastStatus string `bson:"last_status,omitempty" json:"last_status,omitempty"`
+	LastError  string `bson:"last_error,omitempty" json:"last_error,omitempty"`
+	LastResult string `bson:"last_result,omitempty" json:"last_result,omitempty"`
+	// LastTrigger is "schedule" or "manual:<user id>"
+	LastTrigger string `bson:"last_trigger,omitempty" json:"last_trigger,omitempty"`
+	RunCount    int    `bson:"run_count" json:"run_count"`
+}
+
+// Running reports whether a replica holds the job's lease at now.
+func (j *ScheduledJob) Running(now time.Time) bool {
+	return j.LeaseUntil != nil && j.LeaseUntil.After(now)
+}
+
+// JobRun is the outcome of one run, recorded when the lease is released.
+type JobRun struct {
+	StartedAt time.Time
+	Duration  time.Duration
+	Trigger   string
+	Result    string
+	Err       error
+}
@@ -19,6 +19,14 @@ type Source struct {
 	LogoURL          string       `bson:"logo_url" json:"logo_url"`
 	Languages        LanguageType `bson:"languages" json:"languages"`
 	ReliabilityScore float64      `bson:"reliability_score" json:"reliability_score"`
+	// FeedURLs are RSS, Atom or JSON Feed URLs polled directly, without the news provider
+	FeedURLs []string `bson:"feed_urls,omitempty" json:"feed_urls,omitempty"`
+	// PollIntervalMinutes is how often the feeds are polled (0 uses the default)
+	PollIntervalMinutes int `bson:"poll_interval_minutes,omitempty" json:"poll_interval_minutes,omitempty"`
+	// Reliability explains ReliabilityScore; it is rewritten by the scoring job
+	Reliability *ReliabilityBreakdown `bson:"reliability,omitempty" json:"reliability,omitempty"`
+	// ReliabilityOverride, when set, replaces the computed score
+	ReliabilityOverride *ReliabilityOverride `bson:"reliability_override,omitempty" json:"reliability_override,omitempty"`
 }
 
 func SetLanguageType(lang string) LanguageType {
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// Story groups articles from different sources that report the same event.
+// It maps to a document in the 'stories' collection.
+type Story struct {
+	ID string `bson:"_id,omitempty" json:"id"`
+	// Neutral headline and combined multi-source summary
+	Headline  BilingualField `bson:"headline" json:"headline"`
+	Summary   BilingualField `bson:"summary" json:"summary"`
+	NewsIDs   []string       `bson:"news_ids" json:"news_ids"`
+	SourceIDs []string       `bson:"source_ids,omitempty" json:"source_ids,omitempty"`
+	// Centroid is the mean embedding of member articles
+	Centroid []float32 `bson:"centroid,omitempty" json:"-"`
+	// KeyTerms are normalized title tokens of member articles used for title matching
+	KeyTerms     []string `bson:"key_terms,omitempty" json:"-"`
+	ArticleCount int      `bson:"article_count" json:"article_count"`
+	// SourceCount is the number of distinct sources, the main trending signal
+	SourceCount      int       `bson:"source_count" json:"source_count"`
+	FirstPublishedAt time.Time `bson:"first_published_at" json:"first_published_at"`
+	LastPublishedAt  time.Time `bson:"last_published_at" json:"last_published_at"`
+	CreatedAt        time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt        time.Time `bson:"updated_at" json:"updated_at"`
+	// PromptVersion is the version of the "story_synthesis" prompt that wrote the headline and summary
+	PromptVersion string `bson:"prompt_version,omitempty" json:"prompt_version,omitempty"`
+	// Version guards concurrent updates; it grows with every write
+	Version int `bson:"version" json:"-"`
+}
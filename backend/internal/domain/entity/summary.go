@@ -2,12 +2,48 @@ package entity
 
 import "time"
 
-type Summary struct {
-	ID string `bson:"_id,omitempty" json:"id"`
-	NewsID string `bson:"news_id" json:"news_id"`
-	Content string `bson:"content" json:"content"`
-	Language string `bson:"language" json:"language"`
-	CreatedAt time.Time `bson:"created_at" json:"created_at"`
-	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+// SummaryType identifies the shape of a generated summary variant.
+type SummaryType string
+
+const (
+	// SummaryHeadline is a single line suitable for cards and notifications.
+	SummaryHeadline SummaryType = "headline"
+	// SummaryShort is a one or two sentence blurb.
+	SummaryShort SummaryType = "short"
+	// SummaryBullets is a list of 3-5 key points.
+	SummaryBullets SummaryType = "bullets"
+	// SummaryDetailed is a full paragraph (used by the brief email).
+	SummaryDetailed SummaryType = "detailed"
+)
+
+// SummaryTypes lists all supported summary variants.
+var SummaryTypes = []SummaryType{SummaryHeadline, SummaryShort, SummaryBullets, SummaryDetailed}
+
+// ParseSummaryType maps a raw string to a known SummaryType.
+func ParseSummaryType(s string) (SummaryType, bool) {
+	for _, t := range SummaryTypes {
+		if string(t) == s {
+			return t, true
+		}
+	}
+	return "", false
 }
 
+// Summary is a cached summary variant of a news article in one language.
+// It maps to a document in the 'summaries' collection.
+type Summary struct {
+	ID       string      `bson:"_id,omitempty" json:"id"`
+	NewsID   string      `bson:"news_id" json:"news_id"`
+	Type     SummaryType `bson:"type" json:"type"`
+	Content  string      `bson:"content" json:"content"`
+	Bullets  []string    `bson:"bullets,omitempty" json:"bullets,omitempty"`
+	Language string      `bson:"language" json:"language"`
+	// Generation metadata
+	Model         string    `bson:"model,omitempty" json:"model,omitempty"`
+	PromptVersion string    `bson:"prompt_version,omitempty" json:"prompt_version,omitempty"`
+	CreatedAt     time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt     time.Time `bson:"updated_at" json:"updated_at"`
+	// SourceHash fingerprints the article text the variant was made from; a
+	// variant whose article has changed since is generated again
+	SourceHash string `bson:"source_hash,omitempty" json:"-"`
+}
@@ -0,0 +1,73 @@
+package entity
+
+import "time"
+
+// TranslationStatus tracks the machine translation of one bilingual field.
+type TranslationStatus string
+
+const (
+	// TranslationPending is queued for the background worker.
+	TranslationPending TranslationStatus = "pending"
+	// TranslationDone holds a machine translation of the source field.
+	TranslationDone TranslationStatus = "done"
+	// TranslationFailed failed at least once and will be retried.
+	TranslationFailed TranslationStatus = "failed"
+	// TranslationMirrored gave up after the last retry; the field holds a copy of the source text.
+	TranslationMirrored TranslationStatus = "mirrored"
+	// TranslationEdited was corrected by an editor.
+	TranslationEdited TranslationStatus = "edited"
+)
+
+// Translatable field keys used in News.Translations.
+const (
+	FieldTitleEN   = "title_en"
+	FieldTitleAM   = "title_am"
+	FieldBodyEN    = "body_en"
+	FieldBodyAM    = "body_am"
+	FieldSummaryEN = "summary_en"
+	FieldSummaryAM = "summary_am"
+)
+
+// TranslatableFields lists the bilingual field keys and the counterpart each is translated from.
+var TranslatableFields = map[string]string{
+	FieldTitleEN: FieldTitleAM, FieldTitleAM: FieldTitleEN,
+	FieldBodyEN: FieldBodyAM, FieldBodyAM: FieldBodyEN,
+	FieldSummaryEN: FieldSummaryAM, FieldSummaryAM: FieldSummaryEN,
+}
+
+// FieldLanguage returns the language code of a field key ("title_am" → "am").
+func FieldLanguage(field string) string {
+	if len(field) > 3 && field[len(field)-3] == '_' {
+		return field[len(field)-2:]
+	}
+	return ""
+}
+
+// FieldValue returns a pointer to the bilingual field named by key, or nil.
+func (n *News) FieldValue(key string) *string {
+	switch key {
+	case FieldTitleEN:
+		return &n.TitleEN
+	case FieldTitleAM:
+		return &n.TitleAM
+	case FieldBodyEN:
+		return &n.BodyEN
+	case FieldBodyAM:
+		return &n.BodyAM
+	case FieldSummaryEN:
+		return &n.SummaryEN
+	case FieldSummaryAM:
+		return &n.SummaryAM
+	}
+	return nil
+}
+
+// FieldTranslation records how a target-language field was produced.
+type FieldTranslation struct {
+	Status TranslationStatus `bson:"status" json:"status"`
+	// SourceField is the field the text is translated from (e.g. title_en for title_am)
+	SourceField string    `bson:"source_field" json:"source_field"`
+	Attempts    int       `bson:"attempts" json:"attempts"`
+	LastError   string    `bson:"last_error,omitempty" json:"-"`
+	UpdatedAt   time.Time `bson:"updated_at" json:"updated_at"`
+}
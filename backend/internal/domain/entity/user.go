@@ -14,6 +14,7 @@ type NotificationsPreferences struct {
 type Preferences struct {
 	Topics            []string                 `bson:"topics" json:"topics"`
 	SubscribedSources []string                 `bson:"subscribed_sources" json:"subscribed_sources"`
+	Entities          []string                 `bson:"entities,omitempty" json:"entities,omitempty"`
 	Notifications     NotificationsPreferences `bson:"notifications" json:"notifications"`
 }
 
@@ -36,6 +37,8 @@ type UserRole string
 const (
 	UserRoleAdmin UserRole = "admin"
 	UserRoleUser  UserRole = "user"
+	// UserRoleEditor may review and correct article text and translations
+	UserRoleEditor UserRole = "editor"
 )
 
 func DefaultRole() UserRole {
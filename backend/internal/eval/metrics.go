@@ -0,0 +1,152 @@
+package eval
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Tokens lowercases text and splits it into words. Ethiopic punctuation
+// (። ፣ ፤) is not a letter, so Amharic splits the same way as English.
+func Tokens(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.Is(unicode.Mn, r)
+	})
+}
+
+// Score is a precision/recall pair with its F1.
+type Score struct {
+	Precision float64 `json:"precision"`
+	Recall    float64 `json:"recall"`
+	F1        float64 `json:"f1"`
+}
+
+func newScore(overlap, candidate, reference int) Score {
+	var s Score
+	if candidate > 0 {
+		s.Precision = float64(overlap) / float64(candidate)
+	}
+	if reference > 0 {
+		s.Recall = float64(overlap) / float64(reference)
+	}
+	if s.Precision+s.Recall > 0 {
+		s.F1 = 2 * s.Precision * s.Recall / (s.Precision + s.Recall)
+	}
+	return s
+}
+
+// RougeN scores the n-gram overlap of a candidate against a reference.
+func RougeN(candidate, reference string, n int) Score {
+	c, r := ngrams(Tokens(candidate), n), ngrams(Tokens(reference), n)
+	overlap, total := 0, 0
+	for g, count := range c {
+		total += count
+		if rc := r[g]; rc > 0 {
+			overlap += min(count, rc)
+		}
+	}
+	refTotal := 0
+	for _, count := range r {
+		refTotal += count
+	}
+	return newScore(overlap, total, refTotal)
+}
+
+func ngrams(tokens []string, n int) map[string]int {
+	out := map[string]int{}
+	for i := 0; i+n <= len(tokens); i++ {
+		out[strings.Join(tokens[i:i+n], " ")]++
+	}
+	return out
+}
+
+// RougeL scores the longest common subsequence of candidate and reference.
+func RougeL(candidate, reference string) Score {
+	c, r := Tokens(candidate), Tokens(reference)
+	if len(c) == 0 || len(r) == 0 {
+		return Score{}
+	}
+	prev := make([]int, len(r)+1)
+	cur := make([]int, len(r)+1)
+	for i := 1; i <= len(c); i++ {
+		for j := 1; j <= len(r); j++ {
+			if c[i-1] == r[j-1] {
+				cur[j] = prev[j-1] + 1
+			} else {
+				cur[j] = max(prev[j], cur[j-1])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return newScore(prev[len(r)], len(c), len(r))
+}
+
+// ScriptShare returns the share of letters written in Ethiopic and in Latin script.
+func ScriptShare(text string) (ethiopic, latin float64) {
+	var e, l, total int
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		total++
+		switch {
+		case unicode.Is(unicode.Ethiopic, r):
+			e++
+		case unicode.Is(unicode.Latin, r):
+			l++
+		}
+	}
+	if total == 0 {
+		return 0, 0
+	}
+	return float64(e) / float64(total), float64(l) / float64(total)
+}
+
+// LanguageOK reports whether text is written in the expected language's
+// script: mostly Ethiopic for "am", mostly Latin for "en".
+func LanguageOK(text, lang string) bool {
+	ethiopic, latin := ScriptShare(text)
+	if lang == "am" {
+		return ethiopic >= 0.8
+	}
+	return latin >= 0.9
+}
+
+// SetScore compares predicted labels with gold labels.
+func SetScore(predicted, gold []string) (truePos, falsePos, falseNeg int) {
+	want := map[string]bool{}
+	for _, g := range gold {
+		want[g] = true
+	}
+	seen := map[string]bool{}
+	for _, p := range predicted {
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		if want[p] {
+			truePos++
+		} else {
+			falsePos++
+		}
+	}
+	for g := range want {
+		if !seen[g] {
+			falseNeg++
+		}
+	}
+	return truePos, falsePos, falseNeg
+}
+
+// sentences counts sentence terminators, English or Ethiopic.
+func sentences(text string) int {
+	n := 0
+	for _, r := range text {
+		if r == '.' || r == '!' || r == '?' || r == '።' {
+			n++
+		}
+	}
+	if n == 0 && strings.TrimSpace(text) != "" {
+		n = 1
+	}
+	return n
+}
@@ -0,0 +1,256 @@
+package eval
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Report is the result of one evaluation run. Two reports can be compared
+// with Markdown(baseline).
+type Report struct {
+	RunAt          time.Time                         `json:"run_at"`
+	Model          string                            `json:"model"`
+	PromptVersions map[string]string                 `json:"prompt_versions"`
+	Fixtures       int                               `json:"fixtures"`
+	Summary        map[string]*SummaryMetrics        `json:"summary"` // by language, plus "all"
+	Classification ClassificationMetrics             `json:"classification"`
+	PerTopic       map[string]*ClassificationMetrics `json:"per_topic"`
+	Items          []ItemResult                      `json:"items"`
+}
+
+// SummaryMetrics averages the summary checks over the fixtures of a language.
+type SummaryMetrics struct {
+	Count         int     `json:"count"`
+	Failed        int     `json:"failed"`
+	Rouge1F       float64 `json:"rouge1_f"`
+	Rouge2F       float64 `json:"rouge2_f"`
+	RougeLF       float64 `json:"rougeL_f"`
+	AvgWords      float64 `json:"avg_words"`
+	LengthOK      float64 `json:"length_ok_rate"`
+	LanguageOK    float64 `json:"language_ok_rate"`
+	TranslationOK float64 `json:"translation_ok_rate"`
+}
+
+// ClassificationMetrics are micro-averaged over the evaluated fixtures.
+type ClassificationMetrics struct {
+	TruePositives  int     `json:"tp"`
+	FalsePositives int     `json:"fp"`
+	FalseNegatives int     `json:"fn"`
+	Precision      float64 `json:"precision"`
+	Recall         float64 `json:"recall"`
+	F1             float64 `json:"f1"`
+}
+
+func (m *ClassificationMetrics) add(tp, fp, fn int) {
+	m.TruePositives += tp
+	m.FalsePositives += fp
+	m.FalseNegatives += fn
+	s := newScore(m.TruePositives, m.TruePositives+m.FalsePositives, m.TruePositives+m.FalseNegatives)
+	m.Precision, m.Recall, m.F1 = s.Precision, s.Recall, s.F1
+}
+
+func (r *Report) aggregate(topics []string) {
+	r.Summary = map[string]*SummaryMetrics{}
+	r.PerTopic = map[string]*ClassificationMetrics{}
+	for _, t := range topics {
+		r.PerTopic[t] = &ClassificationMetrics{}
+	}
+	translated := map[string]int{}
+	for _, it := range r.Items {
+		for _, lang := range []string{it.Language, "all"} {
+			m := r.Summary[lang]
+			if m == nil {
+				m = &SummaryMetrics{}
+				r.Summary[lang] = m
+			}
+			m.Count++
+			if it.Summary == "" {
+				m.Failed++
+				continue
+			}
+			m.Rouge1F += it.Rouge1.F1
+			m.Rouge2F += it.Rouge2.F1
+			m.RougeLF += it.RougeL.F1
+			m.AvgWords += float64(it.Words)
+			m.LengthOK += boolFloat(it.LengthOK)
+			m.LanguageOK += boolFloat(it.LanguageOK)
+			if it.Translation != "" {
+				translated[lang]++
+				m.TranslationOK += boolFloat(it.TranslationOK)
+			}
+		}
+		if len(it.Errors) > 0 && len(it.Predicted) == 0 {
+			continue
+		}
+		r.Classification.add(SetScore(it.Predicted, it.Expected))
+		for t, m := range r.PerTopic {
+			m.add(SetScore(only(it.Predicted, t), only(it.Expected, t)))
+		}
+	}
+	for lang, m := range r.Summary {
+		if ok := m.Count - m.Failed; ok > 0 {
+			n := float64(ok)
+			m.Rouge1F /= n
+			m.Rouge2F /= n
+			m.RougeLF /= n
+			m.AvgWords /= n
+			m.LengthOK /= n
+			m.LanguageOK /= n
+		}
+		if translated[lang] > 0 {
+			m.TranslationOK /= float64(translated[lang])
+		}
+	}
+	for t, m := range r.PerTopic {
+		if m.TruePositives+m.FalsePositives+m.FalseNegatives == 0 {
+			delete(r.PerTopic, t)
+		}
+	}
+}
+
+func only(list []string, t string) []string {
+	for _, v := range list {
+		if v == t {
+			return []string{t}
+		}
+	}
+	return nil
+}
+
+func boolFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// WriteJSON saves the report for later comparison.
+func (r *Report) WriteJSON(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// ReadReport loads a report written by WriteJSON.
+func ReadReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var r Report
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &r, nil
+}
+
+// Markdown renders the report; with a baseline, every metric shows its change.
+func (r *Report) Markdown(baseline *Report) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# NewsBrief evaluation — %s\n\n", r.RunAt.Format(time.RFC3339))
+	fmt.Fprintf(&b, "- Model: `%s`\n- Fixtures: %d\n", r.Model, r.Fixtures)
+	for _, k := range sortedKeys(r.PromptVersions) {
+		fmt.Fprintf(&b, "- Prompt %s: %s\n", k, r.PromptVersions[k])
+	}
+	if baseline != nil {
+		fmt.Fprintf(&b, "- Baseline: %s (`%s`)\n", baseline.RunAt.Format(time.RFC3339), baseline.Model)
+	}
+
+	b.WriteString("\n## Summarization\n\n")
+	b.WriteString("| Language | Count | Failed | ROUGE-1 F | ROUGE-2 F | ROUGE-L F | Avg words | Length OK | Language OK | Translation OK |\n")
+	b.WriteString("|---|---|---|---|---|---|---|---|---|---|\n")
+	for _, lang := range []string{"en", "am", "all"} {
+		m := r.Summary[lang]
+		if m == nil {
+			continue
+		}
+		var base *SummaryMetrics
+		if baseline != nil {
+			base = baseline.Summary[lang]
+		}
+		pick := func(f func(*SummaryMetrics) float64) string {
+			if base == nil {
+				return fmt.Sprintf("%.3f", f(m))
+			}
+			return withDelta(f(m), f(base))
+		}
+		fmt.Fprintf(&b, "| %s | %d | %d | %s | %s | %s | %s | %s | %s | %s |\n", lang, m.Count, m.Failed,
+			pick(func(s *SummaryMetrics) float64 { return s.Rouge1F }),
+			pick(func(s *SummaryMetrics) float64 { return s.Rouge2F }),
+			pick(func(s *SummaryMetrics) float64 { return s.RougeLF }),
+			pick(func(s *SummaryMetrics) float64 { return s.AvgWords }),
+			pick(func(s *SummaryMetrics) float64 { return s.LengthOK }),
+			pick(func(s *SummaryMetrics) float64 { return s.LanguageOK }),
+			pick(func(s *SummaryMetrics) float64 { return s.TranslationOK }),
+		)
+	}
+
+	b.WriteString("\n## Topic classification (micro-averaged)\n\n")
+	b.WriteString("| Scope | TP | FP | FN | Precision | Recall | F1 |\n|---|---|---|---|---|---|---|\n")
+	writeClass := func(name string, m, base *ClassificationMetrics) {
+		p, rc, f := fmt.Sprintf("%.3f", m.Precision), fmt.Sprintf("%.3f", m.Recall), fmt.Sprintf("%.3f", m.F1)
+		if base != nil {
+			p, rc, f = withDelta(m.Precision, base.Precision), withDelta(m.Recall, base.Recall), withDelta(m.F1, base.F1)
+		}
+		fmt.Fprintf(&b, "| %s | %d | %d | %d | %s | %s | %s |\n", name, m.TruePositives, m.FalsePositives, m.FalseNegatives, p, rc, f)
+	}
+	var baseAll *ClassificationMetrics
+	if baseline != nil {
+		baseAll = &baseline.Classification
+	}
+	writeClass("all", &r.Classification, baseAll)
+	topics := make([]string, 0, len(r.PerTopic))
+	for t := range r.PerTopic {
+		topics = append(topics, t)
+	}
+	sort.Strings(topics)
+	for _, t := range topics {
+		var base *ClassificationMetrics
+		if baseline != nil {
+			base = baseline.PerTopic[t]
+		}
+		writeClass(t, r.PerTopic[t], base)
+	}
+
+	b.WriteString("\n## Items\n\n| ID | Lang | ROUGE-L F | Words | Length | Language | Expected | Predicted | Errors |\n|---|---|---|---|---|---|---|---|---|\n")
+	for _, it := range r.Items {
+		fmt.Fprintf(&b, "| %s | %s | %.3f | %d | %s | %s | %s | %s | %s |\n", it.ID, it.Language, it.RougeL.F1, it.Words,
+			check(it.LengthOK), check(it.LanguageOK), strings.Join(it.Expected, ", "), strings.Join(it.Predicted, ", "),
+			strings.ReplaceAll(strings.Join(it.Errors, "; "), "|", "\\|"))
+	}
+	return b.String()
+}
+
+func withDelta(v, base float64) string {
+	d := v - base
+	switch {
+	case d > 0.0005:
+		return fmt.Sprintf("%.3f (+%.3f)", v, d)
+	case d < -0.0005:
+		return fmt.Sprintf("%.3f (%.3f)", v, d)
+	default:
+		return fmt.Sprintf("%.3f (=)", v)
+	}
+}
+
+func check(ok bool) string {
+	if ok {
+		return "ok"
+	}
+	return "FAIL"
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,112 @@
+package eval
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+)
+
+// ItemResult is the outcome of one fixture.
+type ItemResult struct {
+	ID            string   `json:"id"`
+	Language      string   `json:"language"`
+	Summary       string   `json:"summary,omitempty"`
+	PromptVersion string   `json:"prompt_version,omitempty"`
+	Rouge1        Score    `json:"rouge1"`
+	Rouge2        Score    `json:"rouge2"`
+	RougeL        Score    `json:"rougeL"`
+	Words         int      `json:"words"`
+	Sentences     int      `json:"sentences"`
+	LengthOK      bool     `json:"length_ok"`
+	LanguageOK    bool     `json:"language_ok"`
+	Translation   string   `json:"translation,omitempty"`
+	TranslationOK bool     `json:"translation_ok"`
+	Expected      []string `json:"expected_topics"`
+	Predicted     []string `json:"predicted_topics"`
+	Errors        []string `json:"errors,omitempty"`
+	LatencyMS     int64    `json:"latency_ms"`
+}
+
+// Options tune a run.
+type Options struct {
+	// SkipTranslation leaves out the round trip of each summary into the other language
+	SkipTranslation bool
+	// Timeout bounds the work on one fixture
+	Timeout time.Duration
+	// Progress, when set, is called after each fixture
+	Progress func(done, total int, item ItemResult)
+}
+
+// Run sends every fixture through the pipeline and aggregates the results.
+// A failing step is recorded on the item and the run continues.
+func Run(ctx context.Context, pipeline contract.IEvalPipeline, fixtures []Fixture, model string, opts Options) *Report {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 2 * time.Minute
+	}
+	report := &Report{RunAt: time.Now().UTC(), Model: model, Fixtures: len(fixtures), PromptVersions: map[string]string{}}
+	for i, f := range fixtures {
+		item := runOne(ctx, pipeline, f, opts)
+		if item.PromptVersion != "" {
+			report.PromptVersions["summarize:"+f.Language] = item.PromptVersion
+		}
+		report.Items = append(report.Items, item)
+		if opts.Progress != nil {
+			opts.Progress(i+1, len(fixtures), item)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	report.aggregate(pipeline.Topics())
+	return report
+}
+
+func runOne(ctx context.Context, pipeline contract.IEvalPipeline, f Fixture, opts Options) ItemResult {
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+	start := time.Now()
+	item := ItemResult{ID: f.ID, Language: f.Language, Expected: f.Topics, Predicted: []string{}}
+	key := "eval:" + f.ID
+
+	summary, version, err := pipeline.Summarize(ctx, key, f.Body, f.Language)
+	if err != nil {
+		item.Errors = append(item.Errors, "summarize: "+err.Error())
+	} else {
+		item.Summary, item.PromptVersion = summary, version
+		if f.ReferenceSummary != "" {
+			item.Rouge1 = RougeN(summary, f.ReferenceSummary, 1)
+			item.Rouge2 = RougeN(summary, f.ReferenceSummary, 2)
+			item.RougeL = RougeL(summary, f.ReferenceSummary)
+		}
+		item.Words = len(Tokens(summary))
+		item.Sentences = sentences(summary)
+		item.LengthOK = item.Words >= f.MinWords && item.Words <= f.MaxWords
+		item.LanguageOK = LanguageOK(summary, f.Language)
+
+		if !opts.SkipTranslation {
+			other := "am"
+			if f.Language == "am" {
+				other = "en"
+			}
+			translated, err := pipeline.Translate(ctx, summary, f.Language, other)
+			if err != nil {
+				item.Errors = append(item.Errors, "translate: "+err.Error())
+			} else {
+				item.Translation = translated
+				item.TranslationOK = LanguageOK(translated, other)
+			}
+		}
+	}
+
+	topics, err := pipeline.Classify(ctx, key, f.Title, f.Body, f.Language)
+	if err != nil {
+		item.Errors = append(item.Errors, "classify: "+err.Error())
+	} else {
+		sort.Strings(topics)
+		item.Predicted = topics
+	}
+	item.LatencyMS = time.Since(start).Milliseconds()
+	return item
+}
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHandler lets admins issue, rotate and revoke machine credentials.
+type APIKeyHandler struct {
+	uc contract.IAPIKeyUsecase
+}
+
+func NewAPIKeyHandler(uc contract.IAPIKeyUsecase) *APIKeyHandler {
+	return &APIKeyHandler{uc: uc}
+}
+
+// ListKeys handles GET /api/v1/admin/api-keys?include_revoked=true
+func (h *APIKeyHandler) ListKeys(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	list, err := h.uc.List(c.Request.Context(), c.Query("include_revoked") == "true")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.APIKeyListResponseDTO{Keys: dto.MapAPIKeysToDTOs(list), Total: len(list)})
+}
+
+// IssueKey handles POST /api/v1/admin/api-keys
+func (h *APIKeyHandler) IssueKey(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	var req dto.IssueAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+	in := contract.IssueAPIKeyInput{
+		Name:       req.Name,
+		Kind:       entity.APIKeyKind(req.Kind),
+		Scopes:     req.Scopes,
+		SourceSlug: req.SourceSlug,
+		CreatedBy:  c.GetString("userID"),
+	}
+	if req.ExpiresInDays > 0 {
+		at := time.Now().UTC().AddDate(0, 0, req.ExpiresInDays)
+		in.ExpiresAt = &at
+	}
+	key, secret, err := h.uc.Issue(c.Request.Context(), in)
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.IssuedAPIKeyResponse{Key: dto.MapAPIKeyToDTO(key), Secret: secret})
+}
+
+// RotateKey handles POST /api/v1/admin/api-keys/:id/rotate
+func (h *APIKeyHandler) RotateKey(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	var req dto.RotateAPIKeyRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
+			return
+		}
+	}
+	key, secret, err := h.uc.Rotate(c.Request.Context(), c.Param("id"), time.Duration(req.GraceHours)*time.Hour, c.GetString("userID"))
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.IssuedAPIKeyResponse{Key: dto.MapAPIKeyToDTO(key), Secret: secret})
+}
+
+// RevokeKey handles DELETE /api/v1/admin/api-keys/:id
+func (h *APIKeyHandler) RevokeKey(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	if err := h.uc.Revoke(c.Request.Context(), c.Param("id")); err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "API key revoked"})
+}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
 	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
@@ -24,12 +25,12 @@ func (h *ChatHandler) ChatGeneral(c *gin.Context) {
 		return
 	}
 	sessionID := c.GetHeader("X-Session-ID")
-	reply, err := h.chatbotUC.ChatGeneral(sessionID, req.Message)
+	reply, err := h.chatbotUC.ChatGeneral(c.Request.Context(), sessionID, req.Message)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		respondAIError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, dto.ChatResponse{Reply: reply})
+	c.JSON(http.StatusOK, dto.ChatResponse{Reply: reply.Reply, Citations: dto.MapCitationsToDTOs(reply.Citations), Refused: reply.Refused, FollowUps: reply.FollowUps})
 }
 
 // ChatForNews handles chat restricted to a specific news item.
@@ -41,10 +42,96 @@ func (h *ChatHandler) ChatForNews(c *gin.Context) {
 	}
 	newsID := c.Param("id")
 	sessionID := c.GetHeader("X-Session-ID")
-	reply, err := h.chatbotUC.ChatForNews(newsID, sessionID, req.Message)
+	reply, err := h.chatbotUC.ChatForNews(c.Request.Context(), newsID, sessionID, req.Message)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		respondAIError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, dto.ChatResponse{Reply: reply})
+	c.JSON(http.StatusOK, dto.ChatResponse{Reply: reply.Reply, Refused: reply.Refused, FollowUps: reply.FollowUps})
+}
+
+// GetSuggestedQuestions handles GET /api/v1/news/:id/suggested-questions?lang=en|am
+// (both languages when lang is omitted).
+func (h *ChatHandler) GetSuggestedQuestions(c *gin.Context) {
+	lang := c.Query("lang")
+	if lang != "" && lang != "en" && lang != "am" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "lang must be en or am"})
+		return
+	}
+	newsID := c.Param("id")
+	questions, err := h.chatbotUC.SuggestedQuestions(c.Request.Context(), newsID)
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	resp := dto.SuggestedQuestionsResponse{NewsID: newsID}
+	if lang == "" || lang == "en" {
+		resp.EN = questions.EN
+	}
+	if lang == "" || lang == "am" {
+		resp.AM = questions.AM
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ChatArticles handles POST /api/v1/chat/articles: a question over a set of
+// articles (IDs, a story, a topic's latest items or the caller's bookmarks).
+func (h *ChatHandler) ChatArticles(c *gin.Context) {
+	var req dto.ChatArticlesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+	set, ok := articleSet(c, req.ArticleSetRequest)
+	if !ok {
+		return
+	}
+	sessionID := c.GetHeader("X-Session-ID")
+	reply, err := h.chatbotUC.ChatArticles(c.Request.Context(), set, sessionID, req.Message)
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.ChatResponse{Reply: reply.Reply, Citations: dto.MapCitationsToDTOs(reply.Citations), Refused: reply.Refused, FollowUps: reply.FollowUps})
+}
+
+// CompareCoverage handles POST /api/v1/chat/compare
+func (h *ChatHandler) CompareCoverage(c *gin.Context) {
+	var req dto.CompareCoverageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+	if req.Lang != "" && req.Lang != "en" && req.Lang != "am" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "lang must be en or am"})
+		return
+	}
+	set, ok := articleSet(c, req.ArticleSetRequest)
+	if !ok {
+		return
+	}
+	comparison, err := h.chatbotUC.CompareCoverage(c.Request.Context(), set, req.Lang)
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, comparison)
+}
+
+// articleSet converts the request selector; bookmarks need a signed-in user.
+func articleSet(c *gin.Context, req dto.ArticleSetRequest) (contract.ArticleSet, bool) {
+	set := contract.ArticleSet{StoryID: strings.TrimSpace(req.StoryID), TopicID: strings.TrimSpace(req.TopicID)}
+	for _, id := range req.NewsIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			set.NewsIDs = append(set.NewsIDs, id)
+		}
+	}
+	if req.Bookmarks {
+		set.BookmarksOf = contract.UsageActorFrom(c.Request.Context()).UserID
+		if set.BookmarksOf == "" {
+			c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: "sign in to chat over your bookmarks"})
+			return contract.ArticleSet{}, false
+		}
+	}
+	return set, true
 }
@@ -0,0 +1,77 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+type IssueAPIKeyRequest struct {
+	Name string `json:"name" binding:"required"`
+	// Kind is "bearer" (default) or "signed"
+	Kind       string   `json:"kind" binding:"omitempty,oneof=bearer signed"`
+	Scopes     []string `json:"scopes" binding:"required,min=1"`
+	SourceSlug string   `json:"source_slug"`
+	// ExpiresInDays of 0 issues a key without expiry
+	ExpiresInDays int `json:"expires_in_days" binding:"min=0"`
+}
+
+type RotateAPIKeyRequest struct {
+	// GraceHours keeps the old key working while clients switch (default 24)
+	GraceHours int `json:"grace_hours" binding:"min=0"`
+}
+
+type APIKeyDTO struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Kind       string     `json:"kind"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	SourceID   string     `json:"source_id,omitempty"`
+	CreatedBy  string     `json:"created_by,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP string     `json:"last_used_ip,omitempty"`
+	RotatedTo  string     `json:"rotated_to,omitempty"`
+	Active     bool       `json:"active"`
+}
+
+// IssuedAPIKeyResponse carries the full key, which is not shown again.
+type IssuedAPIKeyResponse struct {
+	Key    APIKeyDTO `json:"key"`
+	Secret string    `json:"secret"`
+}
+
+type APIKeyListResponseDTO struct {
+	Keys  []APIKeyDTO `json:"keys"`
+	Total int         `json:"total"`
+}
+
+func MapAPIKeyToDTO(k *entity.APIKey) APIKeyDTO {
+	return APIKeyDTO{
+		ID:         k.ID,
+		Name:       k.Name,
+		Kind:       string(k.Kind),
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		SourceID:   k.SourceID,
+		CreatedBy:  k.CreatedBy,
+		CreatedAt:  k.CreatedAt,
+		ExpiresAt:  k.ExpiresAt,
+		RevokedAt:  k.RevokedAt,
+		LastUsedAt: k.LastUsedAt,
+		LastUsedIP: k.LastUsedIP,
+		RotatedTo:  k.RotatedTo,
+		Active:     k.Active(time.Now()),
+	}
+}
+
+func MapAPIKeysToDTOs(list []*entity.APIKey) []APIKeyDTO {
+	out := make([]APIKeyDTO, 0, len(list))
+	for _, k := range list {
+		out = append(out, MapAPIKeyToDTO(k))
+	}
+	return out
+}
@@ -1,9 +1,57 @@
 package dto
 
+import "github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+
 type ChatRequest struct {
 	Message string `json:"message" binding:"required"`
 }
 
+// ArticleSetRequest selects the articles of a multi-article chat or
+// comparison; exactly one selector should be given.
+type ArticleSetRequest struct {
+	NewsIDs   []string `json:"news_ids"`
+	StoryID   string   `json:"story_id"`
+	TopicID   string   `json:"topic_id"`
+	Bookmarks bool     `json:"bookmarks"`
+}
+
+type ChatArticlesRequest struct {
+	ArticleSetRequest
+	Message string `json:"message" binding:"required"`
+}
+
+type CompareCoverageRequest struct {
+	ArticleSetRequest
+	Lang string `json:"lang"`
+}
+
 type ChatResponse struct {
-	Reply string `json:"reply"`
+	Reply     string            `json:"reply"`
+	Citations []ChatCitationDTO `json:"citations,omitempty"`
+	Refused   bool              `json:"refused,omitempty"`
+	FollowUps []string          `json:"follow_ups,omitempty"`
+}
+
+// SuggestedQuestionsResponse lists starter questions for an article chat.
+type SuggestedQuestionsResponse struct {
+	NewsID string   `json:"news_id"`
+	EN     []string `json:"en,omitempty"`
+	AM     []string `json:"am,omitempty"`
+}
+
+// ChatCitationDTO links an answer to a stored news article.
+type ChatCitationDTO struct {
+	NewsID    string `json:"news_id"`
+	Title     string `json:"title"`
+	SourceURL string `json:"source_url,omitempty"`
+	Source    string `json:"source,omitempty"`
+}
+
+// MapCitationsToDTOs converts citations into their API representation.
+func MapCitationsToDTOs(citations []entity.Citation) []ChatCitationDTO {
+	out := make([]ChatCitationDTO, 0, len(citations))
+	for _, c := range citations {
+		out = append(out, ChatCitationDTO{NewsID: c.NewsID, Title: c.Title, SourceURL: c.SourceURL, Source: c.Source})
+	}
+	return out
 }
@@ -0,0 +1,144 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// SetUserRoleRequest assigns a role to a user.
+type SetUserRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=user editor admin"`
+}
+
+// SegmentCorrectionDTO pairs a source phrase with its corrected translation.
+type SegmentCorrectionDTO struct {
+	Source string `json:"source" binding:"required"`
+	Target string `json:"target" binding:"required"`
+}
+
+// CorrectFieldRequest replaces the text of one bilingual field.
+type CorrectFieldRequest struct {
+	Content string `json:"content" binding:"required"`
+	Note    string `json:"note"`
+	// Segments are stored in translation memory; when empty, a short field is stored whole
+	Segments []SegmentCorrectionDTO `json:"segments"`
+}
+
+// AddMemoryEntryRequest records an approved phrase translation.
+type AddMemoryEntryRequest struct {
+	SourceLang string `json:"source_lang" binding:"required,oneof=en am"`
+	TargetLang string `json:"target_lang" binding:"required,oneof=en am"`
+	Source     string `json:"source" binding:"required"`
+	Target     string `json:"target" binding:"required"`
+}
+
+// EditorialFieldDTO is one side of a bilingual field pair.
+type EditorialFieldDTO struct {
+	Field   string `json:"field"`
+	Content string `json:"content"`
+	// Status is empty for original text, otherwise the translation status
+	Status    string `json:"status,omitempty"`
+	Revisions int    `json:"revisions"`
+}
+
+// EditorialPairDTO shows the English and Amharic versions of a field side by side.
+type EditorialPairDTO struct {
+	Name string            `json:"name"`
+	EN   EditorialFieldDTO `json:"en"`
+	AM   EditorialFieldDTO `json:"am"`
+}
+
+type FieldRevisionDTO struct {
+	ID             string `json:"id"`
+	Field          string `json:"field"`
+	Previous       string `json:"previous"`
+	Content        string `json:"content"`
+	Origin         string `json:"origin"`
+	PreviousStatus string `json:"previous_status,omitempty"`
+	EditorID       string `json:"editor_id,omitempty"`
+	Note           string `json:"note,omitempty"`
+	CreatedAt      string `json:"created_at"`
+}
+
+type EditorialArticleDTO struct {
+	ID        string             `json:"id"`
+	Language  string             `json:"language"`
+	SourceURL string             `json:"source_url,omitempty"`
+	Fields    []EditorialPairDTO `json:"fields"`
+	Revisions []FieldRevisionDTO `json:"revisions"`
+}
+
+type TranslationMemoryEntryDTO struct {
+	ID         string `json:"id"`
+	SourceLang string `json:"source_lang"`
+	TargetLang string `json:"target_lang"`
+	Source     string `json:"source"`
+	Target     string `json:"target"`
+	NewsID     string `json:"news_id,omitempty"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+func MapEditorialArticle(n *entity.News, revs []*entity.FieldRevision) EditorialArticleDTO {
+	counts := map[string]int{}
+	for _, r := range revs {
+		counts[r.Field]++
+	}
+	field := func(key string) EditorialFieldDTO {
+		return EditorialFieldDTO{
+			Field:     key,
+			Content:   *n.FieldValue(key),
+			Status:    string(n.Translations[key].Status),
+			Revisions: counts[key],
+		}
+	}
+	return EditorialArticleDTO{
+		ID:        n.ID,
+		Language:  n.Language,
+		SourceURL: n.SourceURL,
+		Fields: []EditorialPairDTO{
+			{Name: "title", EN: field(entity.FieldTitleEN), AM: field(entity.FieldTitleAM)},
+			{Name: "summary", EN: field(entity.FieldSummaryEN), AM: field(entity.FieldSummaryAM)},
+			{Name: "body", EN: field(entity.FieldBodyEN), AM: field(entity.FieldBodyAM)},
+		},
+		Revisions: MapRevisionsToDTOs(revs),
+	}
+}
+
+func MapRevisionsToDTOs(revs []*entity.FieldRevision) []FieldRevisionDTO {
+	out := make([]FieldRevisionDTO, 0, len(revs))
+	for _, r := range revs {
+		out = append(out, FieldRevisionDTO{
+			ID:             r.ID,
+			Field:          r.Field,
+			Previous:       r.Previous,
+			Content:        r.Content,
+			Origin:         string(r.Origin),
+			PreviousStatus: string(r.PreviousStatus),
+			EditorID:       r.EditorID,
+			Note:           r.Note,
+			CreatedAt:      r.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return out
+}
+
+func MapMemoryEntriesToDTOs(list []*entity.TranslationMemoryEntry) []TranslationMemoryEntryDTO {
+	out := make([]TranslationMemoryEntryDTO, 0, len(list))
+	for _, e := range list {
+		out = append(out, MapMemoryEntryToDTO(e))
+	}
+	return out
+}
+
+func MapMemoryEntryToDTO(e *entity.TranslationMemoryEntry) TranslationMemoryEntryDTO {
+	return TranslationMemoryEntryDTO{
+		ID:         e.ID,
+		SourceLang: e.SourceLang,
+		TargetLang: e.TargetLang,
+		Source:     e.Source,
+		Target:     e.Target,
+		NewsID:     e.NewsID,
+		UpdatedAt:  e.UpdatedAt.Format(time.RFC3339),
+	}
+}
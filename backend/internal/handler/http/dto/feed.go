@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// SetFeedsRequest replaces the feeds of a source; an empty list disables feed polling.
+type SetFeedsRequest struct {
+	FeedURLs            []string `json:"feed_urls"`
+	PollIntervalMinutes int      `json:"poll_interval_minutes"`
+}
+
+// FeedStateDTO is the polling status of one feed, as shown to admins.
+type FeedStateDTO struct {
+	URL           string    `json:"url"`
+	SourceID      string    `json:"source_id"`
+	LastPolledAt  time.Time `json:"last_polled_at"`
+	LastStatus    string    `json:"last_status"`
+	LastError     string    `json:"last_error,omitempty"`
+	Failures      int       `json:"failures"`
+	LastItems     int       `json:"last_items"`
+	LastIngested  int       `json:"last_ingested"`
+	TotalIngested int       `json:"total_ingested"`
+	TotalPolls    int       `json:"total_polls"`
+	TotalFailures int       `json:"total_failures"`
+}
+
+type FeedStateListResponseDTO struct {
+	Feeds []FeedStateDTO `json:"feeds"`
+	Total int            `json:"total"`
+}
+
+func MapFeedStatesToDTOs(list []*entity.FeedState) []FeedStateDTO {
+	out := make([]FeedStateDTO, 0, len(list))
+	for _, s := range list {
+		out = append(out, FeedStateDTO{
+			URL:           s.URL,
+			SourceID:      s.SourceID,
+			LastPolledAt:  s.LastPolledAt,
+			LastStatus:    s.LastStatus,
+			LastError:     s.LastError,
+			Failures:      s.Failures,
+			LastItems:     s.LastItems,
+			LastIngested:  s.LastIngested,
+			TotalIngested: s.TotalIngested,
+			TotalPolls:    s.TotalPolls,
+			TotalFailures: s.TotalFailures,
+		})
+	}
+	return out
+}
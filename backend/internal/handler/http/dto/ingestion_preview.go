@@ -0,0 +1,127 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// IngestionPreviewDTO is a dry-run ingestion; the approval lease stays internal.
+type IngestionPreviewDTO struct {
+	ID              string             `json:"id"`
+	Query           string             `json:"query"`
+	TopK            int                `json:"top_k"`
+	RequestedBy     string             `json:"requested_by,omitempty"`
+	Items           []PreviewItemDTO   `json:"items"`
+	Skipped         []PreviewSkipDTO   `json:"skipped"`
+	TopicsToCreate  []PlannedTopicDTO  `json:"topics_to_create"`
+	SourcesToCreate []PlannedSourceDTO `json:"sources_to_create"`
+	CreatedAt       string             `json:"created_at"`
+	ExpiresAt       string             `json:"expires_at"`
+}
+
+// PreviewItemDTO is one would-be article. Its image proxy URLs work once the
+// article is approved, since it keeps its ID.
+type PreviewItemDTO struct {
+	News NewsListItemDTO `json:"news"`
+	// SourceURL is the page the article was fetched from
+	SourceURL string            `json:"source_url,omitempty"`
+	Topics    []PlannedTopicDTO `json:"topics"`
+	Source    *PlannedSourceDTO `json:"source,omitempty"`
+	Status    string            `json:"status"`
+	Error     string            `json:"error,omitempty"`
+}
+
+type PreviewSkipDTO struct {
+	Title     string `json:"title"`
+	SourceURL string `json:"source_url,omitempty"`
+	Reason    string `json:"reason"`
+}
+
+type PlannedTopicDTO struct {
+	ID     string            `json:"id"`
+	Slug   string            `json:"slug"`
+	Label  BilingualFieldDTO `json:"label"`
+	Create bool              `json:"create"`
+}
+
+type PlannedSourceDTO struct {
+	ID        string `json:"id"`
+	Slug      string `json:"slug"`
+	Name      string `json:"name"`
+	URL       string `json:"url"`
+	Languages string `json:"languages"`
+	Create    bool   `json:"create"`
+}
+
+// PreviewApprovalDTO reports the items saved by an approval.
+type PreviewApprovalDTO struct {
+	Saved      []string             `json:"saved"`
+	Duplicates int                  `json:"duplicates"`
+	Failed     int                  `json:"failed"`
+	Preview    *IngestionPreviewDTO `json:"preview"`
+}
+
+func MapIngestionPreviewToDTO(p *entity.IngestionPreview) *IngestionPreviewDTO {
+	if p == nil {
+		return nil
+	}
+	out := &IngestionPreviewDTO{
+		ID:              p.ID,
+		Query:           p.Query,
+		TopK:            p.TopK,
+		RequestedBy:     p.RequestedBy,
+		Items:           make([]PreviewItemDTO, 0, len(p.Items)),
+		Skipped:         make([]PreviewSkipDTO, 0, len(p.Skipped)),
+		TopicsToCreate:  mapPlannedTopics(p.TopicsToCreate),
+		SourcesToCreate: make([]PlannedSourceDTO, 0, len(p.SourcesToCreate)),
+		CreatedAt:       p.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:       p.ExpiresAt.Format(time.RFC3339),
+	}
+	for i := range p.Items {
+		item := &p.Items[i]
+		mapped := PreviewItemDTO{
+			News:      MapNewsToDTO(&item.News),
+			SourceURL: item.News.SourceURL,
+			Topics:    mapPlannedTopics(item.Topics),
+			Status:    item.Status,
+			Error:     item.Error,
+		}
+		if item.Source != nil {
+			src := mapPlannedSource(*item.Source)
+			mapped.Source = &src
+		}
+		out.Items = append(out.Items, mapped)
+	}
+	for _, s := range p.Skipped {
+		out.Skipped = append(out.Skipped, PreviewSkipDTO{Title: s.Title, SourceURL: s.SourceURL, Reason: s.Reason})
+	}
+	for _, s := range p.SourcesToCreate {
+		out.SourcesToCreate = append(out.SourcesToCreate, mapPlannedSource(s))
+	}
+	return out
+}
+
+func mapPlannedTopics(list []entity.PlannedTopic) []PlannedTopicDTO {
+	out := make([]PlannedTopicDTO, 0, len(list))
+	for _, t := range list {
+		out = append(out, PlannedTopicDTO{
+			ID:     t.ID,
+			Slug:   t.Slug,
+			Label:  BilingualFieldDTO{EN: t.Label.EN, AM: t.Label.AM},
+			Create: t.Create,
+		})
+	}
+	return out
+}
+
+func mapPlannedSource(s entity.PlannedSource) PlannedSourceDTO {
+	return PlannedSourceDTO{
+		ID:        s.ID,
+		Slug:      s.Slug,
+		Name:      s.Name,
+		URL:       s.URL,
+		Languages: string(s.Languages),
+		Create:    s.Create,
+	}
+}
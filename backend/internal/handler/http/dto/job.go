@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+// JobDTO is a scheduled background job as shown to admins.
+type JobDTO struct {
+	Name           string     `json:"name"`
+	Description    string     `json:"description,omitempty"`
+	Schedule       string     `json:"schedule"`
+	Timezone       string     `json:"timezone"`
+	Paused         bool       `json:"paused"`
+	PausedBy       string     `json:"paused_by,omitempty"`
+	Running        bool       `json:"running"`
+	RunningOn      string     `json:"running_on,omitempty"`
+	NextRunAt      *time.Time `json:"next_run_at,omitempty"`
+	LastRunAt      *time.Time `json:"last_run_at,omitempty"`
+	LastDurationMS int64      `json:"last_duration_ms"`
+	LastStatus     string     `json:"last_status,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	LastResult     string     `json:"last_result,omitempty"`
+	LastTrigger    string     `json:"last_trigger,omitempty"`
+	RunCount       int        `json:"run_count"`
+}
+
+type JobListResponseDTO struct {
+	Jobs  []JobDTO `json:"jobs"`
+	Total int      `json:"total"`
+}
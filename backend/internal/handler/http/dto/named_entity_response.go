@@ -0,0 +1,38 @@
+package dto
+
+import "github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+
+// NamedEntityDTO represents a person, organization or place.
+type NamedEntityDTO struct {
+	ID           string            `json:"id"`
+	Slug         string            `json:"slug"`
+	Type         string            `json:"type"`
+	Label        BilingualFieldDTO `json:"label"`
+	ArticleCount int               `json:"article_count"`
+}
+
+type NamedEntityListResponseDTO struct {
+	Entities   []NamedEntityDTO `json:"entities"`
+	Total      int64            `json:"total"`
+	TotalPages int              `json:"total_pages"`
+	Page       int              `json:"page"`
+	Limit      int              `json:"limit"`
+}
+
+func MapNamedEntityToDTO(e *entity.NamedEntity) NamedEntityDTO {
+	return NamedEntityDTO{
+		ID:           e.ID,
+		Slug:         e.Slug,
+		Type:         string(e.Type),
+		Label:        BilingualFieldDTO{EN: e.Label.EN, AM: e.Label.AM},
+		ArticleCount: e.ArticleCount,
+	}
+}
+
+func MapNamedEntitiesToDTOs(list []*entity.NamedEntity) []NamedEntityDTO {
+	out := make([]NamedEntityDTO, 0, len(list))
+	for _, e := range list {
+		out = append(out, MapNamedEntityToDTO(e))
+	}
+	return out
+}
@@ -22,3 +22,8 @@ type NewsIngestResponse struct {
 	PublishedAt time.Time `json:"published_at"`
 	CreatedAt   time.Time `json:"created_at"`
 }
+
+// ApprovePreviewRequest selects the items of an ingestion preview to save, by news ID.
+type ApprovePreviewRequest struct {
+	NewsIDs []string `json:"news_ids" binding:"required"`
+}
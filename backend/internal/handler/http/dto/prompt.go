@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// CreatePromptRequest adds a new version of a built-in prompt.
+type CreatePromptRequest struct {
+	Name     string `json:"name" binding:"required"`
+	Language string `json:"language" binding:"omitempty,oneof=en am"`
+	Text     string `json:"text" binding:"required"`
+	Note     string `json:"note"`
+	// Active serves the version right away; Weight splits traffic with other active versions
+	Active bool `json:"active"`
+	Weight int  `json:"weight" binding:"omitempty,min=1,max=10000"`
+}
+
+// SetPromptActiveRequest serves or withdraws a stored prompt version.
+type SetPromptActiveRequest struct {
+	Active *bool `json:"active" binding:"required"`
+	Weight int   `json:"weight" binding:"omitempty,min=1,max=10000"`
+}
+
+type PromptDTO struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Language  string    `json:"language,omitempty"`
+	Version   int       `json:"version"`
+	Origin    string    `json:"origin"`
+	Text      string    `json:"text"`
+	Active    bool      `json:"active"`
+	Weight    int       `json:"weight"`
+	Variables []string  `json:"variables"`
+	Required  []string  `json:"required"`
+	Untrusted []string  `json:"untrusted,omitempty"`
+	Note      string    `json:"note,omitempty"`
+	CreatedBy string    `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+func MapPromptToDTO(t *entity.PromptTemplate) PromptDTO {
+	return PromptDTO{
+		ID:        t.ID,
+		Name:      t.Name,
+		Language:  t.Language,
+		Version:   t.Version,
+		Origin:    string(t.Origin),
+		Text:      t.Text,
+		Active:    t.Active,
+		Weight:    t.Weight,
+		Variables: t.Variables,
+		Required:  t.Required,
+		Untrusted: t.Untrusted,
+		Note:      t.Note,
+		CreatedBy: t.CreatedBy,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+func MapPromptsToDTOs(list []entity.PromptTemplate) []PromptDTO {
+	out := make([]PromptDTO, 0, len(list))
+	for i := range list {
+		out = append(out, MapPromptToDTO(&list[i]))
+	}
+	return out
+}
@@ -0,0 +1,9 @@
+package dto
+
+import "time"
+
+// ProviderBackfillRequest re-fetches provider items published in [from, to).
+type ProviderBackfillRequest struct {
+	From time.Time `json:"from" binding:"required"`
+	To   time.Time `json:"to" binding:"required"`
+}
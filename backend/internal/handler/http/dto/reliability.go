@@ -0,0 +1,97 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// ReportNewsRequest flags an article; reason is one of misleading, clickbait,
+// inaccurate, spam, offensive or other.
+type ReportNewsRequest struct {
+	NewsID string `json:"news_id" binding:"required"`
+	Reason string `json:"reason" binding:"required"`
+	Note   string `json:"note"`
+}
+
+type NewsReportDTO struct {
+	ID        string    `json:"id"`
+	NewsID    string    `json:"news_id"`
+	Reason    string    `json:"reason"`
+	Note      string    `json:"note,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func MapNewsReportToDTO(r *entity.NewsReport) NewsReportDTO {
+	return NewsReportDTO{ID: r.ID, NewsID: r.NewsID, Reason: string(r.Reason), Note: r.Note, CreatedAt: r.CreatedAt}
+}
+
+// SetReliabilityOverrideRequest pins a source's score between 0 and 100.
+type SetReliabilityOverrideRequest struct {
+	Score *float64 `json:"score" binding:"required"`
+	Note  string   `json:"note"`
+}
+
+type ReliabilityComponentDTO struct {
+	Name      string  `json:"name"`
+	Score     float64 `json:"score"`
+	Weight    float64 `json:"weight"`
+	Available bool    `json:"available"`
+	Detail    string  `json:"detail"`
+}
+
+type ReliabilityOverrideDTO struct {
+	Score float64   `json:"score"`
+	Note  string    `json:"note,omitempty"`
+	SetBy string    `json:"set_by,omitempty"`
+	SetAt time.Time `json:"set_at"`
+}
+
+// SourceReliabilityDTO explains the reliability score of a source to admins.
+type SourceReliabilityDTO struct {
+	Slug             string  `json:"slug"`
+	Name             string  `json:"name"`
+	ReliabilityScore float64 `json:"reliability_score"`
+	// ComputedScore is the job's score, kept even while an override applies
+	ComputedScore float64                   `json:"computed_score"`
+	Confidence    float64                   `json:"confidence"`
+	WindowDays    int                       `json:"window_days"`
+	Articles      int                       `json:"articles"`
+	Components    []ReliabilityComponentDTO `json:"components"`
+	Override      *ReliabilityOverrideDTO   `json:"override,omitempty"`
+	// ComputedAt is null until the source is first scored
+	ComputedAt *time.Time `json:"computed_at"`
+}
+
+type SourceReliabilityListDTO struct {
+	Sources []SourceReliabilityDTO `json:"sources"`
+	Total   int                    `json:"total"`
+}
+
+func MapSourceReliabilityToDTO(src entity.Source) SourceReliabilityDTO {
+	out := SourceReliabilityDTO{
+		Slug:             src.Slug,
+		Name:             src.Name,
+		ReliabilityScore: src.ReliabilityScore,
+		Components:       []ReliabilityComponentDTO{},
+	}
+	if b := src.Reliability; b != nil {
+		computedAt := b.ComputedAt
+		out.ComputedScore, out.Confidence, out.WindowDays, out.Articles, out.ComputedAt = b.ComputedScore, b.Confidence, b.WindowDays, b.Articles, &computedAt
+		for _, c := range b.Components {
+			out.Components = append(out.Components, ReliabilityComponentDTO{Name: c.Name, Score: c.Score, Weight: c.Weight, Available: c.Available, Detail: c.Detail})
+		}
+	}
+	if o := src.ReliabilityOverride; o != nil {
+		out.Override = &ReliabilityOverrideDTO{Score: o.Score, Note: o.Note, SetBy: o.SetBy, SetAt: o.SetAt}
+	}
+	return out
+}
+
+func MapSourceReliabilityToDTOs(sources []entity.Source) []SourceReliabilityDTO {
+	out := make([]SourceReliabilityDTO, 0, len(sources))
+	for _, s := range sources {
+		out = append(out, MapSourceReliabilityToDTO(s))
+	}
+	return out
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// ReprocessRequest re-runs stages over the stored articles matching the
+// filter. Stages are summarize, classify, translate, localize_date and embed;
+// missing is one of summary, topics, translation or localized_date.
+type ReprocessRequest struct {
+	From       *time.Time `json:"from"`
+	To         *time.Time `json:"to"`
+	Source     string     `json:"source"`
+	Topic      string     `json:"topic"`
+	Language   string     `json:"language"`
+	Missing    string     `json:"missing"`
+	Stages     []string   `json:"stages" binding:"required"`
+	RatePerMin int        `json:"rate_per_min"`
+}
+
+type ReprocessJobListDTO struct {
+	Jobs  []*entity.ReprocessJob `json:"jobs"`
+	Total int                    `json:"total"`
+}
@@ -71,6 +71,11 @@ type SubscribeTopicsRequest struct {
 	Topics []string `json:"topics" binding:"required"`
 }
 
+// FollowEntitiesRequest follows one or more named entities (people, organizations, places).
+type FollowEntitiesRequest struct {
+	Entities []string `json:"entities" binding:"required"`
+}
+
 // NotificationsRequestDTO defines the nested notifications object for preference updates.
 type NotificationsRequestDTO struct {
 	DailyBrief   *bool `json:"daily_brief"`
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
@@ -35,6 +37,7 @@ func ToUserResponse(user entity.User) UserResponse {
 		Preferences: PreferencesDTO{
 			Topics:            user.Preferences.Topics,
 			SubscribedSources: user.Preferences.SubscribedSources,
+			Entities:          user.Preferences.Entities,
 			Notifications: NotificationsDTO{ // This assumes your entity.Preferences has this nested struct
 				DailyBrief:   user.Preferences.Notifications.DailyBrief,
 				BreakingNews: user.Preferences.Notifications.BreakingNews,
@@ -79,6 +82,7 @@ type PreferencesDTO struct {
 	Lang              string           `json:"lang"`
 	Topics            []string         `json:"topics"`             // This field is now correctly included
 	SubscribedSources []string         `json:"subscribed_sources"` // This field is now correctly included
+	Entities          []string         `json:"entities"`
 	BriefType         string           `json:"brief_type"`
 	DataSaver         bool             `json:"data_saver"`
 	Notifications     NotificationsDTO `json:"notifications"`
@@ -95,6 +99,11 @@ type SourceDTO struct {
 	Languages        string   `json:"languages"`
 	Topics           []string `json:"topics"`
 	ReliabilityScore float64  `json:"reliability_score"`
+	// FeedURLs and PollIntervalMinutes configure native feed ingestion
+	FeedURLs            []string `json:"feed_urls,omitempty"`
+	PollIntervalMinutes int      `json:"poll_interval_minutes,omitempty"`
+	// LogoProxyURL serves the logo from the API's image cache (accepts ?w=)
+	LogoProxyURL string `json:"logo_proxy_url,omitempty"`
 }
 
 // SourcesResponseDTO is the response for the GET /v1/sources endpoint.
@@ -108,19 +117,30 @@ func MapSourcesToDTOs(sources []entity.Source) []SourceDTO {
 	sourceDTOs := make([]SourceDTO, len(sources))
 	for i, source := range sources {
 		sourceDTOs[i] = SourceDTO{
-			ID:               source.ID,
-			Slug:             source.Slug,
-			Name:             source.Name,
-			Description:      source.Description,
-			URL:              source.URL,
-			LogoURL:          source.LogoURL,
-			Languages:        string(source.Languages),
-			ReliabilityScore: source.ReliabilityScore,
+			ID:                  source.ID,
+			Slug:                source.Slug,
+			Name:                source.Name,
+			Description:         source.Description,
+			URL:                 source.URL,
+			LogoURL:             source.LogoURL,
+			LogoProxyURL:        logoProxyURL(source),
+			Languages:           string(source.Languages),
+			ReliabilityScore:    source.ReliabilityScore,
+			FeedURLs:            source.FeedURLs,
+			PollIntervalMinutes: source.PollIntervalMinutes,
 		}
 	}
 	return sourceDTOs
 }
 
+// logoProxyURL is empty for sources without a logo.
+func logoProxyURL(source entity.Source) string {
+	if source.LogoURL == "" {
+		return ""
+	}
+	return "/api/v1/sources/" + url.PathEscape(source.Slug) + "/logo"
+}
+
 // NotificationsDTO defines the nested notifications object in API responses.
 type NotificationsDTO struct {
 	DailyBrief   bool `json:"daily_brief"`
@@ -173,11 +193,37 @@ type NewsListItemDTO struct {
 	Language               string   `json:"language"`
 	SourceID               string   `json:"source_id"`
 	Topics                 []string `json:"topics,omitempty"`
+	StoryID                string   `json:"story_id,omitempty"`
+	EntityIDs              []string `json:"entity_ids,omitempty"`
 	PublishedAt            string   `json:"published_at"`
 	PublishedDateLocalized string   `json:"published_date_localized,omitempty"`
 	CreatedAt              string   `json:"created_at"`
 	UpdatedAt              string   `json:"updated_at"`
 	IsBookmarked           *bool    `json:"is_bookmarked,omitempty"`
+	// Translations is keyed by field (e.g. "title_am") for fields produced by machine translation
+	Translations map[string]TranslationStateDTO `json:"translations,omitempty"`
+	// LeadImage is also the first entry of Images
+	LeadImage *NewsImageDTO  `json:"lead_image,omitempty"`
+	Images    []NewsImageDTO `json:"images,omitempty"`
+}
+
+// NewsImageDTO is an article image. ProxyURL serves it from the API's image
+// cache; append ?w=<pixels> for a resized copy.
+type NewsImageDTO struct {
+	ProxyURL    string `json:"proxy_url"`
+	OriginalURL string `json:"original_url"`
+	Width       int    `json:"width,omitempty"`
+	Height      int    `json:"height,omitempty"`
+	Alt         string `json:"alt,omitempty"`
+}
+
+// TranslationStateDTO tells clients how a translated field was produced.
+type TranslationStateDTO struct {
+	Status string `json:"status"`
+	// Machine is true when the field holds a machine translation
+	Machine bool `json:"machine"`
+	// Pending is true while the field still shows the source-language text awaiting translation
+	Pending bool `json:"pending"`
 }
 
 type NewsListResponseDTO struct {
@@ -191,56 +237,81 @@ type NewsListResponseDTO struct {
 func MapNewsToDTOs(list []*entity.News) []NewsListItemDTO {
 	out := make([]NewsListItemDTO, 0, len(list))
 	for _, n := range list {
-		out = append(out, NewsListItemDTO{
-			ID:                     n.ID,
-			Title:                  n.Title,
-			Body:                   n.Body,
-			TitleEN:                n.TitleEN,
-			TitleAM:                n.TitleAM,
-			BodyEN:                 n.BodyEN,
-			BodyAM:                 n.BodyAM,
-			SummaryEN:              n.SummaryEN,
-			SummaryAM:              n.SummaryAM,
-			Language:               n.Language,
-			SourceID:               n.SourceID,
-			Topics:                 n.Topics,
-			PublishedAt:            n.PublishedAt.Format(time.RFC3339),
-			PublishedDateLocalized: n.PublishedDateLocalized,
-			CreatedAt:              n.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:              n.UpdatedAt.Format(time.RFC3339),
-		})
+		out = append(out, MapNewsToDTO(n))
 	}
 	return out
 }
 
+// MapNewsToDTO maps one article. Fields still awaiting translation carry the
+// source-language text and are flagged as pending in Translations.
+func MapNewsToDTO(n *entity.News) NewsListItemDTO {
+	item := NewsListItemDTO{
+		ID:                     n.ID,
+		Title:                  n.Title,
+		Body:                   n.Body,
+		TitleEN:                n.TitleEN,
+		TitleAM:                n.TitleAM,
+		BodyEN:                 n.BodyEN,
+		BodyAM:                 n.BodyAM,
+		SummaryEN:              n.SummaryEN,
+		SummaryAM:              n.SummaryAM,
+		Language:               n.Language,
+		SourceID:               n.SourceID,
+		Topics:                 n.Topics,
+		StoryID:                n.StoryID,
+		EntityIDs:              n.EntityIDs,
+		PublishedAt:            n.PublishedAt.Format(time.RFC3339),
+		PublishedDateLocalized: n.PublishedDateLocalized,
+		CreatedAt:              n.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:              n.UpdatedAt.Format(time.RFC3339),
+	}
+	for i, img := range n.Images {
+		item.Images = append(item.Images, NewsImageDTO{
+			ProxyURL:    fmt.Sprintf("/api/v1/news/%s/images/%d", url.PathEscape(n.ID), i),
+			OriginalURL: img.URL,
+			Width:       img.Width,
+			Height:      img.Height,
+			Alt:         img.Alt,
+		})
+	}
+	if len(item.Images) > 0 {
+		item.LeadImage = &item.Images[0]
+	}
+	if len(n.Translations) == 0 {
+		return item
+	}
+	fields := map[string]*string{
+		entity.FieldTitleEN: &item.TitleEN, entity.FieldTitleAM: &item.TitleAM,
+		entity.FieldBodyEN: &item.BodyEN, entity.FieldBodyAM: &item.BodyAM,
+		entity.FieldSummaryEN: &item.SummaryEN, entity.FieldSummaryAM: &item.SummaryAM,
+	}
+	item.Translations = make(map[string]TranslationStateDTO, len(n.Translations))
+	for key, t := range n.Translations {
+		pending := t.Status == entity.TranslationPending || t.Status == entity.TranslationFailed
+		item.Translations[key] = TranslationStateDTO{
+			Status:  string(t.Status),
+			Machine: t.Status == entity.TranslationDone,
+			Pending: pending,
+		}
+		if target, ok := fields[key]; ok && pending && *target == "" {
+			if src, ok := fields[t.SourceField]; ok {
+				*target = *src
+			}
+		}
+	}
+	return item
+}
+
 // MapNewsToDTOsWithBookmarks maps news list and enriches with per-user bookmark flags
 func MapNewsToDTOsWithBookmarks(list []*entity.News, flags map[string]bool) []NewsListItemDTO {
 	out := make([]NewsListItemDTO, 0, len(list))
 	for _, n := range list {
-		var bm *bool
+		item := MapNewsToDTO(n)
 		if flags != nil {
 			v := flags[n.ID]
-			bm = &v
+			item.IsBookmarked = &v
 		}
-		out = append(out, NewsListItemDTO{
-			ID:                     n.ID,
-			Title:                  n.Title,
-			Body:                   n.Body,
-			TitleEN:                n.TitleEN,
-			TitleAM:                n.TitleAM,
-			BodyEN:                 n.BodyEN,
-			BodyAM:                 n.BodyAM,
-			SummaryEN:              n.SummaryEN,
-			SummaryAM:              n.SummaryAM,
-			Language:               n.Language,
-			SourceID:               n.SourceID,
-			Topics:                 n.Topics,
-			PublishedAt:            n.PublishedAt.Format(time.RFC3339),
-			PublishedDateLocalized: n.PublishedDateLocalized,
-			CreatedAt:              n.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:              n.UpdatedAt.Format(time.RFC3339),
-			IsBookmarked:           bm,
-		})
+		out = append(out, item)
 	}
 	return out
 }
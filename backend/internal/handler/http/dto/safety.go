@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// SafetyEventDTO is one blocked or redacted AI request, as shown to admins.
+type SafetyEventDTO struct {
+	ID        string    `json:"id"`
+	Stage     string    `json:"stage"`
+	Action    string    `json:"action"`
+	Rule      string    `json:"rule"`
+	Feature   string    `json:"feature"`
+	Key       string    `json:"key,omitempty"`
+	Excerpt   string    `json:"excerpt"`
+	UserID    string    `json:"user_id,omitempty"`
+	ClientIP  string    `json:"client_ip,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SafetyEventListResponseDTO struct {
+	Events     []SafetyEventDTO `json:"events"`
+	Total      int64            `json:"total"`
+	TotalPages int              `json:"total_pages"`
+	Page       int              `json:"page"`
+	Limit      int              `json:"limit"`
+}
+
+func MapSafetyEventsToDTOs(list []*entity.SafetyEvent) []SafetyEventDTO {
+	out := make([]SafetyEventDTO, 0, len(list))
+	for _, e := range list {
+		out = append(out, SafetyEventDTO{
+			ID:        e.ID,
+			Stage:     string(e.Stage),
+			Action:    string(e.Action),
+			Rule:      e.Rule,
+			Feature:   e.Feature,
+			Key:       e.Key,
+			Excerpt:   e.Excerpt,
+			UserID:    e.UserID,
+			ClientIP:  e.ClientIP,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+	return out
+}
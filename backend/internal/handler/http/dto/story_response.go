@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// StoryDTO represents a multi-source story cluster.
+type StoryDTO struct {
+	ID               string            `json:"id"`
+	Headline         BilingualFieldDTO `json:"headline"`
+	Summary          BilingualFieldDTO `json:"summary"`
+	PromptVersion    string            `json:"prompt_version,omitempty"`
+	ArticleCount     int               `json:"article_count"`
+	NewsIDs          []string          `json:"news_ids"`
+	SourceIDs        []string          `json:"source_ids,omitempty"`
+	FirstPublishedAt string            `json:"first_published_at"`
+	LastPublishedAt  string            `json:"last_published_at"`
+}
+
+type StoryListResponseDTO struct {
+	Stories    []StoryDTO `json:"stories"`
+	Total      int64      `json:"total"`
+	TotalPages int        `json:"total_pages"`
+	Page       int        `json:"page"`
+	Limit      int        `json:"limit"`
+}
+
+// StoryDetailResponseDTO is a story with its member articles.
+type StoryDetailResponseDTO struct {
+	Story    StoryDTO          `json:"story"`
+	Articles []NewsListItemDTO `json:"articles"`
+}
+
+func MapStoryToDTO(s *entity.Story) StoryDTO {
+	return StoryDTO{
+		ID:               s.ID,
+		Headline:         BilingualFieldDTO{EN: s.Headline.EN, AM: s.Headline.AM},
+		Summary:          BilingualFieldDTO{EN: s.Summary.EN, AM: s.Summary.AM},
+		PromptVersion:    s.PromptVersion,
+		ArticleCount:     s.ArticleCount,
+		NewsIDs:          s.NewsIDs,
+		SourceIDs:        s.SourceIDs,
+		FirstPublishedAt: s.FirstPublishedAt.Format(time.RFC3339),
+		LastPublishedAt:  s.LastPublishedAt.Format(time.RFC3339),
+	}
+}
+
+func MapStoriesToDTOs(list []*entity.Story) []StoryDTO {
+	out := make([]StoryDTO, 0, len(list))
+	for _, s := range list {
+		out = append(out, MapStoryToDTO(s))
+	}
+	return out
+}
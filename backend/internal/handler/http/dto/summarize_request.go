@@ -1,11 +1,28 @@
 package dto
 
+import "time"
+
 type SummarizeRequest struct {
 	NewsID string `json:"news_id" binding:"required"`
+	// Optional variant (headline, short, bullets, detailed) and language (en, am)
+	Type     string `json:"type" binding:"omitempty,oneof=headline short bullets detailed"`
+	Language string `json:"language" binding:"omitempty,oneof=en am"`
 }
 
 type SummarizeResponse struct {
-	NewsID  string `json:"news_id"`
-	Summary string `json:"summary"`
+	NewsID   string `json:"news_id"`
+	Summary  string `json:"summary"`
 	Language string `json:"language"`
-}
\ No newline at end of file
+}
+
+// SummaryVariantResponse is returned for a typed summary variant.
+type SummaryVariantResponse struct {
+	NewsID        string    `json:"news_id"`
+	Type          string    `json:"type"`
+	Language      string    `json:"language"`
+	Content       string    `json:"content"`
+	Bullets       []string  `json:"bullets,omitempty"`
+	Model         string    `json:"model,omitempty"`
+	PromptVersion string    `json:"prompt_version,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
@@ -0,0 +1,131 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// EditorialHandler serves the post-editing workflow for admins and editors.
+type EditorialHandler struct {
+	uc contract.IEditorialUsecase
+}
+
+func NewEditorialHandler(uc contract.IEditorialUsecase) *EditorialHandler {
+	return &EditorialHandler{uc: uc}
+}
+
+func (h *EditorialHandler) authorize(c *gin.Context) bool {
+	if !hasRole(c, "admin", "editor") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Editors only"})
+		return false
+	}
+	return true
+}
+
+// GetArticle handles GET /api/v1/admin/editorial/news/:id
+func (h *EditorialHandler) GetArticle(c *gin.Context) {
+	if !h.authorize(c) {
+		return
+	}
+	news, revs, err := h.uc.GetArticle(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapEditorialArticle(news, revs))
+}
+
+// CorrectField handles PUT /api/v1/admin/editorial/news/:id/fields/:field
+func (h *EditorialHandler) CorrectField(c *gin.Context) {
+	if !h.authorize(c) {
+		return
+	}
+	var req dto.CorrectFieldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+	segments := make([]contract.SegmentCorrection, 0, len(req.Segments))
+	for _, s := range req.Segments {
+		segments = append(segments, contract.SegmentCorrection{Source: s.Source, Target: s.Target})
+	}
+	news, err := h.uc.CorrectField(c.Request.Context(), c.Param("id"), c.Param("field"), req.Content, c.GetString("userID"), req.Note, segments)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	_, revs, err := h.uc.GetArticle(c.Request.Context(), news.ID)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapEditorialArticle(news, revs))
+}
+
+// ListRevisions handles GET /api/v1/admin/editorial/news/:id/revisions?field=
+func (h *EditorialHandler) ListRevisions(c *gin.Context) {
+	if !h.authorize(c) {
+		return
+	}
+	revs, err := h.uc.ListRevisions(c.Request.Context(), c.Param("id"), c.Query("field"))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"revisions": dto.MapRevisionsToDTOs(revs)})
+}
+
+// ListMemory handles GET /api/v1/admin/editorial/memory?source_lang=&target_lang=&limit=
+func (h *EditorialHandler) ListMemory(c *gin.Context) {
+	if !h.authorize(c) {
+		return
+	}
+	limit := 100
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	list, err := h.uc.ListMemory(c.Request.Context(), c.Query("source_lang"), c.Query("target_lang"), limit)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"entries": dto.MapMemoryEntriesToDTOs(list)})
+}
+
+// AddMemoryEntry handles POST /api/v1/admin/editorial/memory
+func (h *EditorialHandler) AddMemoryEntry(c *gin.Context) {
+	if !h.authorize(c) {
+		return
+	}
+	var req dto.AddMemoryEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+	e, err := h.uc.AddMemoryEntry(c.Request.Context(), req.SourceLang, req.TargetLang, req.Source, req.Target, c.GetString("userID"))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapMemoryEntryToDTO(e))
+}
+
+func (h *EditorialHandler) respondError(c *gin.Context, err error) {
+	msg := err.Error()
+	switch {
+	case errors.Is(err, contract.ErrNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "news not found"})
+	case errors.Is(err, contract.ErrConflict):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{Error: "the field changed while saving; reload and retry"})
+	case strings.HasPrefix(msg, "unsupported"), strings.HasSuffix(msg, "required"):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: msg})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: msg})
+	}
+}
@@ -0,0 +1,34 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+type EmbeddingHandler struct {
+	scheduler contract.IScheduler
+}
+
+func NewEmbeddingHandler(scheduler contract.IScheduler) *EmbeddingHandler {
+	return &EmbeddingHandler{scheduler: scheduler}
+}
+
+// BackfillEmbeddings handles POST /api/v1/admin/embeddings/backfill.
+// It triggers the embedding_backfill job, which runs on one replica at a time.
+func (h *EmbeddingHandler) BackfillEmbeddings(c *gin.Context) {
+	userRole, _ := c.Get("userRole")
+	if role, ok := userRole.(string); !ok || strings.TrimSpace(role) != "admin" {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	job, err := h.scheduler.Trigger(c.Request.Context(), contract.EmbeddingBackfillJob, c.GetString("userID"))
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, jobDTO(*job))
+}
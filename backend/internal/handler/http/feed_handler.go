@@ -0,0 +1,67 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// FeedHandler lets admins configure and poll the RSS/Atom/JSON feeds of sources.
+type FeedHandler struct {
+	uc contract.IFeedIngestionUsecase
+}
+
+func NewFeedHandler(uc contract.IFeedIngestionUsecase) *FeedHandler {
+	return &FeedHandler{uc: uc}
+}
+
+// SetFeeds handles PUT /api/v1/admin/sources/:slug/feeds
+func (h *FeedHandler) SetFeeds(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	var req dto.SetFeedsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+	src, err := h.uc.SetFeeds(c.Request.Context(), c.Param("slug"), req.FeedURLs, req.PollIntervalMinutes)
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapSourcesToDTOs([]entity.Source{*src})[0])
+}
+
+// PollSource handles POST /api/v1/admin/sources/:slug/poll, polling the feeds now
+// regardless of their interval.
+func (h *FeedHandler) PollSource(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	res, err := h.uc.PollSource(withJob(c, "feed_ingestion"), c.Param("slug"))
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ListFeeds handles GET /api/v1/admin/feeds
+func (h *FeedHandler) ListFeeds(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	list, err := h.uc.ListStates(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.FeedStateListResponseDTO{Feeds: dto.MapFeedStatesToDTOs(list), Total: len(list)})
+}
@@ -1,8 +1,13 @@
 package http
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
 	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
 	"github.com/gin-gonic/gin"
 )
@@ -30,3 +35,62 @@ func BindAndValidate(c *gin.Context, req interface{}) error {
 	}
 	return nil
 }
+
+// pageParams reads ?page= and ?limit= with the given default limit.
+func pageParams(c *gin.Context, defaultLimit int) (int, int) {
+	page, limit := 1, defaultLimit
+	if p, err := strconv.Atoi(c.Query("page")); err == nil && p > 0 {
+		page = p
+	}
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	return page, limit
+}
+
+// hasRole reports whether the authenticated user has one of the given roles.
+func hasRole(c *gin.Context, roles ...string) bool {
+	role := strings.TrimSpace(c.GetString("userRole"))
+	for _, r := range roles {
+		if role == r {
+			return true
+		}
+	}
+	return false
+}
+
+// respondAIError writes the error of an AI-backed endpoint; an exhausted daily
+// budget is reported as 429 so clients can back off, and content refused by
+// the safety checks as 422.
+func respondAIError(c *gin.Context, err error) {
+	if errors.Is(err, contract.ErrNotFound) {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if errors.Is(err, contract.ErrInvalidInput) {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if errors.Is(err, contract.ErrBudgetExceeded) {
+		c.JSON(http.StatusTooManyRequests, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if errors.Is(err, contract.ErrUnsafeContent) {
+		c.JSON(http.StatusUnprocessableEntity, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if errors.Is(err, contract.ErrConflict) {
+		c.JSON(http.StatusConflict, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+}
+
+// withJob budgets the AI calls of an admin-triggered job as that job while
+// keeping the requesting user on record.
+func withJob(c *gin.Context, job string) context.Context {
+	ctx := c.Request.Context()
+	actor := contract.UsageActorFrom(ctx)
+	actor.Job = job
+	return contract.WithUsageActor(ctx, actor)
+}
@@ -20,6 +20,7 @@ func NewIngestionHandler(ingestionUC contract.INewsIngestionService, providerUC
 }
 
 // IngestNews expects scraped news payload, summarizes it, then persists.
+// Callers authenticate with an API key (see middleware.MachineAuth).
 func (h *IngestionHandler) IngestNews(c *gin.Context) {
 	var req dto.NewsIngestRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -27,6 +28,12 @@ func (h *IngestionHandler) IngestNews(c *gin.Context) {
 		return
 	}
 
+	// keys issued for one newsroom may only push that newsroom's articles
+	if only := c.GetString("apiKeySourceID"); only != "" && req.SourceID != only {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "This API key may only push articles for its own source"})
+		return
+	}
+
 	news := &entity.News{
 		Title:       req.Title,
 		Body:        req.Body,
@@ -36,9 +43,9 @@ func (h *IngestionHandler) IngestNews(c *gin.Context) {
 		PublishedAt: req.PublishedAt,
 	}
 
-	saved, _, err := h.ingestionUC.SaveAfterSummarize(news)
+	saved, _, err := h.ingestionUC.SaveAfterSummarize(c.Request.Context(), news)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		respondAIError(c, err)
 		return
 	}
 
@@ -55,7 +62,8 @@ func (h *IngestionHandler) IngestNews(c *gin.Context) {
 	})
 }
 
-// IngestFromProvider triggers fetching latest news from external provider, summarizes, classifies topics, creates missing topics, then saves
+// IngestFromProvider triggers fetching latest news from external provider, summarizes, classifies topics, creates missing topics, then saves.
+// With dry_run nothing is saved; the would-be articles are returned as a preview to approve.
 func (h *IngestionHandler) IngestFromProvider(c *gin.Context) {
 	userRole, exists := c.Get("userRole")
 	if !exists {
@@ -69,17 +77,89 @@ func (h *IngestionHandler) IngestFromProvider(c *gin.Context) {
 		return
 	}
 	var req struct {
-		Query string `json:"query"`
-		TopK  int    `json:"top_k"`
+		Query  string `json:"query"`
+		TopK   int    `json:"top_k"`
+		DryRun bool   `json:"dry_run"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
 		return
 	}
-	ids, skipped, err := h.providerUC.IngestFromProvider(c.Request.Context(), req.Query, req.TopK)
+	if req.DryRun {
+		preview, err := h.providerUC.PreviewFromProvider(withJob(c, "provider_ingestion_preview"), req.Query, req.TopK, c.GetString("userID"))
+		if err != nil {
+			c.JSON(http.StatusBadGateway, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, dto.MapIngestionPreviewToDTO(preview))
+		return
+	}
+	ids, skipped, err := h.providerUC.IngestFromProvider(withJob(c, "provider_ingestion"), req.Query, req.TopK)
+	if err != nil && len(ids) > 0 {
+		// the run was cut short; what finished is saved
+		c.JSON(http.StatusCreated, gin.H{"ingested": len(ids), "ids": ids, "skipped": skipped, "partial": true, "error": err.Error()})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusBadGateway, dto.ErrorResponse{Error: err.Error()})
 		return
 	}
 	c.JSON(http.StatusCreated, gin.H{"ingested": len(ids), "ids": ids, "skipped": skipped})
 }
+
+// GetPreview handles GET /api/v1/admin/ingest/previews/:id
+func (h *IngestionHandler) GetPreview(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	preview, err := h.providerUC.GetPreview(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapIngestionPreviewToDTO(preview))
+}
+
+// ApprovePreview handles POST /api/v1/admin/ingest/previews/:id/approve,
+// saving the selected items of a dry run.
+func (h *IngestionHandler) ApprovePreview(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	var req dto.ApprovePreviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+	res, err := h.providerUC.ApprovePreview(withJob(c, "provider_ingestion"), c.Param("id"), req.NewsIDs)
+	if err != nil && res != nil {
+		// the run was cut short; the saved items are recorded on the preview
+		c.JSON(http.StatusCreated, gin.H{"saved": res.Saved, "duplicates": res.Duplicates, "failed": res.Failed, "preview": dto.MapIngestionPreviewToDTO(res.Preview), "partial": true, "error": err.Error()})
+		return
+	}
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.PreviewApprovalDTO{
+		Saved:      res.Saved,
+		Duplicates: res.Duplicates,
+		Failed:     res.Failed,
+		Preview:    dto.MapIngestionPreviewToDTO(res.Preview),
+	})
+}
+
+// DiscardPreview handles DELETE /api/v1/admin/ingest/previews/:id
+func (h *IngestionHandler) DiscardPreview(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	if err := h.providerUC.DiscardPreview(c.Request.Context(), c.Param("id")); err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Preview discarded"})
+}
@@ -0,0 +1,102 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// JobHandler lets admins inspect, pause and trigger scheduled jobs.
+type JobHandler struct {
+	scheduler contract.IScheduler
+}
+
+func NewJobHandler(scheduler contract.IScheduler) *JobHandler {
+	return &JobHandler{scheduler: scheduler}
+}
+
+// ListJobs handles GET /api/v1/admin/jobs
+func (h *JobHandler) ListJobs(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	jobs, err := h.scheduler.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.JobListResponseDTO{Jobs: jobDTOs(jobs), Total: len(jobs)})
+}
+
+// PauseJob handles POST /api/v1/admin/jobs/:name/pause; a run in progress finishes.
+func (h *JobHandler) PauseJob(c *gin.Context) {
+	h.setPaused(c, true)
+}
+
+// ResumeJob handles POST /api/v1/admin/jobs/:name/resume
+func (h *JobHandler) ResumeJob(c *gin.Context) {
+	h.setPaused(c, false)
+}
+
+func (h *JobHandler) setPaused(c *gin.Context, paused bool) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	job, err := h.scheduler.SetPaused(c.Request.Context(), c.Param("name"), paused, c.GetString("userID"))
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, jobDTO(*job))
+}
+
+// RunJob handles POST /api/v1/admin/jobs/:name/run, starting a run in the background.
+func (h *JobHandler) RunJob(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	job, err := h.scheduler.Trigger(c.Request.Context(), c.Param("name"), c.GetString("userID"))
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, jobDTO(*job))
+}
+
+// jobDTO lives here because dto cannot import contract (contract imports dto).
+func jobDTO(j contract.JobStatus) dto.JobDTO {
+	d := dto.JobDTO{
+		Name:           j.Name,
+		Description:    j.Description,
+		Schedule:       j.Schedule,
+		Timezone:       j.Timezone,
+		Paused:         j.Paused,
+		PausedBy:       j.PausedBy,
+		Running:        j.Running,
+		NextRunAt:      j.NextRunAt,
+		LastRunAt:      j.LastRunAt,
+		LastDurationMS: j.LastDurationMS,
+		LastStatus:     j.LastStatus,
+		LastError:      j.LastError,
+		LastResult:     j.LastResult,
+		LastTrigger:    j.LastTrigger,
+		RunCount:       j.RunCount,
+	}
+	if j.Running {
+		d.RunningOn = j.LeaseOwner
+	}
+	return d
+}
+
+func jobDTOs(list []contract.JobStatus) []dto.JobDTO {
+	out := make([]dto.JobDTO, 0, len(list))
+	for _, j := range list {
+		out = append(out, jobDTO(j))
+	}
+	return out
+}
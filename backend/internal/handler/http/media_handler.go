@@ -0,0 +1,78 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// MediaHandler serves article images and source logos from the image proxy.
+type MediaHandler struct {
+	uc contract.IMediaUsecase
+}
+
+func NewMediaHandler(uc contract.IMediaUsecase) *MediaHandler {
+	return &MediaHandler{uc: uc}
+}
+
+// GetNewsImage handles GET /api/v1/news/:id/images/:index?w=
+func (h *MediaHandler) GetNewsImage(c *gin.Context) {
+	index, err := strconv.Atoi(c.Param("index"))
+	if err != nil || index < 0 {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "index must be a non-negative integer"})
+		return
+	}
+	width, ok := imageWidth(c)
+	if !ok {
+		return
+	}
+	img, err := h.uc.NewsImage(c.Request.Context(), c.Param("id"), index, width)
+	h.serve(c, img, err)
+}
+
+// GetSourceLogo handles GET /api/v1/sources/:slug/logo?w=
+func (h *MediaHandler) GetSourceLogo(c *gin.Context) {
+	width, ok := imageWidth(c)
+	if !ok {
+		return
+	}
+	img, err := h.uc.SourceLogo(c.Request.Context(), c.Param("slug"), width)
+	h.serve(c, img, err)
+}
+
+// imageWidth reads the optional ?w= width in pixels; 0 is the original size.
+func imageWidth(c *gin.Context) (int, bool) {
+	raw := c.Query("w")
+	if raw == "" {
+		return 0, true
+	}
+	w, err := strconv.Atoi(raw)
+	if err != nil || w < 0 || w > 4096 {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "w must be a width between 0 and 4096"})
+		return 0, false
+	}
+	return w, true
+}
+
+func (h *MediaHandler) serve(c *gin.Context, img *contract.ProxiedImage, err error) {
+	switch {
+	case errors.Is(err, contract.ErrNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Image not found"})
+		return
+	case err != nil:
+		c.JSON(http.StatusBadGateway, dto.ErrorResponse{Error: "Image unavailable"})
+		return
+	}
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Header("ETag", img.ETag)
+	if c.GetHeader("If-None-Match") == img.ETag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, img.ContentType, img.Data)
+}
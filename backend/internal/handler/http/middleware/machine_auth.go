@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/gin-gonic/gin"
+)
+
+// maxMachineBody bounds the request bodies read for signature checks.
+const maxMachineBody = 2 << 20
+
+// MachineAuth admits integrations holding an API key with the given scope,
+// sent either as "X-API-Key: <key>" or as an HMAC-SHA256 signature over
+// "<timestamp>.<body>" in X-NewsBrief-Key / X-NewsBrief-Timestamp /
+// X-NewsBrief-Signature. The key's ID and source restriction are stored as
+// "apiKeyID" and "apiKeySourceID", and its AI calls are budgeted per key.
+func MachineAuth(keys contract.IAPIKeyUsecase, scope string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := contract.MachineRequest{
+			APIKey:    ctx.GetHeader("X-API-Key"),
+			KeyPrefix: ctx.GetHeader("X-NewsBrief-Key"),
+			Timestamp: ctx.GetHeader("X-NewsBrief-Timestamp"),
+			Signature: ctx.GetHeader("X-NewsBrief-Signature"),
+			ClientIP:  ctx.ClientIP(),
+		}
+		if req.APIKey == "" && req.Signature != "" {
+			body, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxMachineBody+1))
+			if err != nil {
+				ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Could not read request body"})
+				return
+			}
+			if len(body) > maxMachineBody {
+				ctx.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Request body too large"})
+				return
+			}
+			req.Body = body
+			ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		key, err := keys.Authenticate(ctx.Request.Context(), req, scope)
+		switch {
+		case errors.Is(err, contract.ErrUnauthorized):
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		case errors.Is(err, contract.ErrForbidden):
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		case err != nil:
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Could not verify credentials"})
+			return
+		}
+		ctx.Set("apiKeyID", key.ID)
+		ctx.Set("apiKeySourceID", key.SourceID)
+		actor := contract.UsageActor{ClientIP: req.ClientIP, Job: "api_key:" + key.Prefix}
+		ctx.Request = ctx.Request.WithContext(contract.WithUsageActor(ctx.Request.Context(), actor))
+		ctx.Next()
+	}
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/gin-gonic/gin"
+)
+
+// UsageActor attributes the AI calls of a request to the bearer token's user,
+// or to the client IP when no valid token is sent (forwarded addresses count
+// only from the router's trusted proxies). It never rejects a request;
+// authentication is still enforced by AuthMiddleWare where required.
+func UsageActor(jwtService contract.IJWTService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		actor := contract.UsageActor{ClientIP: ctx.ClientIP()}
+		parts := strings.Split(ctx.GetHeader("Authorization"), " ")
+		if len(parts) == 2 && strings.ToLower(parts[0]) == "bearer" {
+			if claims, err := jwtService.ParseAccessToken(parts[1]); err == nil {
+				actor.UserID = claims.UserID
+			}
+		}
+		ctx.Request = ctx.Request.WithContext(contract.WithUsageActor(ctx.Request.Context(), actor))
+		ctx.Next()
+	}
+}
@@ -0,0 +1,143 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+type NamedEntityHandler struct {
+	uc contract.INamedEntityUsecase
+}
+
+func NewNamedEntityHandler(uc contract.INamedEntityUsecase) *NamedEntityHandler {
+	return &NamedEntityHandler{uc: uc}
+}
+
+// ListEntities handles GET /api/v1/entities?type=&q=&page=&limit=
+func (h *NamedEntityHandler) ListEntities(c *gin.Context) {
+	var entityType entity.NamedEntityType
+	if raw := c.Query("type"); raw != "" {
+		t, ok := entity.ParseNamedEntityType(raw)
+		if !ok {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "type must be one of person, organization, place"})
+			return
+		}
+		entityType = t
+	}
+	page, limit := pageParams(c, 20)
+	list, total, totalPages, err := h.uc.ListEntities(c.Request.Context(), entityType, c.Query("q"), page, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.NamedEntityListResponseDTO{
+		Entities:   dto.MapNamedEntitiesToDTOs(list),
+		Total:      total,
+		TotalPages: totalPages,
+		Page:       page,
+		Limit:      limit,
+	})
+}
+
+// GetEntity handles GET /api/v1/entities/:id
+func (h *NamedEntityHandler) GetEntity(c *gin.Context) {
+	e, err := h.uc.GetEntity(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.respondLookupError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapNamedEntityToDTO(e))
+}
+
+// GetEntityNews handles GET /api/v1/entities/:id/news
+func (h *NamedEntityHandler) GetEntityNews(c *gin.Context) {
+	page, limit := pageParams(c, 10)
+	list, total, totalPages, err := h.uc.ListNews(c.Request.Context(), c.Param("id"), page, limit)
+	if err != nil {
+		h.respondLookupError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.NewsListResponseDTO{
+		News:       dto.MapNewsToDTOs(list),
+		Total:      total,
+		TotalPages: totalPages,
+		Page:       page,
+		Limit:      limit,
+	})
+}
+
+// FollowEntities handles POST /api/v1/me/entities
+func (h *NamedEntityHandler) FollowEntities(c *gin.Context) {
+	userID := c.GetString("userID")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: "Unauthorized"})
+		return
+	}
+	var req dto.FollowEntitiesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+	if err := h.uc.FollowEntities(c.Request.Context(), userID, req.Entities); err != nil {
+		msg := err.Error()
+		switch {
+		case strings.Contains(msg, "entities not found"), strings.Contains(msg, "invalid entity id"):
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: msg})
+		case strings.Contains(msg, "user not found"):
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: msg})
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to follow entities"})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Followed entities successfully"})
+}
+
+// UnfollowEntity handles DELETE /api/v1/me/entities/:entityID
+func (h *NamedEntityHandler) UnfollowEntity(c *gin.Context) {
+	userID := c.GetString("userID")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: "Unauthorized"})
+		return
+	}
+	if err := h.uc.UnfollowEntity(c.Request.Context(), userID, c.Param("entityID")); err != nil {
+		msg := err.Error()
+		switch {
+		case strings.Contains(msg, "entity not found"), strings.Contains(msg, "user not found"):
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: msg})
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to unfollow entity"})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Unfollowed entity successfully"})
+}
+
+// GetFollowedEntities handles GET /api/v1/me/entities
+func (h *NamedEntityHandler) GetFollowedEntities(c *gin.Context) {
+	userID := c.GetString("userID")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: "Unauthorized"})
+		return
+	}
+	list, err := h.uc.GetFollowedEntities(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to retrieve followed entities"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"entities": dto.MapNamedEntitiesToDTOs(list)})
+}
+
+func (h *NamedEntityHandler) respondLookupError(c *gin.Context, err error) {
+	if errors.Is(err, contract.ErrNotFound) {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "entity not found"})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+}
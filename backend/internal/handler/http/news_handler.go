@@ -1,8 +1,10 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
 	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
@@ -10,11 +12,18 @@ import (
 )
 
 type NewsHandler struct {
-	uc contract.INewsUsecase
+	uc      contract.INewsUsecase
+	stories *StoryHandler
 }
 
-func NewNewsHandler(uc contract.INewsUsecase) *NewsHandler {
-	return &NewsHandler{uc: uc}
+func NewNewsHandler(uc contract.INewsUsecase, stories *StoryHandler) *NewsHandler {
+	return &NewsHandler{uc: uc, stories: stories}
+}
+
+// wantsStories reports whether the client asked for story clusters (?view=stories)
+// instead of individual articles.
+func (h *NewsHandler) wantsStories(c *gin.Context) bool {
+	return h.stories != nil && c.Query("view") == "stories"
 }
 
 // GetNews handles GET /api/v1/news?Page=&limit=
@@ -132,8 +141,13 @@ func (h *NewsHandler) GetNewsByTopic(c *gin.Context) {
 // GetTodayNews handles GET /api/v1/news/today (returns exactly 4 items)
 func (h *NewsHandler) GetTodayNews(c *gin.Context) {
 	limit := 4
+	if h.wantsStories(c) {
+		now := time.Now()
+		h.stories.respondTrending(c, time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()), 1, limit)
+		return
+	}
 
-	list, total, _, err := h.uc.ListToday(limit)
+	list, total, totalPages, err := h.uc.ListToday(c.Request.Context(), limit)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -142,7 +156,7 @@ func (h *NewsHandler) GetTodayNews(c *gin.Context) {
 	resp := dto.NewsListResponseDTO{
 		News:       dto.MapNewsToDTOs(list),
 		Total:      total,
-		TotalPages: 1,
+		TotalPages: totalPages,
 		Page:       1,
 		Limit:      limit,
 	}
@@ -166,7 +180,12 @@ func (h *NewsHandler) GetTrendingNews(c *gin.Context) {
 		}
 	}
 
-	list, total, totalPages, err := h.uc.ListTrending(page, limit)
+	if h.wantsStories(c) {
+		h.stories.respondTrending(c, time.Time{}, page, limit)
+		return
+	}
+
+	list, total, totalPages, err := h.uc.ListTrending(c.Request.Context(), page, limit)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -182,6 +201,38 @@ func (h *NewsHandler) GetTrendingNews(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// GetRelatedNews handles GET /api/v1/news/:id/related?limit=&days=
+func (h *NewsHandler) GetRelatedNews(c *gin.Context) {
+	newsID := c.Param("id")
+	limit := 5
+	days := 7
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 && l <= 20 {
+		limit = l
+	}
+	if d, err := strconv.Atoi(c.Query("days")); err == nil && d > 0 && d <= 90 {
+		days = d
+	}
+
+	list, err := h.uc.ListRelated(c.Request.Context(), newsID, limit, time.Duration(days)*24*time.Hour)
+	if err != nil {
+		if errors.Is(err, contract.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "news not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp := dto.NewsListResponseDTO{
+		News:       dto.MapNewsToDTOs(list),
+		Total:      int64(len(list)),
+		TotalPages: 1,
+		Page:       1,
+		Limit:      limit,
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
 // Admin create news
 func (h *NewsHandler) AdminCreateNews(c *gin.Context) {
 	var req dto.AdminCreateNews
@@ -0,0 +1,90 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// PromptHandler lets admins version, A/B test and roll back model prompts.
+type PromptHandler struct {
+	uc contract.IPromptUsecase
+}
+
+func NewPromptHandler(uc contract.IPromptUsecase) *PromptHandler {
+	return &PromptHandler{uc: uc}
+}
+
+// ListPrompts handles GET /api/v1/admin/prompts?name=
+func (h *PromptHandler) ListPrompts(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	list, err := h.uc.List(c.Request.Context(), c.Query("name"))
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"prompts": dto.MapPromptsToDTOs(list)})
+}
+
+// CreatePrompt handles POST /api/v1/admin/prompts
+func (h *PromptHandler) CreatePrompt(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	var req dto.CreatePromptRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+	t, err := h.uc.CreateVersion(c.Request.Context(), entity.PromptTemplate{
+		Name:      req.Name,
+		Language:  req.Language,
+		Text:      req.Text,
+		Note:      req.Note,
+		Weight:    req.Weight,
+		CreatedBy: c.GetString("userID"),
+	}, req.Active)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapPromptToDTO(t))
+}
+
+// SetPromptActive handles PUT /api/v1/admin/prompts/:id/active
+func (h *PromptHandler) SetPromptActive(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	var req dto.SetPromptActiveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+	t, err := h.uc.SetActive(c.Request.Context(), c.Param("id"), *req.Active, req.Weight)
+	if err != nil {
+		h.respondError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapPromptToDTO(t))
+}
+
+func (h *PromptHandler) respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, contract.ErrNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "prompt version not found"})
+	case errors.Is(err, contract.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+	}
+}
@@ -0,0 +1,52 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// ProviderSyncHandler exposes the provider sync cursor and backfills to admins.
+// Incremental syncs run as the "provider_ingestion" scheduled job.
+type ProviderSyncHandler struct {
+	uc contract.IProviderSyncUsecase
+}
+
+func NewProviderSyncHandler(uc contract.IProviderSyncUsecase) *ProviderSyncHandler {
+	return &ProviderSyncHandler{uc: uc}
+}
+
+// GetState handles GET /api/v1/admin/ingest/provider/sync
+func (h *ProviderSyncHandler) GetState(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	state, err := h.uc.State(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, state)
+}
+
+// StartBackfill handles POST /api/v1/admin/ingest/provider/backfill
+func (h *ProviderSyncHandler) StartBackfill(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	var req dto.ProviderBackfillRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload: from and to must be RFC 3339 times"})
+		return
+	}
+	b, err := h.uc.StartBackfill(c.Request.Context(), req.From, req.To, c.GetString("userID"))
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, b)
+}
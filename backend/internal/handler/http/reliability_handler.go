@@ -0,0 +1,116 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// ReliabilityHandler lets readers report articles and admins inspect,
+// recompute and override source reliability scores.
+type ReliabilityHandler struct {
+	uc contract.IReliabilityUsecase
+}
+
+func NewReliabilityHandler(uc contract.IReliabilityUsecase) *ReliabilityHandler {
+	return &ReliabilityHandler{uc: uc}
+}
+
+// ReportNews handles POST /api/v1/me/reports
+func (h *ReliabilityHandler) ReportNews(c *gin.Context) {
+	userID := c.GetString("userID")
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	var req dto.ReportNewsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+	report, err := h.uc.Report(c.Request.Context(), userID, req.NewsID, entity.ReportReason(req.Reason), req.Note)
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.MapNewsReportToDTO(report))
+}
+
+// List handles GET /api/v1/admin/reliability
+func (h *ReliabilityHandler) List(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	sources, err := h.uc.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.SourceReliabilityListDTO{Sources: dto.MapSourceReliabilityToDTOs(sources), Total: len(sources)})
+}
+
+// RecomputeAll handles POST /api/v1/admin/reliability/recompute
+func (h *ReliabilityHandler) RecomputeAll(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	res, err := h.uc.RecomputeAll(withJob(c, "source_reliability"))
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// Recompute handles POST /api/v1/admin/sources/:slug/reliability/recompute
+func (h *ReliabilityHandler) Recompute(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	src, err := h.uc.Recompute(withJob(c, "source_reliability"), c.Param("slug"))
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapSourceReliabilityToDTO(*src))
+}
+
+// SetOverride handles PUT /api/v1/admin/sources/:slug/reliability/override
+func (h *ReliabilityHandler) SetOverride(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	var req dto.SetReliabilityOverrideRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+	src, err := h.uc.SetOverride(c.Request.Context(), c.Param("slug"), req.Score, req.Note, c.GetString("userID"))
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapSourceReliabilityToDTO(*src))
+}
+
+// ClearOverride handles DELETE /api/v1/admin/sources/:slug/reliability/override,
+// returning the source to its computed score.
+func (h *ReliabilityHandler) ClearOverride(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	src, err := h.uc.SetOverride(c.Request.Context(), c.Param("slug"), nil, "", c.GetString("userID"))
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.MapSourceReliabilityToDTO(*src))
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// ReprocessHandler lets admins re-run pipeline stages over stored articles
+// and follow, pause, resume or cancel the resulting jobs.
+type ReprocessHandler struct {
+	uc contract.IReprocessUsecase
+}
+
+func NewReprocessHandler(uc contract.IReprocessUsecase) *ReprocessHandler {
+	return &ReprocessHandler{uc: uc}
+}
+
+// Start handles POST /api/v1/admin/reprocess
+func (h *ReprocessHandler) Start(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	var req dto.ReprocessRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload: stages are required and from/to must be RFC 3339 times"})
+		return
+	}
+	job, err := h.uc.Start(c.Request.Context(), contract.ReprocessRequest{
+		Filter: entity.ReprocessFilter{
+			From:     req.From,
+			To:       req.To,
+			Source:   req.Source,
+			Topic:    req.Topic,
+			Language: req.Language,
+			Missing:  req.Missing,
+		},
+		Stages:     req.Stages,
+		RatePerMin: req.RatePerMin,
+	}, c.GetString("userID"))
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, job)
+}
+
+// List handles GET /api/v1/admin/reprocess?status=&limit=
+func (h *ReprocessHandler) List(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	jobs, err := h.uc.List(c.Request.Context(), c.Query("status"), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ReprocessJobListDTO{Jobs: jobs, Total: len(jobs)})
+}
+
+// Get handles GET /api/v1/admin/reprocess/:id
+func (h *ReprocessHandler) Get(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	job, err := h.uc.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, job)
+}
+
+// Pause handles POST /api/v1/admin/reprocess/:id/pause
+func (h *ReprocessHandler) Pause(c *gin.Context) {
+	h.control(c, h.uc.Pause)
+}
+
+// Resume handles POST /api/v1/admin/reprocess/:id/resume
+func (h *ReprocessHandler) Resume(c *gin.Context) {
+	h.control(c, h.uc.Resume)
+}
+
+// Cancel handles POST /api/v1/admin/reprocess/:id/cancel
+func (h *ReprocessHandler) Cancel(c *gin.Context) {
+	h.control(c, h.uc.Cancel)
+}
+
+func (h *ReprocessHandler) control(c *gin.Context, action func(ctx context.Context, id string) (*entity.ReprocessJob, error)) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	job, err := action(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, job)
+}
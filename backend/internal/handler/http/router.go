@@ -4,9 +4,8 @@ import (
 	"time"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
 	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/middleware"
-
-	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/external_services"
 	"github.com/RealEskalate/G6-NewsBrief/internal/usecase"
 
 	"github.com/didip/tollbooth/v7"
@@ -31,24 +30,37 @@ type Router struct {
 	newsHandler         *NewsHandler
 	bookmarkHandler     *BookmarkHandler
 	analyticHandler     *AnalyticHandler
+	embeddingHandler    *EmbeddingHandler
+	storyHandler        *StoryHandler
+	namedEntityHandler  *NamedEntityHandler
+	editorialHandler    *EditorialHandler
+	usageHandler        *UsageHandler
+	promptHandler       *PromptHandler
+	safetyHandler       *SafetyHandler
+	feedHandler         *FeedHandler
+	apiKeyHandler       *APIKeyHandler
+	apiKeyUC            contract.IAPIKeyUsecase
+	jobHandler          *JobHandler
+	providerSyncHandler *ProviderSyncHandler
+	mediaHandler        *MediaHandler
+	reliabilityHandler  *ReliabilityHandler
+	reprocessHandler    *ReprocessHandler
 }
 
-func NewRouter(userUsecase contract.IUserUseCase, emailVerUC contract.IEmailVerificationUC, userRepo contract.IUserRepository, tokenRepo contract.ITokenRepository, analyticRepo contract.IAnalyticRepository, topicRepo contract.ITopicRepository, hasher contract.IHasher, jwtService contract.IJWTService, mailService contract.IEmailService, logger contract.IAppLogger, config contract.IConfigProvider, validator contract.IValidator, uuidGen contract.IUUIDGenerator, randomGen contract.IRandomGenerator, sourceUC contract.ISourceUsecase, topicUC contract.ITopicUsecase, subscriptionUC contract.ISubscriptionUsecase, sourceRepo contract.ISourceRepository, newsRepo contract.INewsRepository, bookmarkRepo contract.IBookmarkRepository, geminiClient contract.IGeminiClient) *Router {
+func NewRouter(userUsecase contract.IUserUseCase, emailVerUC contract.IEmailVerificationUC, userRepo contract.IUserRepository, tokenRepo contract.ITokenRepository, analyticRepo contract.IAnalyticRepository, topicRepo contract.ITopicRepository, hasher contract.IHasher, jwtService contract.IJWTService, mailService contract.IEmailService, logger contract.IAppLogger, config contract.IConfigProvider, validator contract.IValidator, uuidGen contract.IUUIDGenerator, randomGen contract.IRandomGenerator, sourceUC contract.ISourceUsecase, topicUC contract.ITopicUsecase, subscriptionUC contract.ISubscriptionUsecase, sourceRepo contract.ISourceRepository, newsRepo contract.INewsRepository, bookmarkRepo contract.IBookmarkRepository, summaryRepo contract.ISummaryRepository, geminiClient contract.IGeminiClient, translatorClient contract.ITranslationClient, translationPipeline contract.ITranslationPipeline, embeddingUC contract.IEmbeddingService, storyUC contract.IStoryUsecase, namedEntityUC contract.INamedEntityUsecase, editorialUC contract.IEditorialUsecase, usageUC contract.IUsageUsecase, promptUC contract.IPromptUsecase, safetyUC contract.ISafetyUsecase, feedUC contract.IFeedIngestionUsecase, apiKeyUC contract.IAPIKeyUsecase, providerIngestionUC contract.IProviderIngestionUsecase, scheduler contract.IScheduler, providerSyncUC contract.IProviderSyncUsecase, mediaUC contract.IMediaUsecase, reliabilityUC contract.IReliabilityUsecase, reprocessUC contract.IReprocessUsecase) *Router {
 
 	baseURL := config.GetAppBaseURL()
-	summarizerUC := usecase.NewsSummarizerUsecase(geminiClient, newsRepo)
-	ingestionUC := usecase.NewNewsIngestionUsecase(geminiClient, newsRepo, uuidGen)
-	providerClient := external_services.NewNewsProviderClient()
-	translatorClient := external_services.NewTranslatorClient()
-	providerIngestionUC := usecase.NewProviderIngestionUsecase(providerClient, geminiClient, translatorClient, topicRepo, newsRepo, uuidGen, sourceRepo)
-	chatbotUC := usecase.NewChatbotUsecase(geminiClient, translatorClient, newsRepo)
+	summarizerUC := usecase.NewsSummarizerUsecase(geminiClient, newsRepo, summaryRepo, uuidGen, promptUC)
+	ingestionUC := usecase.NewNewsIngestionUsecase(geminiClient, newsRepo, uuidGen, embeddingUC, storyUC, namedEntityUC, promptUC)
+	chatbotUC := usecase.NewChatbotUsecase(geminiClient, translatorClient, newsRepo, embeddingUC, promptUC, safetyUC, bookmarkRepo, sourceRepo, storyUC, topicRepo)
 	translatorUC := usecase.NewsTranslatorUsecase(translatorClient, newsRepo)
 	// news usecase needs userRepo and sourceRepo for For-You feed
 	// sourceRepo isn't passed here; build it inside main and expose via usecases. Since router only gets sourceUC, we cannot access repo from here.
 	// Instead, pass sourceRepo to router.NewRouter from main by adding it to params in future if needed.
 	// For now, assume we can obtain it from sourceUC via GetAll + map by slug when necessary, but ListForYou resolves via sourceRepo directly injected in main.
-	newsUC := usecase.NewNewsUsecase(newsRepo, userRepo, sourceRepo, analyticRepo, uuidGen, summarizerUC, translatorClient)
+	newsUC := usecase.NewNewsUsecase(newsRepo, userRepo, sourceRepo, analyticRepo, uuidGen, summarizerUC, translationPipeline, embeddingUC)
 	bookmarkUC := usecase.NewBookmarkUsecase(bookmarkRepo, newsRepo, uuidGen)
+	storyHandler := NewStoryHandler(storyUC)
 	return &Router{
 		userHandler:         NewUserHandler(userUsecase),
 		emailHandler:        NewEmailHandler(emailVerUC, userRepo, jwtService, tokenRepo, hasher, config, uuidGen),
@@ -60,11 +72,26 @@ func NewRouter(userUsecase contract.IUserUseCase, emailVerUC contract.IEmailVeri
 		topicHandler:        NewTopicHandler(topicUC, userUsecase, uuidGen),
 		sourceHandler:       NewSourceHandler(sourceUC, uuidGen),
 		subscriptionHandler: NewSubscriptionHandler(subscriptionUC),
-		newsHandler:         NewNewsHandler(newsUC),
+		newsHandler:         NewNewsHandler(newsUC, storyHandler),
 		bookmarkHandler:     NewBookmarkHandler(bookmarkUC),
 		jwtService:          jwtService,
 		userUsecase:         userUsecase,
 		analyticHandler:     NewAnalyticHandler(analyticRepo),
+		embeddingHandler:    NewEmbeddingHandler(scheduler),
+		storyHandler:        storyHandler,
+		namedEntityHandler:  NewNamedEntityHandler(namedEntityUC),
+		editorialHandler:    NewEditorialHandler(editorialUC),
+		usageHandler:        NewUsageHandler(usageUC),
+		promptHandler:       NewPromptHandler(promptUC),
+		safetyHandler:       NewSafetyHandler(safetyUC),
+		feedHandler:         NewFeedHandler(feedUC),
+		apiKeyHandler:       NewAPIKeyHandler(apiKeyUC),
+		apiKeyUC:            apiKeyUC,
+		jobHandler:          NewJobHandler(scheduler),
+		providerSyncHandler: NewProviderSyncHandler(providerSyncUC),
+		mediaHandler:        NewMediaHandler(mediaUC),
+		reliabilityHandler:  NewReliabilityHandler(reliabilityUC),
+		reprocessHandler:    NewReprocessHandler(reprocessUC),
 	}
 }
 
@@ -97,6 +124,9 @@ func (r *Router) SetupRoutes(router *gin.Engine) {
 	// Attach device detector for downstream handlers
 	router.Use(middleware.DeviceDetector())
 
+	// Attribute AI calls to the signed-in user or the client IP for budgets
+	router.Use(middleware.UsageActor(r.jwtService))
+
 	// router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 	// router.GET("/api/v1/metrics", gin.WrapH(promhttp.Handler()))
 
@@ -132,6 +162,53 @@ func (r *Router) SetupRoutes(router *gin.Engine) {
 		// admin.DELETE("/topics/:id", r.topicHandler.DeleteTopic)
 		admin.POST("/create-sources", r.sourceHandler.CreateSource)
 		admin.POST("/ingest/scraper", r.ingestionHandler.IngestFromProvider)
+		// dry-run ingestions awaiting approval
+		admin.GET("/ingest/previews/:id", r.ingestionHandler.GetPreview)
+		admin.POST("/ingest/previews/:id/approve", r.ingestionHandler.ApprovePreview)
+		admin.DELETE("/ingest/previews/:id", r.ingestionHandler.DiscardPreview)
+		admin.GET("/ingest/provider/sync", r.providerSyncHandler.GetState)
+		admin.POST("/ingest/provider/backfill", r.providerSyncHandler.StartBackfill)
+		// native RSS/Atom/JSON feeds configured per source
+		admin.GET("/feeds", r.feedHandler.ListFeeds)
+		admin.PUT("/sources/:slug/feeds", r.feedHandler.SetFeeds)
+		admin.POST("/sources/:slug/poll", r.feedHandler.PollSource)
+		// source reliability scores, their breakdown and editorial overrides
+		admin.GET("/reliability", r.reliabilityHandler.List)
+		admin.POST("/reliability/recompute", r.reliabilityHandler.RecomputeAll)
+		admin.POST("/sources/:slug/reliability/recompute", r.reliabilityHandler.Recompute)
+		admin.PUT("/sources/:slug/reliability/override", r.reliabilityHandler.SetOverride)
+		admin.DELETE("/sources/:slug/reliability/override", r.reliabilityHandler.ClearOverride)
+		// machine credentials for integrations pushing articles
+		admin.GET("/api-keys", r.apiKeyHandler.ListKeys)
+		admin.POST("/api-keys", r.apiKeyHandler.IssueKey)
+		admin.POST("/api-keys/:id/rotate", r.apiKeyHandler.RotateKey)
+		admin.DELETE("/api-keys/:id", r.apiKeyHandler.RevokeKey)
+		// scheduled background jobs
+		admin.GET("/jobs", r.jobHandler.ListJobs)
+		admin.POST("/jobs/:name/pause", r.jobHandler.PauseJob)
+		admin.POST("/jobs/:name/resume", r.jobHandler.ResumeJob)
+		admin.POST("/jobs/:name/run", r.jobHandler.RunJob)
+		admin.POST("/embeddings/backfill", r.embeddingHandler.BackfillEmbeddings)
+		// re-run summarize/classify/translate/localize/embed over stored articles
+		admin.POST("/reprocess", r.reprocessHandler.Start)
+		admin.GET("/reprocess", r.reprocessHandler.List)
+		admin.GET("/reprocess/:id", r.reprocessHandler.Get)
+		admin.POST("/reprocess/:id/pause", r.reprocessHandler.Pause)
+		admin.POST("/reprocess/:id/resume", r.reprocessHandler.Resume)
+		admin.POST("/reprocess/:id/cancel", r.reprocessHandler.Cancel)
+		admin.PUT("/users/:id/role", r.userHandler.SetUserRole)
+		admin.GET("/llm-usage", r.usageHandler.GetReport)
+		// versioned prompt templates
+		admin.GET("/prompts", r.promptHandler.ListPrompts)
+		admin.POST("/prompts", r.promptHandler.CreatePrompt)
+		admin.PUT("/prompts/:id/active", r.promptHandler.SetPromptActive)
+		admin.GET("/safety-events", r.safetyHandler.ListEvents)
+		// editorial post-editing (admins and editors)
+		admin.GET("/editorial/news/:id", r.editorialHandler.GetArticle)
+		admin.PUT("/editorial/news/:id/fields/:field", r.editorialHandler.CorrectField)
+		admin.GET("/editorial/news/:id/revisions", r.editorialHandler.ListRevisions)
+		admin.GET("/editorial/memory", r.editorialHandler.ListMemory)
+		admin.POST("/editorial/memory", r.editorialHandler.AddMemoryEntry)
 		// admin.PUT("/sources/:id", r.sourceHandler.UpdateSource)
 		// admin.DELETE("/sources/:id", r.sourceHandler.DeleteSource)
 	}
@@ -150,12 +227,17 @@ func (r *Router) SetupRoutes(router *gin.Engine) {
 		userProfile.POST("/topics", r.topicHandler.SubscribeTopic)
 		userProfile.DELETE("/topics/:topicID", r.topicHandler.UnsubscribeTopic)
 		userProfile.GET("/subscribed-topics", r.topicHandler.GetUserSubscribedTopics)
+		userProfile.GET("/entities", r.namedEntityHandler.GetFollowedEntities)
+		userProfile.POST("/entities", r.namedEntityHandler.FollowEntities)
+		userProfile.DELETE("/entities/:entityID", r.namedEntityHandler.UnfollowEntity)
 		// personalized feed (For You)
 		userProfile.GET("/for-you", r.newsHandler.GetForYou)
 		// bookmarks
 		userProfile.POST("/bookmarks", r.bookmarkHandler.Save)
 		userProfile.DELETE("/bookmarks/:news_id", r.bookmarkHandler.Unsave)
 		userProfile.GET("/bookmarks", r.bookmarkHandler.List)
+		// report a misleading or clickbait article; reports feed source reliability
+		userProfile.POST("/reports", r.reliabilityHandler.ReportNews)
 	}
 	// public api
 	public := v1.Group("")
@@ -164,9 +246,19 @@ func (r *Router) SetupRoutes(router *gin.Engine) {
 		public.GET("/news", r.newsHandler.GetNews)
 		public.GET("/news/today", r.newsHandler.GetTodayNews)
 		public.GET("/news/trending", r.newsHandler.GetTrendingNews)
+		public.GET("/news/:id/summary", r.summarizerHandler.GetSummary)
+		public.GET("/news/:id/related", r.newsHandler.GetRelatedNews)
+		// Article images and source logos through the resizing image proxy
+		public.GET("/news/:id/images/:index", r.mediaHandler.GetNewsImage)
 		public.GET("/topics/:topicID/news", r.newsHandler.GetNewsByTopic)
+		public.GET("/stories", r.storyHandler.ListStories)
+		public.GET("/stories/:id", r.storyHandler.GetStory)
+		public.GET("/entities", r.namedEntityHandler.ListEntities)
+		public.GET("/entities/:id", r.namedEntityHandler.GetEntity)
+		public.GET("/entities/:id/news", r.namedEntityHandler.GetEntityNews)
 		public.GET("/topics", r.topicHandler.GetTopics)
 		public.GET("/sources", r.sourceHandler.GetSources)
+		public.GET("/sources/:slug/logo", r.mediaHandler.GetSourceLogo)
 	}
 	// Logout route (no authentication required just accept the refresh token from the request body and invalidate the user session)
 	v1.POST("/logout", r.userHandler.Logout)
@@ -176,10 +268,14 @@ func (r *Router) SetupRoutes(router *gin.Engine) {
 	{
 		// Utilities
 		ai.POST("/summarize", r.summarizerHandler.Summarize)
-		ai.POST("/news/ingest", r.ingestionHandler.IngestNews)
+		// integrations push articles with an API key or a signed request
+		ai.POST("/news/ingest", middleware.MachineAuth(r.apiKeyUC, entity.ScopeNewsIngest), r.ingestionHandler.IngestNews)
 		// Chat endpoints
 		ai.POST("/chat/general", r.chatHandler.ChatGeneral)
 		ai.POST("/chat/news/:id", r.chatHandler.ChatForNews)
+		ai.GET("/news/:id/suggested-questions", r.chatHandler.GetSuggestedQuestions)
+		ai.POST("/chat/articles", r.chatHandler.ChatArticles)
+		ai.POST("/chat/compare", r.chatHandler.CompareCoverage)
 		// Translation endpoints
 		ai.POST("/translate", r.translatorHandler.Translate)
 		ai.POST("/news/:id/translate", r.translatorHandler.TranslateNews)
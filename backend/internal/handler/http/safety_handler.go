@@ -0,0 +1,47 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// SafetyHandler shows admins the requests the safety guard blocked or redacted.
+type SafetyHandler struct {
+	uc contract.ISafetyUsecase
+}
+
+func NewSafetyHandler(uc contract.ISafetyUsecase) *SafetyHandler {
+	return &SafetyHandler{uc: uc}
+}
+
+// ListEvents handles GET /api/v1/admin/safety-events?stage=input|content|output&page=&limit=
+func (h *SafetyHandler) ListEvents(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	stage := entity.SafetyStage(c.Query("stage"))
+	switch stage {
+	case "", entity.SafetyInput, entity.SafetyContent, entity.SafetyOutput:
+	default:
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "stage must be input, content or output"})
+		return
+	}
+	page, limit := pageParams(c, 20)
+	list, total, totalPages, err := h.uc.ListEvents(c.Request.Context(), stage, page, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.SafetyEventListResponseDTO{
+		Events:     dto.MapSafetyEventsToDTOs(list),
+		Total:      total,
+		TotalPages: totalPages,
+		Page:       page,
+		Limit:      limit,
+	})
+}
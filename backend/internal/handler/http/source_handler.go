@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
@@ -43,17 +44,23 @@ func (h *SourceHandler) CreateSource(c *gin.Context) {
 	}
 
 	source := entity.Source{
-		ID:               h.uuidGen.NewUUID(),
-		Slug:             sourceDTO.Slug,
-		Name:             sourceDTO.Name,
-		Description:      sourceDTO.Description,
-		URL:              sourceDTO.URL,
-		LogoURL:          sourceDTO.LogoURL,
-		Languages:        entity.SetLanguageType(sourceDTO.Languages),
-		ReliabilityScore: sourceDTO.ReliabilityScore,
+		ID:                  h.uuidGen.NewUUID(),
+		Slug:                sourceDTO.Slug,
+		Name:                sourceDTO.Name,
+		Description:         sourceDTO.Description,
+		URL:                 sourceDTO.URL,
+		LogoURL:             sourceDTO.LogoURL,
+		Languages:           entity.SetLanguageType(sourceDTO.Languages),
+		ReliabilityScore:    sourceDTO.ReliabilityScore,
+		FeedURLs:            sourceDTO.FeedURLs,
+		PollIntervalMinutes: sourceDTO.PollIntervalMinutes,
 	}
 
 	if err := h.sourceUsecase.CreateSource(c.Request.Context(), &source); err != nil {
+		if errors.Is(err, contract.ErrInvalidInput) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to create source"})
 		return
 	}
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+type StoryHandler struct {
+	uc contract.IStoryUsecase
+}
+
+func NewStoryHandler(uc contract.IStoryUsecase) *StoryHandler {
+	return &StoryHandler{uc: uc}
+}
+
+// ListStories handles GET /api/v1/stories?page=&limit=
+func (h *StoryHandler) ListStories(c *gin.Context) {
+	page, limit := pageParams(c, 10)
+	h.respondList(c, time.Time{}, page, limit)
+}
+
+// GetStory handles GET /api/v1/stories/:id
+func (h *StoryHandler) GetStory(c *gin.Context) {
+	story, articles, err := h.uc.GetStory(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if errors.Is(err, contract.ErrNotFound) {
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "story not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.StoryDetailResponseDTO{
+		Story:    dto.MapStoryToDTO(story),
+		Articles: dto.MapNewsToDTOs(articles),
+	})
+}
+
+// respondList writes a page of stories active since the given time, newest first.
+func (h *StoryHandler) respondList(c *gin.Context, since time.Time, page, limit int) {
+	list, total, totalPages, err := h.uc.ListStories(c.Request.Context(), since, page, limit)
+	writeStories(c, list, total, totalPages, page, limit, err)
+}
+
+// respondTrending writes a page of stories active since the given time (zero
+// = the trending window), those covered by the most sources first.
+func (h *StoryHandler) respondTrending(c *gin.Context, since time.Time, page, limit int) {
+	list, total, totalPages, err := h.uc.TrendingStories(c.Request.Context(), since, page, limit)
+	writeStories(c, list, total, totalPages, page, limit, err)
+}
+
+func writeStories(c *gin.Context, list []*entity.Story, total int64, totalPages, page, limit int, err error) {
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.StoryListResponseDTO{
+		Stories:    dto.MapStoriesToDTOs(list),
+		Total:      total,
+		TotalPages: totalPages,
+		Page:       page,
+		Limit:      limit,
+	})
+}
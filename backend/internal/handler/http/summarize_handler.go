@@ -1,9 +1,11 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
 	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
 	"github.com/gin-gonic/gin"
 )
@@ -25,9 +27,16 @@ func (sh *SummarizeHandler) Summarize(c *gin.Context) {
 		return
 	}
 
-	summary, err := sh.summarizerUC.Summarize(req.NewsID)
+	// A requested variant is served from the summaries cache
+	if req.Type != "" {
+		summaryType, _ := entity.ParseSummaryType(req.Type)
+		sh.respondVariant(c, req.NewsID, summaryType, req.Language)
+		return
+	}
+
+	summary, err := sh.summarizerUC.Summarize(c.Request.Context(), req.NewsID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		respondAIError(c, err)
 		return
 	}
 
@@ -38,3 +47,40 @@ func (sh *SummarizeHandler) Summarize(c *gin.Context) {
 	})
 
 }
+
+// GetSummary handles GET /api/v1/news/:id/summary?type=&lang=
+func (sh *SummarizeHandler) GetSummary(c *gin.Context) {
+	summaryType, ok := entity.ParseSummaryType(c.DefaultQuery("type", string(entity.SummaryShort)))
+	if !ok {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "type must be one of headline, short, bullets, detailed"})
+		return
+	}
+	lang := c.Query("lang")
+	if lang != "" && lang != "en" && lang != "am" {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "lang must be en or am"})
+		return
+	}
+	sh.respondVariant(c, c.Param("id"), summaryType, lang)
+}
+
+func (sh *SummarizeHandler) respondVariant(c *gin.Context, newsID string, summaryType entity.SummaryType, lang string) {
+	summary, err := sh.summarizerUC.GetSummary(c.Request.Context(), newsID, summaryType, lang)
+	if err != nil {
+		if errors.Is(err, contract.ErrNotFound) {
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "news not found"})
+			return
+		}
+		respondAIError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.SummaryVariantResponse{
+		NewsID:        summary.NewsID,
+		Type:          string(summary.Type),
+		Language:      summary.Language,
+		Content:       summary.Content,
+		Bullets:       summary.Bullets,
+		Model:         summary.Model,
+		PromptVersion: summary.PromptVersion,
+		CreatedAt:     summary.CreatedAt,
+	})
+}
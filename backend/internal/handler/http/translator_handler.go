@@ -25,9 +25,9 @@ func (h *TranslatorHandler) Translate(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
 		return
 	}
-	translated, err := h.translatorUC.Translate(req.Text, req.SourceLang, req.TargetLang)
+	translated, err := h.translatorUC.Translate(c.Request.Context(), req.Text, req.SourceLang, req.TargetLang)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		respondAIError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, dto.TranslateResponse{TranslatedText: translated})
@@ -41,9 +41,9 @@ func (h *TranslatorHandler) TranslateNews(c *gin.Context) {
 		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "news not found"})
 		return
 	}
-	updated, err := h.translatorUC.TranslateNews(*news)
+	updated, err := h.translatorUC.TranslateNews(c.Request.Context(), *news)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		respondAIError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, entity.News{
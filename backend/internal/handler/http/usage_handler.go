@@ -0,0 +1,53 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// UsageHandler serves the AI usage report to admins.
+type UsageHandler struct {
+	uc contract.IUsageUsecase
+}
+
+func NewUsageHandler(uc contract.IUsageUsecase) *UsageHandler {
+	return &UsageHandler{uc: uc}
+}
+
+// GetReport handles GET /api/v1/admin/llm-usage?from=YYYY-MM-DD&to=YYYY-MM-DD
+// (defaults to the last 7 days).
+func (h *UsageHandler) GetReport(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	to := time.Now().UTC()
+	from := to.AddDate(0, 0, -6)
+	if v := c.Query("from"); v != "" {
+		t, err := time.Parse(entity.UsageDayLayout, v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "from must be YYYY-MM-DD"})
+			return
+		}
+		from = t
+	}
+	if v := c.Query("to"); v != "" {
+		t, err := time.Parse(entity.UsageDayLayout, v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "to must be YYYY-MM-DD"})
+			return
+		}
+		to = t
+	}
+	report, err := h.uc.Report(c.Request.Context(), from, to)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
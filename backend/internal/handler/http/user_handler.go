@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
 	"github.com/RealEskalate/G6-NewsBrief/internal/handler/http/dto"
 	"github.com/gin-gonic/gin"
 )
@@ -267,3 +268,29 @@ func (h *UserHandler) UpdatePreferences(c *gin.Context) {
 	}
 	SuccessHandler(c, http.StatusOK, response)
 }
+
+// SetUserRole handles PUT /api/v1/admin/users/:id/role (admins only).
+func (h *UserHandler) SetUserRole(c *gin.Context) {
+	if !hasRole(c, "admin") {
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "Forbidden: Admins only"})
+		return
+	}
+	var req dto.SetUserRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request payload"})
+		return
+	}
+	user, err := h.userUsecase.SetRole(c.Request.Context(), c.Param("id"), entity.UserRole(req.Role))
+	if err != nil {
+		switch err.Error() {
+		case "invalid role":
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		case "user not found":
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToUserResponse(*user))
+}
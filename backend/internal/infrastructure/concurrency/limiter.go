@@ -0,0 +1,47 @@
+// Package concurrency bounds the number of calls in flight to an upstream.
+package concurrency
+
+import "context"
+
+// Limiter is a counting semaphore whose waits give up with the context.
+// A nil Limiter admits every call.
+type Limiter struct {
+	slots chan struct{}
+}
+
+// NewLimiter admits at most n concurrent holders; n <= 0 returns nil (unlimited).
+func NewLimiter(n int) *Limiter {
+	if n <= 0 {
+		return nil
+	}
+	return &Limiter{slots: make(chan struct{}, n)}
+}
+
+// Acquire waits for a free slot or returns ctx.Err().
+func (l *Limiter) Acquire(ctx context.Context) error {
+	if l == nil {
+		return ctx.Err()
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a slot taken by Acquire.
+func (l *Limiter) Release() {
+	if l == nil {
+		return
+	}
+	<-l.slots
+}
+
+// Limit returns the configured bound, 0 when unlimited.
+func (l *Limiter) Limit() int {
+	if l == nil {
+		return 0
+	}
+	return cap(l.slots)
+}
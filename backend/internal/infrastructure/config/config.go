@@ -17,6 +17,7 @@ type Config struct {
 	RefreshTokenExpiry           time.Duration
 	PasswordResetTokenExpiry     time.Duration
 	EmailVerificationTokenExpiry time.Duration
+	SchedulerTimezone            string
 }
 
 // NewConfig creates a new Config instance, loading values from environment variables.
@@ -29,6 +30,7 @@ func NewConfig() contract.IConfigProvider {
 		RefreshTokenExpiry:           time.Hour * time.Duration(getEnvAsInt("REFRESH_TOKEN_EXPIRY_HOURS", 168)), // 7 days
 		PasswordResetTokenExpiry:     time.Minute * time.Duration(getEnvAsInt("PASSWORD_RESET_TOKEN_EXPIRY_MINUTES", 15)),
 		EmailVerificationTokenExpiry: time.Minute * time.Duration(getEnvAsInt("EMAIL_VERIFICATION_TOKEN_EXPIRY_MINUTES", 60)),
+		SchedulerTimezone:            getEnv("SCHEDULER_TIMEZONE", "Africa/Addis_Ababa"),
 	}
 }
 
@@ -67,6 +69,11 @@ func (c *Config) GetEmailVerificationTokenExpiry() time.Duration {
 	return c.EmailVerificationTokenExpiry
 }
 
+// GetSchedulerTimezone returns the IANA timezone cron schedules are evaluated in.
+func (c *Config) GetSchedulerTimezone() string {
+	return c.SchedulerTimezone
+}
+
 // Helper function to get an environment variable or return a default value.
 func getEnv(key, fallback string) string {
 	if value, ok := os.LookupEnv(key); ok {
@@ -0,0 +1,152 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRejects(t *testing.T) {
+	cases := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * 32 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"*/x * * * *",
+		"10-5 * * * *",
+		"* * * foo *",
+		"@every 30s",
+		"@every soon",
+		"@fortnightly",
+	}
+	for _, expr := range cases {
+		if _, err := Parse(expr); err == nil {
+			t.Errorf("Parse(%q) succeeded, want an error", expr)
+		}
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	cases := []struct {
+		expr                          string
+		minute, hour, dom, month, dow uint64
+	}{
+		{expr: "*/15 * * * *", minute: bits(0, 15, 30, 45), hour: span(0, 23), dom: span(1, 31), month: span(1, 12), dow: span(0, 7)},
+		{expr: "5/20 0 1 1 0", minute: bits(5, 25, 45), hour: bits(0), dom: bits(1), month: bits(1), dow: bits(0)},
+		{expr: "0 9-17/4 * * *", minute: bits(0), hour: bits(9, 13, 17), dom: span(1, 31), month: span(1, 12), dow: span(0, 7)},
+		{expr: "0,30 6,18 1,15 * *", minute: bits(0, 30), hour: bits(6, 18), dom: bits(1, 15), month: span(1, 12), dow: span(0, 7)},
+		{expr: "0 0 * jan-mar MON-fri", minute: bits(0), hour: bits(0), dom: span(1, 31), month: bits(1, 2, 3), dow: bits(1, 2, 3, 4, 5)},
+		{expr: "0 0 * * 7", minute: bits(0), hour: bits(0), dom: span(1, 31), month: span(1, 12), dow: bits(0, 7)},
+		{expr: "@weekly", minute: bits(0), hour: bits(0), dom: span(1, 31), month: span(1, 12), dow: bits(0)},
+	}
+	for _, c := range cases {
+		s, err := Parse(c.expr)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", c.expr, err)
+			continue
+		}
+		got := [5]uint64{s.minute, s.hour, s.dom, s.month, s.dow}
+		want := [5]uint64{c.minute, c.hour, c.dom, c.month, c.dow}
+		if got != want {
+			t.Errorf("Parse(%q) = %b, want %b", c.expr, got, want)
+		}
+		if s.String() != c.expr {
+			t.Errorf("Parse(%q).String() = %q", c.expr, s.String())
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	cases := []struct {
+		expr, from, want string
+	}{
+		// steps wrap into the next hour and day
+		{"*/15 * * * *", "2024-03-10T10:07:00Z", "2024-03-10T10:15:00Z"},
+		{"*/15 * * * *", "2024-03-10T10:45:00Z", "2024-03-10T11:00:00Z"},
+		{"*/15 * * * *", "2024-03-10T23:59:30Z", "2024-03-11T00:00:00Z"},
+		{"0 */6 * * *", "2024-03-10T18:00:00Z", "2024-03-11T00:00:00Z"},
+		{"5/20 * * * *", "2024-03-10T10:45:00Z", "2024-03-10T11:05:00Z"},
+		// strictly after: a time on the schedule moves to the following one
+		{"30 2 * * *", "2024-03-10T02:30:00Z", "2024-03-11T02:30:00Z"},
+		// month ends, including leap years
+		{"0 0 31 * *", "2024-04-01T00:00:00Z", "2024-05-31T00:00:00Z"},
+		{"0 0 29 2 *", "2023-03-01T00:00:00Z", "2024-02-29T00:00:00Z"},
+		{"0 0 1 * *", "2024-01-31T23:59:00Z", "2024-02-01T00:00:00Z"},
+		{"59 23 * * *", "2024-12-31T23:59:00Z", "2025-01-01T23:59:00Z"},
+		{"@yearly", "2024-06-15T12:00:00Z", "2025-01-01T00:00:00Z"},
+		// day of week alone, and 7 as Sunday
+		{"0 9 * * mon", "2024-03-10T12:00:00Z", "2024-03-11T09:00:00Z"},
+		{"0 9 * * 7", "2024-03-11T12:00:00Z", "2024-03-17T09:00:00Z"},
+		// both day fields restricted: either one fires
+		{"0 0 13 * fri", "2024-03-09T00:00:00Z", "2024-03-13T00:00:00Z"},
+		{"0 0 13 * fri", "2024-03-13T00:00:00Z", "2024-03-15T00:00:00Z"},
+		// never matches
+		{"0 0 30 2 *", "2024-01-01T00:00:00Z", "0001-01-01T00:00:00Z"},
+		// @every counts from the zero time, not from the call
+		{"@every 1h", "2024-03-10T10:07:00Z", "2024-03-10T11:00:00Z"},
+		{"@every 30m", "2024-03-10T10:30:00Z", "2024-03-10T11:00:00Z"},
+	}
+	for _, c := range cases {
+		s, err := Parse(c.expr)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", c.expr, err)
+		}
+		got := s.Next(mustTime(t, c.from))
+		if want := mustTime(t, c.want); !got.Equal(want) {
+			t.Errorf("%q after %s = %s, want %s", c.expr, c.from, got.Format(time.RFC3339), c.want)
+		}
+	}
+}
+
+func TestNextInLocation(t *testing.T) {
+	// Africa/Addis_Ababa is UTC+3 with no daylight saving
+	loc := time.FixedZone("EAT", 3*60*60)
+	s, err := Parse("0 6 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := s.Next(time.Date(2024, 3, 10, 5, 0, 0, 0, time.UTC))
+	if want := time.Date(2024, 3, 10, 6, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("UTC: got %s, want %s", got, want)
+	}
+	got = s.Next(time.Date(2024, 3, 10, 5, 0, 0, 0, time.UTC).In(loc))
+	if want := time.Date(2024, 3, 11, 6, 0, 0, 0, loc); !got.Equal(want) {
+		t.Errorf("EAT: got %s, want %s", got, want)
+	}
+	if got.Location() != loc {
+		t.Errorf("location = %s, want %s", got.Location(), loc)
+	}
+}
+
+func mustTime(t *testing.T, s string) time.Time {
+	t.Helper()
+	v, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.IsZero() {
+		return time.Time{}
+	}
+	return v
+}
+
+func bits(values ...int) uint64 {
+	var b uint64
+	for _, v := range values {
+		b |= 1 << uint(v)
+	}
+	return b
+}
+
+func span(lo, hi int) uint64 {
+	var b uint64
+	for v := lo; v <= hi; v++ {
+		b |= 1 << uint(v)
+	}
+	return b
+}
@@ -0,0 +1,23 @@
+package external_services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+)
+
+// FakeTranslationClient returns the input tagged with the target language.
+// It needs no network access and is meant for local development and demos.
+type FakeTranslationClient struct{}
+
+func NewFakeTranslationClient() contract.ITranslationClient {
+	return &FakeTranslationClient{}
+}
+
+func (c *FakeTranslationClient) Translate(_ context.Context, text, sourceLang, targetLang string) (string, error) {
+	if text == "" || sourceLang == targetLang {
+		return text, nil
+	}
+	return fmt.Sprintf("[%s] %s", targetLang, text), nil
+}
@@ -0,0 +1,505 @@
+package external_services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+)
+
+// maxFeedBytes bounds the size of a fetched feed document.
+const maxFeedBytes = 5 << 20
+
+type FeedClient struct {
+	client    *http.Client
+	userAgent string
+}
+
+// NewFeedClient polls RSS 2.0 (and RSS 1.0), Atom and JSON Feed documents.
+// FEED_USER_AGENT overrides the User-Agent sent to publishers.
+func NewFeedClient() contract.IFeedClient {
+	ua := os.Getenv("FEED_USER_AGENT")
+	if ua == "" {
+		ua = "NewsBriefBot/1.0 (+https://github.com/RealEskalate/G6-NewsBrief)"
+	}
+	return &FeedClient{client: &http.Client{Timeout: 30 * time.Second}, userAgent: ua}
+}
+
+func (c *FeedClient) Fetch(ctx context.Context, feedURL, etag, lastModified string) (contract.FeedResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, feedURL, nil)
+	if err != nil {
+		return contract.FeedResult{}, err
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Accept", "application/rss+xml, application/atom+xml, application/feed+json, application/json;q=0.9, application/xml;q=0.8, */*;q=0.5")
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return contract.FeedResult{}, err
+	}
+	defer resp.Body.Close()
+
+	result := contract.FeedResult{ETag: resp.Header.Get("ETag"), LastModified: resp.Header.Get("Last-Modified")}
+	if result.ETag == "" {
+		result.ETag = etag
+	}
+	if result.LastModified == "" {
+		result.LastModified = lastModified
+	}
+	if resp.StatusCode == http.StatusNotModified {
+		result.NotModified = true
+		return result, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return contract.FeedResult{}, fmt.Errorf("feed status %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxFeedBytes))
+	if err != nil {
+		return contract.FeedResult{}, err
+	}
+	base, _ := url.Parse(feedURL)
+	items, err := ParseFeed(body, base)
+	if err != nil {
+		return contract.FeedResult{}, err
+	}
+	result.Items = items
+	return result, nil
+}
+
+// ParseFeed detects the feed format and maps its entries to ProviderItems.
+// Relative links are resolved against base (which may be nil).
+func ParseFeed(body []byte, base *url.URL) ([]contract.ProviderItem, error) {
+	body = bytes.TrimPrefix(body, []byte("\xef\xbb\xbf"))
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return nil, fmt.Errorf("empty feed")
+	}
+	var (
+		items []contract.ProviderItem
+		err   error
+	)
+	if trimmed[0] == '{' {
+		items, err = parseJSONFeed(trimmed)
+	} else {
+		items, err = parseXMLFeed(trimmed)
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := items[:0]
+	for _, it := range items {
+		it.SourceURL = resolveLink(base, it.SourceURL)
+		// images in entry HTML are relative to the entry's page
+		imageBase := base
+		if u, err := url.Parse(it.SourceURL); err == nil && u.IsAbs() {
+			imageBase = u
+		}
+		for i := range it.Images {
+			it.Images[i].URL = resolveLink(imageBase, it.Images[i].URL)
+		}
+		if it.ID == "" {
+			it.ID = it.SourceURL
+		}
+		if it.Title == "" && it.Text == "" {
+			continue
+		}
+		it.SourceType = "feed"
+		out = append(out, it)
+	}
+	return out, nil
+}
+
+// xmlFeed covers RSS 2.0 (<rss><channel><item>), RSS 1.0 (<rdf:RDF><item>)
+// and Atom (<feed><entry>); only the matching fields are filled.
+type xmlFeed struct {
+	XMLName xml.Name
+	Lang    string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Channel struct {
+		Title    string    `xml:"title"`
+		Language string    `xml:"language"`
+		Items    []rssItem `xml:"item"`
+	} `xml:"channel"`
+	Items   []rssItem   `xml:"item"`
+	Title   string      `xml:"title"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Links       []string `xml:"link"`
+	GUID        string   `xml:"guid"`
+	Description string   `xml:"description"`
+	Content     string   `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	PubDate     string   `xml:"pubDate"`
+	Date        string   `xml:"http://purl.org/dc/elements/1.1/ date"`
+	Language    string   `xml:"http://purl.org/dc/elements/1.1/ language"`
+	Enclosures  []struct {
+		URL  string `xml:"url,attr"`
+		Type string `xml:"type,attr"`
+	} `xml:"enclosure"`
+	mediaImages
+}
+
+// mediaImages are the Media RSS pictures of an RSS item or Atom entry.
+type mediaImages struct {
+	Contents   []mediaImage `xml:"http://search.yahoo.com/mrss/ content"`
+	Thumbnails []mediaImage `xml:"http://search.yahoo.com/mrss/ thumbnail"`
+	Group      struct {
+		Contents []mediaImage `xml:"http://search.yahoo.com/mrss/ content"`
+	} `xml:"http://search.yahoo.com/mrss/ group"`
+}
+
+type mediaImage struct {
+	URL    string `xml:"url,attr"`
+	Type   string `xml:"type,attr"`
+	Medium string `xml:"medium,attr"`
+	Width  int    `xml:"width,attr"`
+	Height int    `xml:"height,attr"`
+	// Title and Description of media:content double as alt text
+	Title       string `xml:"http://search.yahoo.com/mrss/ title"`
+	Description string `xml:"http://search.yahoo.com/mrss/ description"`
+}
+
+// refs returns the pictures, full-size content before thumbnails.
+func (m mediaImages) refs() []contract.ImageRef {
+	var out []contract.ImageRef
+	for _, mc := range append(append(m.Contents, m.Group.Contents...), m.Thumbnails...) {
+		if mc.Medium != "" && mc.Medium != "image" || mc.Type != "" && !strings.HasPrefix(mc.Type, "image/") {
+			continue
+		}
+		out = append(out, contract.ImageRef{URL: strings.TrimSpace(mc.URL), Width: mc.Width, Height: mc.Height, Alt: htmlToText(firstNonBlank(mc.Description, mc.Title))})
+	}
+	return out
+}
+
+type atomEntry struct {
+	Lang      string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	ID        string     `xml:"id"`
+	Title     atomText   `xml:"title"`
+	Links     []atomLink `xml:"link"`
+	Published string     `xml:"published"`
+	Updated   string     `xml:"updated"`
+	Summary   atomText   `xml:"summary"`
+	Content   atomText   `xml:"content"`
+	mediaImages
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+// atomText holds text, escaped html or inline xhtml content.
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Text  string `xml:",chardata"`
+	Inner string `xml:",innerxml"`
+}
+
+func (t atomText) html() string {
+	if t.Type == "xhtml" {
+		return t.Inner
+	}
+	return t.Text
+}
+
+func parseXMLFeed(body []byte) ([]contract.ProviderItem, error) {
+	dec := xml.NewDecoder(bytes.NewReader(body))
+	dec.Strict = false
+	dec.Entity = xml.HTMLEntity
+	dec.CharsetReader = feedCharsetReader
+	var doc xmlFeed
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("parse feed: %w", err)
+	}
+
+	var items []contract.ProviderItem
+	switch strings.ToLower(doc.XMLName.Local) {
+	case "rss", "rdf":
+		site := strings.TrimSpace(doc.Channel.Title)
+		feedLang := doc.Channel.Language
+		for _, it := range append(doc.Channel.Items, doc.Items...) {
+			link := ""
+			for _, l := range it.Links {
+				if l = strings.TrimSpace(l); l != "" {
+					link = l
+					break
+				}
+			}
+			text := it.Content
+			if strings.TrimSpace(htmlToText(text)) == "" {
+				text = it.Description
+			}
+			images := it.refs()
+			for _, enc := range it.Enclosures {
+				if strings.HasPrefix(enc.Type, "image/") {
+					images = append(images, contract.ImageRef{URL: strings.TrimSpace(enc.URL)})
+				}
+			}
+			items = append(items, contract.ProviderItem{
+				ID:            strings.TrimSpace(it.GUID),
+				Title:         htmlToText(it.Title),
+				Text:          htmlToText(text),
+				SourceURL:     link,
+				SourceSite:    site,
+				PublishedDate: feedDate(it.PubDate, it.Date),
+				Lang:          feedLanguage(it.Language, feedLang),
+				Images:        append(images, htmlImages(it.Content, it.Description)...),
+			})
+		}
+	case "feed":
+		site := strings.TrimSpace(doc.Title)
+		for _, e := range doc.Entries {
+			link := ""
+			for _, l := range e.Links {
+				if l.Rel == "" || l.Rel == "alternate" {
+					link = strings.TrimSpace(l.Href)
+					break
+				}
+			}
+			text := e.Content.html()
+			if strings.TrimSpace(htmlToText(text)) == "" {
+				text = e.Summary.html()
+			}
+			images := e.refs()
+			for _, l := range e.Links {
+				if l.Rel == "enclosure" && strings.HasPrefix(l.Type, "image/") {
+					images = append(images, contract.ImageRef{URL: strings.TrimSpace(l.Href)})
+				}
+			}
+			items = append(items, contract.ProviderItem{
+				ID:            strings.TrimSpace(e.ID),
+				Title:         htmlToText(e.Title.html()),
+				Text:          htmlToText(text),
+				SourceURL:     link,
+				SourceSite:    site,
+				PublishedDate: feedDate(e.Published, e.Updated),
+				Lang:          feedLanguage(e.Lang, doc.Lang),
+				Images:        append(images, htmlImages(e.Content.html(), e.Summary.html())...),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported feed format <%s>", doc.XMLName.Local)
+	}
+	return items, nil
+}
+
+type jsonFeed struct {
+	Version  string `json:"version"`
+	Title    string `json:"title"`
+	Language string `json:"language"`
+	Items    []struct {
+		ID            interface{} `json:"id"`
+		URL           string      `json:"url"`
+		ExternalURL   string      `json:"external_url"`
+		Title         string      `json:"title"`
+		ContentHTML   string      `json:"content_html"`
+		ContentText   string      `json:"content_text"`
+		Summary       string      `json:"summary"`
+		DatePublished string      `json:"date_published"`
+		DateModified  string      `json:"date_modified"`
+		Language      string      `json:"language"`
+		Image         string      `json:"image"`
+		BannerImage   string      `json:"banner_image"`
+	} `json:"items"`
+}
+
+func parseJSONFeed(body []byte) ([]contract.ProviderItem, error) {
+	var doc jsonFeed
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("parse json feed: %w", err)
+	}
+	if !strings.HasPrefix(doc.Version, "https://jsonfeed.org/version/") {
+		return nil, fmt.Errorf("not a JSON Feed")
+	}
+	items := make([]contract.ProviderItem, 0, len(doc.Items))
+	for _, it := range doc.Items {
+		id := ""
+		if it.ID != nil {
+			id = strings.TrimSpace(fmt.Sprint(it.ID))
+		}
+		text := strings.TrimSpace(it.ContentText)
+		if text == "" {
+			text = htmlToText(it.ContentHTML)
+		}
+		if text == "" {
+			text = htmlToText(it.Summary)
+		}
+		var images []contract.ImageRef
+		for _, u := range []string{it.Image, it.BannerImage} {
+			if u = strings.TrimSpace(u); u != "" {
+				images = append(images, contract.ImageRef{URL: u})
+			}
+		}
+		items = append(items, contract.ProviderItem{
+			ID:            id,
+			Title:         htmlToText(it.Title),
+			Text:          text,
+			SourceURL:     firstNonBlank(it.URL, it.ExternalURL),
+			SourceSite:    strings.TrimSpace(doc.Title),
+			PublishedDate: feedDate(it.DatePublished, it.DateModified),
+			Lang:          feedLanguage(it.Language, doc.Language),
+			Images:        append(images, htmlImages(it.ContentHTML)...),
+		})
+	}
+	return items, nil
+}
+
+var feedDateLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"Mon, 02 Jan 2006 15:04 -0700",
+	"2 Jan 2006 15:04:05 -0700",
+	"02 Jan 2006 15:04:05 MST",
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// feedDate returns the first parseable date as RFC3339 in UTC, or "".
+func feedDate(values ...string) string {
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		for _, layout := range feedDateLayouts {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t.UTC().Format(time.RFC3339)
+			}
+		}
+	}
+	return ""
+}
+
+// feedLanguage maps a declared language (e.g. "am-ET", "en-us") to "am" or
+// "en"; anything else is left for ingestion to decide.
+func feedLanguage(values ...string) string {
+	for _, v := range values {
+		v = strings.ToLower(strings.TrimSpace(v))
+		switch {
+		case v == "":
+			continue
+		case v == "am" || v == "amh" || strings.HasPrefix(v, "am-") || strings.HasPrefix(v, "am_"):
+			return "am"
+		case v == "en" || v == "eng" || strings.HasPrefix(v, "en-") || strings.HasPrefix(v, "en_"):
+			return "en"
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+var (
+	htmlDropBlocks = regexp.MustCompile(`(?is)<(script|style)[^>]*>.*?</(script|style)>`)
+	htmlBreaks     = regexp.MustCompile(`(?i)<br\s*/?>|</(p|div|li|h[1-6]|blockquote|tr)>`)
+	htmlTags       = regexp.MustCompile(`<[^>]*>`)
+	blankLines     = regexp.MustCompile(`\n{3,}`)
+	htmlImgTags    = regexp.MustCompile(`(?i)<img\s[^>]*>`)
+	htmlImgAttr    = regexp.MustCompile(`(?i)\s(src|alt|width|height)\s*=\s*("[^"]*"|'[^']*'|[^\s>]+)`)
+)
+
+// htmlImages returns the <img> pictures embedded in feed HTML, in order.
+func htmlImages(docs ...string) []contract.ImageRef {
+	var out []contract.ImageRef
+	for _, doc := range docs {
+		for _, tag := range htmlImgTags.FindAllString(doc, -1) {
+			var img contract.ImageRef
+			for _, m := range htmlImgAttr.FindAllStringSubmatch(tag, -1) {
+				v := html.UnescapeString(strings.Trim(m[2], `"'`))
+				switch strings.ToLower(m[1]) {
+				case "src":
+					img.URL = strings.TrimSpace(v)
+				case "alt":
+					img.Alt = strings.TrimSpace(v)
+				case "width":
+					img.Width, _ = strconv.Atoi(v)
+				case "height":
+					img.Height, _ = strconv.Atoi(v)
+				}
+			}
+			if img.URL != "" && !strings.HasPrefix(img.URL, "data:") {
+				out = append(out, img)
+			}
+		}
+	}
+	return out
+}
+
+// htmlToText turns feed HTML into plain text, keeping paragraph breaks.
+func htmlToText(s string) string {
+	s = htmlDropBlocks.ReplaceAllString(s, "")
+	s = htmlBreaks.ReplaceAllString(s, "\n")
+	s = htmlTags.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		lines[i] = strings.Join(strings.Fields(l), " ")
+	}
+	s = strings.Join(lines, "\n")
+	return strings.TrimSpace(blankLines.ReplaceAllString(s, "\n\n"))
+}
+
+func resolveLink(base *url.URL, link string) string {
+	if link == "" || base == nil {
+		return link
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+	return base.ResolveReference(u).String()
+}
+
+// feedCharsetReader decodes the legacy charsets feeds still declare;
+// UTF-8 documents pass through.
+func feedCharsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "", "utf-8", "utf8", "us-ascii", "ascii":
+		return input, nil
+	case "iso-8859-1", "latin1", "latin-1", "windows-1252", "cp1252":
+		data, err := io.ReadAll(input)
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, 0, len(data)+len(data)/4)
+		for _, b := range data {
+			buf = utf8.AppendRune(buf, rune(b))
+		}
+		return bytes.NewReader(buf), nil
+	}
+	return nil, fmt.Errorf("unsupported feed charset %q", charset)
+}
+
+func firstNonBlank(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
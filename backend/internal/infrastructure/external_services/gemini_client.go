@@ -2,30 +2,61 @@ package external_services
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"strings"
 	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/concurrency"
 )
 
 type GeminiClient struct {
-	APIKey string
-	APIURL string // Expected to be the full generateContent endpoint for the chosen model
+	APIKey   string
+	APIURL   string // Expected to be the full generateContent endpoint for the chosen model
+	EmbedURL string // Full embedContent endpoint for the embedding model
+
+	meter contract.IUsageMeter
+	// limit bounds the requests in flight to the Gemini API
+	limit *concurrency.Limiter
 }
 
 func NewGeminiClient(apiKey, apiURL string) *GeminiClient {
+	embedURL := os.Getenv("GEMINI_EMBED_API_URL")
+	if embedURL == "" {
+		embedURL = "https://generativelanguage.googleapis.com/v1beta/models/text-embedding-004:embedContent"
+	}
 	return &GeminiClient{
-		APIKey: apiKey,
-		APIURL: apiURL,
+		APIKey:   apiKey,
+		APIURL:   apiURL,
+		EmbedURL: embedURL,
 	}
 }
 
+// WithMeter checks budgets before and records usage after every model call.
+func (c *GeminiClient) WithMeter(meter contract.IUsageMeter) *GeminiClient {
+	c.meter = meter
+	return c
+}
+
+// WithConcurrency allows at most n Gemini requests in flight across all
+// callers; the rest wait until a slot frees or their context ends. n <= 0
+// removes the bound.
+func (c *GeminiClient) WithConcurrency(n int) *GeminiClient {
+	c.limit = concurrency.NewLimiter(n)
+	return c
+}
+
 // genReq and genResp are minimal structs for Google Generative Language API
 type genReq struct {
 	Contents          []content      `json:"contents"`
 	SystemInstruction *systemMessage `json:"systemInstruction,omitempty"`
+	Tools             []genTool      `json:"tools,omitempty"`
 }
 
 type content struct {
@@ -34,7 +65,29 @@ type content struct {
 }
 
 type part struct {
-	Text string `json:"text"`
+	Text             string            `json:"text,omitempty"`
+	FunctionCall     *functionCall     `json:"functionCall,omitempty"`
+	FunctionResponse *functionResponse `json:"functionResponse,omitempty"`
+}
+
+type functionCall struct {
+	Name string                 `json:"name"`
+	Args map[string]interface{} `json:"args,omitempty"`
+}
+
+type functionResponse struct {
+	Name     string                 `json:"name"`
+	Response map[string]interface{} `json:"response"`
+}
+
+type genTool struct {
+	FunctionDeclarations []functionDeclaration `json:"functionDeclarations"`
+}
+
+type functionDeclaration struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Parameters  map[string]interface{} `json:"parameters,omitempty"`
 }
 
 type systemMessage struct {
@@ -47,7 +100,16 @@ type genResp struct {
 		Content struct {
 			Parts []part `json:"parts"`
 		} `json:"content"`
+		FinishReason string `json:"finishReason"`
 	} `json:"candidates"`
+	PromptFeedback struct {
+		BlockReason string `json:"blockReason"`
+	} `json:"promptFeedback"`
+	UsageMetadata struct {
+		PromptTokenCount     int `json:"promptTokenCount"`
+		CandidatesTokenCount int `json:"candidatesTokenCount"`
+		TotalTokenCount      int `json:"totalTokenCount"`
+	} `json:"usageMetadata"`
 	Error *struct {
 		Code    int    `json:"code"`
 		Message string `json:"message"`
@@ -56,13 +118,16 @@ type genResp struct {
 }
 
 func (c *GeminiClient) buildURLWithKey() string {
+	return withKey(c.APIURL, c.APIKey)
+}
+
+func withKey(url, key string) string {
 	// Append ?key= or &key= depending on presence of query string
 	sep := "?"
-	if strings.Contains(c.APIURL, "?") {
+	if strings.Contains(url, "?") {
 		sep = "&"
 	}
-	// fmt.Println("API URL:", c.APIURL) // Debug print
-	return fmt.Sprintf("%s%vkey=%s", c.APIURL, sep, c.APIKey)
+	return fmt.Sprintf("%s%vkey=%s", url, sep, key)
 }
 
 func (c *GeminiClient) httpClient() *http.Client {
@@ -70,96 +135,195 @@ func (c *GeminiClient) httpClient() *http.Client {
 }
 
 func extractText(r genResp) (string, error) {
+	// the model's own safety filters refuse the prompt or withhold the answer
+	if r.PromptFeedback.BlockReason != "" {
+		return "", fmt.Errorf("%w: prompt blocked by model (%s)", contract.ErrUnsafeContent, r.PromptFeedback.BlockReason)
+	}
+	if len(r.Candidates) > 0 {
+		switch r.Candidates[0].FinishReason {
+		case "SAFETY", "PROHIBITED_CONTENT", "BLOCKLIST", "SPII":
+			return "", fmt.Errorf("%w: reply blocked by model (%s)", contract.ErrUnsafeContent, r.Candidates[0].FinishReason)
+		}
+	}
 	if len(r.Candidates) == 0 || len(r.Candidates[0].Content.Parts) == 0 {
 		return "", fmt.Errorf("no candidates returned from model")
 	}
 	return r.Candidates[0].Content.Parts[0].Text, nil
 }
 
-func (c *GeminiClient) Summarize(text, lang string) (string, error) {
-	// Prompt the model to summarize in the requested language
-	prompt := fmt.Sprintf("Summarize the following text in %s. Keep it concise and clear.\n\n%s", lang, text)
-	reqBody := genReq{
-		Contents: []content{
-			{Parts: []part{{Text: prompt}}},
-		},
+// Model returns the model name parsed from the configured endpoint,
+// e.g. ".../models/gemini-1.5-flash:generateContent" -> "gemini-1.5-flash".
+func (c *GeminiClient) Model() string {
+	return modelFromURL(c.APIURL)
+}
+
+// EmbeddingModel returns the embedding model name parsed from EmbedURL.
+func (c *GeminiClient) EmbeddingModel() string {
+	return modelFromURL(c.EmbedURL)
+}
+
+func modelFromURL(u string) string {
+	if i := strings.Index(u, "models/"); i >= 0 {
+		u = u[i+len("models/"):]
 	}
-	data, _ := json.Marshal(reqBody)
+	if i := strings.IndexAny(u, ":?"); i >= 0 {
+		u = u[:i]
+	}
+	return u
+}
 
-	req, _ := http.NewRequest("POST", c.buildURLWithKey(), bytes.NewBuffer(data))
-	req.Header.Set("Content-Type", "application/json")
+type embedReq struct {
+	Model   string  `json:"model"`
+	Content content `json:"content"`
+}
+
+type embedResp struct {
+	Embedding struct {
+		Values []float32 `json:"values"`
+	} `json:"embedding"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
 
+// Embed returns the embedding vector of the given text.
+func (c *GeminiClient) Embed(ctx context.Context, text string) ([]float32, error) {
+	if c.meter == nil {
+		return c.embed(ctx, text)
+	}
+	if err := c.meter.Allow(ctx); err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	vector, err := c.embed(ctx, text)
+	usage := &entity.LLMUsage{
+		Kind:       entity.UsageEmbedding,
+		Operation:  contract.UsageOperationFrom(ctx, "embed"),
+		Provider:   c.EmbeddingModel(),
+		Characters: len([]rune(text)),
+		LatencyMS:  time.Since(start).Milliseconds(),
+		Success:    err == nil,
+	}
+	if err != nil {
+		usage.Error = err.Error()
+	}
+	c.meter.Record(ctx, usage)
+	return vector, err
+}
+
+func (c *GeminiClient) embed(ctx context.Context, text string) ([]float32, error) {
+	if err := c.limit.Acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer c.limit.Release()
+	reqBody := embedReq{Model: "models/" + c.EmbeddingModel(), Content: content{Parts: []part{{Text: text}}}}
+	data, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, withKey(c.EmbedURL, c.APIKey), bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
 	resp, err := c.httpClient().Do(req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer resp.Body.Close()
-
 	bodyBytes, _ := io.ReadAll(resp.Body)
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return "", fmt.Errorf("gemini error %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, fmt.Errorf("gemini embed error %d: %s", resp.StatusCode, string(bodyBytes))
 	}
-
-	var result genResp
+	var result embedResp
 	if err := json.Unmarshal(bodyBytes, &result); err != nil {
-		return "", err
+		return nil, err
 	}
 	if result.Error != nil {
-		return "", fmt.Errorf("gemini api error: %s", result.Error.Message)
+		return nil, fmt.Errorf("gemini api error: %s", result.Error.Message)
+	}
+	if len(result.Embedding.Values) == 0 {
+		return nil, fmt.Errorf("empty embedding returned from model")
+	}
+	return result.Embedding.Values, nil
+}
+
+// Generate sends a single free-form prompt and returns the first candidate text.
+func (c *GeminiClient) Generate(ctx context.Context, prompt string) (string, error) {
+	reqBody := genReq{Contents: []content{{Role: "user", Parts: []part{{Text: prompt}}}}}
+	result, err := c.post(ctx, "generate", reqBody)
+	if err != nil {
+		return "", err
 	}
 	return extractText(result)
 }
 
-// ClassifyTopics asks the model to output a JSON array of concise topic labels
-func (c *GeminiClient) ClassifyTopics(text, lang string, topK int) ([]string, error) {
-	if topK <= 0 {
-		topK = 2
+// post sends a generateContent request through the usage meter. The operation
+// is recorded unless the caller labelled ctx with a more specific one.
+func (c *GeminiClient) post(ctx context.Context, operation string, reqBody genReq) (genResp, error) {
+	if c.meter != nil {
+		if err := c.meter.Allow(ctx); err != nil {
+			return genResp{}, err
+		}
+	}
+	start := time.Now()
+	result, err := c.send(ctx, reqBody)
+	if c.meter != nil {
+		usage := &entity.LLMUsage{
+			Kind:         entity.UsageLLM,
+			Operation:    contract.UsageOperationFrom(ctx, operation),
+			Provider:     c.Model(),
+			PromptTokens: result.UsageMetadata.PromptTokenCount,
+			OutputTokens: result.UsageMetadata.CandidatesTokenCount,
+			TotalTokens:  result.UsageMetadata.TotalTokenCount,
+			LatencyMS:    time.Since(start).Milliseconds(),
+			Success:      err == nil,
+		}
+		if err != nil {
+			usage.Error = err.Error()
+		}
+		c.meter.Record(ctx, usage)
+	}
+	return result, err
+}
+
+// send performs the generateContent HTTP request and decodes the response.
+func (c *GeminiClient) send(ctx context.Context, reqBody genReq) (genResp, error) {
+	if err := c.limit.Acquire(ctx); err != nil {
+		return genResp{}, err
+	}
+	defer c.limit.Release()
+	data, err := json.Marshal(reqBody)
+	if err != nil {
+		return genResp{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.buildURLWithKey(), bytes.NewBuffer(data))
+	if err != nil {
+		return genResp{}, err
 	}
-	prompt := fmt.Sprintf("Return a JSON array (no prose) of up to %d high-level topic labels in %s for the following text. Keep labels concise, 1-3 words. If uncertain, still return best guesses. Text:\n\n%s", topK, lang, text)
-	reqBody := genReq{Contents: []content{{Parts: []part{{Text: prompt}}}}}
-	data, _ := json.Marshal(reqBody)
-	req, _ := http.NewRequest("POST", c.buildURLWithKey(), bytes.NewBuffer(data))
 	req.Header.Set("Content-Type", "application/json")
+
 	resp, err := c.httpClient().Do(req)
 	if err != nil {
-		return nil, err
+		return genResp{}, err
 	}
 	defer resp.Body.Close()
+
 	bodyBytes, _ := io.ReadAll(resp.Body)
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("gemini error %d: %s", resp.StatusCode, string(bodyBytes))
+		return genResp{}, fmt.Errorf("gemini error %d: %s", resp.StatusCode, string(bodyBytes))
 	}
 	var result genResp
-	if err := json.Unmarshal(bodyBytes, &result); err == nil {
-		// try structured extraction then parse as JSON
-		textOut, err := extractText(result)
-		if err != nil {
-			return nil, err
-		}
-		// parse textOut as JSON array
-		var arr []string
-		if err := json.Unmarshal([]byte(textOut), &arr); err == nil {
-			return arr, nil
-		}
-		// fallback: split by commas/newlines
-		raw := strings.ReplaceAll(textOut, "\n", ",")
-		parts := strings.Split(raw, ",")
-		out := make([]string, 0, len(parts))
-		for _, p := range parts {
-			s := strings.TrimSpace(p)
-			if s != "" {
-				out = append(out, s)
-			}
-		}
-		if len(out) > topK {
-			out = out[:topK]
-		}
-		return out, nil
+	if err := json.Unmarshal(bodyBytes, &result); err != nil {
+		return genResp{}, err
 	}
-	return nil, fmt.Errorf("failed to parse gemini response for topics")
+	if result.Error != nil {
+		return genResp{}, fmt.Errorf("gemini api error: %s", result.Error.Message)
+	}
+	return result, nil
 }
 
-func (c *GeminiClient) Chat(messages []string, context string) (string, error) {
+func (c *GeminiClient) Chat(ctx context.Context, messages []string, system string) (string, error) {
 	// Build conversation with the latest user message only for now
 	userMsg := ""
 	if len(messages) > 0 {
@@ -170,31 +334,70 @@ func (c *GeminiClient) Chat(messages []string, context string) (string, error) {
 			{Role: "user", Parts: []part{{Text: userMsg}}},
 		},
 	}
-	if strings.TrimSpace(context) != "" {
-		reqBody.SystemInstruction = &systemMessage{Role: "system", Parts: []part{{Text: context}}}
+	if strings.TrimSpace(system) != "" {
+		reqBody.SystemInstruction = &systemMessage{Role: "system", Parts: []part{{Text: system}}}
 	}
-
-	data, _ := json.Marshal(reqBody)
-	req, _ := http.NewRequest("POST", c.buildURLWithKey(), bytes.NewBuffer(data))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient().Do(req)
+	result, err := c.post(ctx, "chat", reqBody)
 	if err != nil {
 		return "", err
 	}
-	defer resp.Body.Close()
+	return extractText(result)
+}
 
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return "", fmt.Errorf("gemini error %d: %s", resp.StatusCode, string(bodyBytes))
+// ChatWithTools sends the conversation with the declared functions and
+// returns either the model's text or the calls it requested.
+func (c *GeminiClient) ChatWithTools(ctx context.Context, turns []contract.ChatTurn, system string, tools []contract.ToolSpec) (contract.ToolStep, error) {
+	reqBody := genReq{}
+	for _, t := range turns {
+		switch t.Role {
+		case "model":
+			msg := content{Role: "model"}
+			if t.Text != "" {
+				msg.Parts = append(msg.Parts, part{Text: t.Text})
+			}
+			for _, call := range t.Calls {
+				msg.Parts = append(msg.Parts, part{FunctionCall: &functionCall{Name: call.Name, Args: call.Args}})
+			}
+			reqBody.Contents = append(reqBody.Contents, msg)
+		case "tool":
+			msg := content{Role: "user"}
+			for _, r := range t.Results {
+				msg.Parts = append(msg.Parts, part{FunctionResponse: &functionResponse{Name: r.Name, Response: r.Response}})
+			}
+			reqBody.Contents = append(reqBody.Contents, msg)
+		default:
+			reqBody.Contents = append(reqBody.Contents, content{Role: "user", Parts: []part{{Text: t.Text}}})
+		}
 	}
-
-	var result genResp
-	if err := json.Unmarshal(bodyBytes, &result); err != nil {
-		return "", err
+	if strings.TrimSpace(system) != "" {
+		reqBody.SystemInstruction = &systemMessage{Role: "system", Parts: []part{{Text: system}}}
 	}
-	if result.Error != nil {
-		return "", fmt.Errorf("gemini api error: %s", result.Error.Message)
+	if len(tools) > 0 {
+		decl := make([]functionDeclaration, 0, len(tools))
+		for _, t := range tools {
+			decl = append(decl, functionDeclaration{Name: t.Name, Description: t.Description, Parameters: t.Parameters})
+		}
+		reqBody.Tools = []genTool{{FunctionDeclarations: decl}}
 	}
-	return extractText(result)
+	result, err := c.post(ctx, "chat_tools", reqBody)
+	if err != nil {
+		return contract.ToolStep{}, err
+	}
+	var step contract.ToolStep
+	if len(result.Candidates) > 0 {
+		for _, p := range result.Candidates[0].Content.Parts {
+			if p.FunctionCall != nil {
+				step.Calls = append(step.Calls, contract.ToolCall{Name: p.FunctionCall.Name, Args: p.FunctionCall.Args})
+			}
+		}
+	}
+	if len(step.Calls) > 0 {
+		return step, nil
+	}
+	text, err := extractText(result)
+	if err != nil {
+		return contract.ToolStep{}, err
+	}
+	step.Text = text
+	return step, nil
 }
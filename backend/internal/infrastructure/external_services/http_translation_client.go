@@ -0,0 +1,68 @@
+package external_services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+)
+
+// HTTPTranslationClient calls a self-hosted machine translation service that
+// speaks the LibreTranslate API (POST /translate with q/source/target).
+type HTTPTranslationClient struct {
+	url    string
+	apiKey string
+	client *http.Client
+}
+
+func NewHTTPTranslationClient(url, apiKey string) contract.ITranslationClient {
+	return &HTTPTranslationClient{
+		url:    url,
+		apiKey: apiKey,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type mtRequest struct {
+	Q      string `json:"q"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Format string `json:"format"`
+	APIKey string `json:"api_key,omitempty"`
+}
+
+type mtResponse struct {
+	TranslatedText string `json:"translatedText"`
+	Error          string `json:"error,omitempty"`
+}
+
+func (c *HTTPTranslationClient) Translate(ctx context.Context, text, sourceLang, targetLang string) (string, error) {
+	body, err := json.Marshal(mtRequest{Q: text, Source: sourceLang, Target: targetLang, Format: "text", APIKey: c.apiKey})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	b, _ := io.ReadAll(resp.Body)
+	var out mtResponse
+	if err := json.Unmarshal(b, &out); err != nil {
+		return "", fmt.Errorf("translation service status %d: %s", resp.StatusCode, string(b))
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("translation service status %d: %s", resp.StatusCode, out.Error)
+	}
+	return out.TranslatedText, nil
+}
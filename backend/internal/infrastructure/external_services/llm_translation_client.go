@@ -0,0 +1,43 @@
+package external_services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+)
+
+// LLMTranslationClient translates with the configured Gemini model, using the
+// "translate" prompt of the registry so the text is isolated as untrusted.
+type LLMTranslationClient struct {
+	gemini  contract.IGeminiClient
+	prompts contract.IPromptRegistry
+}
+
+func NewLLMTranslationClient(gemini contract.IGeminiClient, prompts contract.IPromptRegistry) contract.ITranslationClient {
+	return &LLMTranslationClient{gemini: gemini, prompts: prompts}
+}
+
+var translationLanguageNames = map[string]string{"en": "English", "am": "Amharic"}
+
+func (c *LLMTranslationClient) Translate(ctx context.Context, text, sourceLang, targetLang string) (string, error) {
+	src, ok := translationLanguageNames[sourceLang]
+	if !ok {
+		src = sourceLang
+	}
+	p, err := c.prompts.Render(ctx, "translate", targetLang, "", map[string]string{"source_language": src, "text": text})
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(contract.WithUsageOperation(ctx, contract.UsageOperationFrom(ctx, "translate")), 60*time.Second)
+	defer cancel()
+	out, err := c.gemini.Generate(ctx, p.Text)
+	if err != nil {
+		return "", err
+	}
+	// the model sometimes echoes the delimiters around its translation
+	out = strings.TrimSpace(out)
+	out = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(out, "<untrusted>"), "</untrusted>"))
+	return out, nil
+}
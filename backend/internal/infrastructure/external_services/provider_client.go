@@ -1,11 +1,14 @@
 package external_services
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
@@ -27,13 +30,33 @@ func NewNewsProviderClient() contract.INewsProviderClient {
 	}
 }
 
-func (c *NewsProviderClient) Search(query string, topK int) ([]contract.ProviderItem, error) {
-	// New endpoint ignores query; using stored news listing with limit
+// Search returns the newest stored items matching query, as the first page of FetchPage.
+func (c *NewsProviderClient) Search(ctx context.Context, query string, topK int) ([]contract.ProviderItem, error) {
 	if topK <= 0 {
 		topK = 100 // default limit requested
 	}
-	url := fmt.Sprintf("%s/api/v1/news/stored?limit=%d", c.baseURL, topK)
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	return c.FetchPage(ctx, contract.ProviderQuery{Query: query, Limit: topK})
+}
+
+// FetchPage requests one page of stored items. The service sorts by published
+// date, newest first, and applies offset, since (inclusive), until (exclusive)
+// and q; the caller still checks dates since undated items are dated by crawl time.
+func (c *NewsProviderClient) FetchPage(ctx context.Context, q contract.ProviderQuery) ([]contract.ProviderItem, error) {
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(q.Limit))
+	if q.Offset > 0 {
+		params.Set("offset", strconv.Itoa(q.Offset))
+	}
+	if q.Query != "" {
+		params.Set("q", q.Query)
+	}
+	if !q.Since.IsZero() {
+		params.Set("since", q.Since.UTC().Format(time.RFC3339))
+	}
+	if !q.Until.IsZero() {
+		params.Set("until", q.Until.UTC().Format(time.RFC3339))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/api/v1/news/stored?"+params.Encode(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,29 +64,35 @@ func (c *NewsProviderClient) Search(query string, topK int) ([]contract.Provider
 	if err != nil {
 		return nil, err
 	}
-	
 	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		return nil, fmt.Errorf("provider status %d", resp.StatusCode)
 	}
-	// Read entire body once and try multiple shapes
-	b, _ := io.ReadAll(resp.Body)
+	b, err := io.ReadAll(io.LimitReader(resp.Body, 32<<20))
+	if err != nil {
+		return nil, err
+	}
+	return decodeProviderItems(b), nil
+}
+
+// decodeProviderItems accepts a bare array, a {"data": [...]} wrapper or a single item.
+func decodeProviderItems(b []byte) []contract.ProviderItem {
 	// 1) array
 	var items []contract.ProviderItem
 	if err := json.Unmarshal(b, &items); err == nil {
-		return items, nil
+		return items
 	}
 	// 2) wrapped { data: [] }
 	var wrap struct {
 		Data []contract.ProviderItem `json:"data"`
 	}
 	if err := json.Unmarshal(b, &wrap); err == nil && len(wrap.Data) > 0 {
-		return wrap.Data, nil
+		return wrap.Data
 	}
 	// 3) single item
 	var one contract.ProviderItem
 	if err := json.Unmarshal(b, &one); err == nil && one.ID != "" {
-		return []contract.ProviderItem{one}, nil
+		return []contract.ProviderItem{one}
 	}
-	return []contract.ProviderItem{}, nil
+	return []contract.ProviderItem{}
 }
@@ -1,6 +1,8 @@
 package external_services
 
 import (
+	"context"
+
 	"github.com/Conight/go-googletrans"
 )
 
@@ -14,7 +16,8 @@ func NewTranslatorClient() *TranslatorClient {
 	}
 }
 
-func (tc *TranslatorClient) Translate(text, sourceLang, targetLang string) (string, error) {
+// Translate calls the public Google endpoint, which does not take a context.
+func (tc *TranslatorClient) Translate(_ context.Context, text, sourceLang, targetLang string) (string, error) {
 	res, err := tc.client.Translate(text, sourceLang, targetLang)
 	if err != nil {
 		return "", err
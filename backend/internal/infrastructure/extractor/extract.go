@@ -0,0 +1,599 @@
+// Package extractor fetches article pages and pulls out their main text,
+// readability style: paragraphs are scored, the best scoring container wins
+// and navigation, share bars and other boilerplate are dropped.
+package extractor
+
+import (
+	"io"
+	"math"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Article is the main content of a page.
+type Article struct {
+	Title string `json:"title"`
+	// Text holds the paragraphs separated by blank lines
+	Text       string `json:"text"`
+	Lang       string `json:"lang,omitempty"`
+	SiteName   string `json:"site_name,omitempty"`
+	Published  string `json:"published,omitempty"`
+	Paragraphs int    `json:"paragraphs"`
+	// Images holds the lead image first, then the gallery
+	Images []Image `json:"images,omitempty"`
+}
+
+const (
+	// minParagraphWeight drops fragments such as captions and bylines
+	minParagraphWeight = 40
+	// minScoredWeight is the least text a node needs to count towards its ancestors
+	minScoredWeight = 25
+)
+
+var (
+	unlikelyRe = regexp.MustCompile(`(?i)comment|disqus|footer|footnote|sidebar|share|sharing|social|related|recommend|advert|\bads?\b|ad-|promo|sponsor|navbar|\bnav\b|menu|subscribe|newsletter|cookie|consent|popup|modal|breadcrumb|widget|tags?-|pagination|pager|print|author-box|most-read|trending|rss`)
+	maybeRe    = regexp.MustCompile(`(?i)article|body|column|main|content|story|entry|post`)
+	positiveRe = regexp.MustCompile(`(?i)article|body|content|entry|hentry|h-entry|main|page|post|text|blog|story|news|field-item|td-post-content|single`)
+	negativeRe = regexp.MustCompile(`(?i)hidden|banner|combx|comment|com-|contact|foot|footer|footnote|masthead|media|meta|outbrain|promo|related|scroll|share|shoutbox|sidebar|skyscraper|sponsor|shopping|tags|tool|widget|byline|caption|credit`)
+	// boilerplateRe matches whole paragraphs that are page furniture, in English and Amharic
+	boilerplateRe = regexp.MustCompile(`(?i)^(share( this)?( on)?|read more|continue reading|related( articles| stories)?|advertisement|subscribe|sign up|follow us|click here|all rights reserved|copyright|©|ያጋሩ|አጋራ|ተጨማሪ ያንብቡ|ተዛማጅ|ማስታወቂያ|ይከተሉን|ለመመዝገብ|መብቱ በህግ የተጠበቀ)`)
+)
+
+// removed are dropped before scoring; they never hold article text.
+var removed = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Iframe: true, atom.Form: true,
+	atom.Nav: true, atom.Footer: true, atom.Aside: true, atom.Svg: true, atom.Button: true,
+	atom.Input: true, atom.Select: true, atom.Textarea: true, atom.Object: true, atom.Embed: true,
+	atom.Header: true, atom.Figcaption: true, atom.Template: true, atom.Link: true, atom.Meta: true,
+}
+
+// blockTags end a paragraph; a div without any of them is treated as a paragraph.
+var blockTags = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.Section: true, atom.Article: true, atom.Blockquote: true,
+	atom.Pre: true, atom.Table: true, atom.Ul: true, atom.Ol: true, atom.Dl: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+	atom.Figure: true, atom.Main: true, atom.Li: true,
+}
+
+// Extract parses an HTML page and returns its main article text. Relative
+// image URLs are resolved against pageURL; without it they are dropped.
+func Extract(r io.Reader, pageURL string) (*Article, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	base, _ := url.Parse(pageURL)
+	if base != nil && !base.IsAbs() {
+		base = nil
+	}
+	meta := readMeta(doc)
+	body := find(doc, atom.Body)
+	if body == nil {
+		body = doc
+	}
+	prune(body)
+
+	scores := map[*html.Node]float64{}
+	score(body, scores)
+	best := body
+	bestScore := 0.0
+	for n, s := range scores {
+		s *= 1 - linkDensity(n)
+		scores[n] = s
+		if s > bestScore || s == bestScore && best != body && depth(n) > depth(best) {
+			best, bestScore = n, s
+		}
+	}
+
+	var paras []string
+	content := withSiblings(best, bestScore, scores)
+	for _, n := range content {
+		paras = append(paras, paragraphs(n)...)
+	}
+	paras = cleanParagraphs(paras, meta.title)
+
+	text := strings.Join(paras, "\n\n")
+	a := &Article{
+		Title:      meta.title,
+		Text:       text,
+		SiteName:   meta.siteName,
+		Published:  meta.published,
+		Paragraphs: len(paras),
+		Lang:       detectLang(text, meta.lang),
+		Images:     collectImages(meta, content, best, base),
+	}
+	if a.Title == "" {
+		a.Title = meta.h1
+	}
+	return a, nil
+}
+
+type pageMeta struct {
+	title, h1, siteName, published, lang string
+	// images are the declared og:image/twitter:image pictures
+	images []Image
+}
+
+func readMeta(doc *html.Node) pageMeta {
+	var m pageMeta
+	var docTitle string
+	walk(doc, func(n *html.Node) bool {
+		switch n.DataAtom {
+		case atom.Html:
+			m.lang = attr(n, "lang")
+		case atom.Title:
+			if docTitle == "" {
+				docTitle = collapse(textOf(n))
+			}
+		case atom.H1:
+			if m.h1 == "" {
+				m.h1 = collapse(textOf(n))
+			}
+		case atom.Time:
+			if m.published == "" {
+				m.published = attr(n, "datetime")
+			}
+		case atom.Link:
+			if strings.EqualFold(attr(n, "rel"), "image_src") {
+				m.addImage(attr(n, "href"))
+			}
+		case atom.Meta:
+			key := strings.ToLower(firstAttr(n, "property", "name", "itemprop"))
+			content := strings.TrimSpace(attr(n, "content"))
+			if content == "" {
+				break
+			}
+			switch key {
+			case "og:image", "og:image:url", "og:image:secure_url", "twitter:image", "twitter:image:src", "image":
+				m.addImage(content)
+			case "og:image:width":
+				m.lastImage(func(img *Image) { img.Width = atoi(content) })
+			case "og:image:height":
+				m.lastImage(func(img *Image) { img.Height = atoi(content) })
+			case "og:image:alt", "twitter:image:alt":
+				m.lastImage(func(img *Image) { img.Alt = content })
+			case "og:title", "twitter:title":
+				if m.title == "" {
+					m.title = content
+				}
+			case "og:site_name":
+				m.siteName = content
+			case "article:published_time", "datepublished", "date", "pubdate", "publish-date":
+				m.published = content
+			case "og:locale":
+				if m.lang == "" {
+					m.lang = content
+				}
+			}
+		}
+		return true
+	})
+	if m.title == "" {
+		m.title = docTitle
+	}
+	m.title = trimSiteName(m.title, m.siteName)
+	return m
+}
+
+// addImage records a declared image; og:image:secure_url and twitter:image
+// usually repeat the og:image and are skipped when one is already known.
+func (m *pageMeta) addImage(u string) {
+	if u = strings.TrimSpace(u); u == "" {
+		return
+	}
+	if len(m.images) > 0 {
+		last := m.images[len(m.images)-1].URL
+		if last == u || strings.TrimPrefix(last, "http:") == strings.TrimPrefix(u, "https:") {
+			return
+		}
+	}
+	m.images = append(m.images, Image{URL: u})
+}
+
+// lastImage applies og:image:* properties to the image declared before them.
+func (m *pageMeta) lastImage(set func(*Image)) {
+	if len(m.images) > 0 {
+		set(&m.images[len(m.images)-1])
+	}
+}
+
+// trimSiteName removes " | Site" or " - Site" from a page title. Without a
+// known site name the tail is dropped only when at least three words remain.
+func trimSiteName(title, site string) string {
+	for _, sep := range []string{" | ", " - ", " – ", " — ", " :: "} {
+		if i := strings.LastIndex(title, sep); i > 0 {
+			tail := strings.TrimSpace(title[i+len(sep):])
+			if site != "" && strings.EqualFold(tail, site) || site == "" && utf8.RuneCountInString(tail) <= 40 && len(strings.Fields(title[:i])) >= 3 {
+				return strings.TrimSpace(title[:i])
+			}
+		}
+	}
+	return title
+}
+
+// prune removes elements that never hold article text, hidden elements and
+// containers whose class or id looks like page furniture.
+func prune(n *html.Node) {
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		if c.Type == html.CommentNode {
+			n.RemoveChild(c)
+		} else if c.Type == html.ElementNode {
+			if removed[c.DataAtom] || hidden(c) || unlikely(c) {
+				n.RemoveChild(c)
+			} else {
+				prune(c)
+			}
+		}
+		c = next
+	}
+}
+
+func hidden(n *html.Node) bool {
+	if _, ok := attrOK(n, "hidden"); ok {
+		return true
+	}
+	style := strings.ReplaceAll(strings.ToLower(attr(n, "style")), " ", "")
+	return strings.Contains(style, "display:none") || strings.Contains(style, "visibility:hidden") || attr(n, "aria-hidden") == "true"
+}
+
+func unlikely(n *html.Node) bool {
+	if n.DataAtom == atom.Body || n.DataAtom == atom.Article || n.DataAtom == atom.Main {
+		return false
+	}
+	id := attr(n, "class") + " " + attr(n, "id") + " " + attr(n, "role")
+	return unlikelyRe.MatchString(id) && !maybeRe.MatchString(id)
+}
+
+// score gives every paragraph-like node points for its length and
+// punctuation and hands them to its parent and grandparent.
+func score(root *html.Node, scores map[*html.Node]float64) {
+	walk(root, func(n *html.Node) bool {
+		if !paragraphLike(n) {
+			return true
+		}
+		text := collapse(textOf(n))
+		w := weight(text)
+		if w < minScoredWeight {
+			return false
+		}
+		points := 1 + float64(punctuation(text)) + math.Min(float64(w)/100, 3)
+		for level, anc := 0, n.Parent; anc != nil && level < 3 && anc != root.Parent; level, anc = level+1, anc.Parent {
+			if anc.Type != html.ElementNode {
+				break
+			}
+			if _, ok := scores[anc]; !ok {
+				scores[anc] = initialScore(anc)
+			}
+			scores[anc] += points / float64(level+1)
+		}
+		return false
+	})
+}
+
+func initialScore(n *html.Node) float64 {
+	s := classWeight(n)
+	switch n.DataAtom {
+	case atom.Article, atom.Main:
+		s += 10
+	case atom.Div, atom.Section:
+		s += 5
+	case atom.Pre, atom.Td, atom.Blockquote:
+		s += 3
+	case atom.Ol, atom.Ul, atom.Dl, atom.Dd, atom.Dt, atom.Li:
+		s -= 3
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Th:
+		s -= 5
+	}
+	return s
+}
+
+func classWeight(n *html.Node) float64 {
+	w := 0.0
+	for _, v := range []string{attr(n, "class"), attr(n, "id")} {
+		if v == "" {
+			continue
+		}
+		if negativeRe.MatchString(v) {
+			w -= 25
+		}
+		if positiveRe.MatchString(v) {
+			w += 25
+		}
+	}
+	if attr(n, "itemprop") == "articleBody" {
+		w += 50
+	}
+	return w
+}
+
+// withSiblings adds siblings of the best node that look like part of the
+// same article, e.g. a lead paragraph kept outside the body container.
+func withSiblings(best *html.Node, bestScore float64, scores map[*html.Node]float64) []*html.Node {
+	if best.Parent == nil || best.DataAtom == atom.Body {
+		return []*html.Node{best}
+	}
+	threshold := math.Max(10, bestScore*0.2)
+	var out []*html.Node
+	for s := best.Parent.FirstChild; s != nil; s = s.NextSibling {
+		if s.Type != html.ElementNode {
+			continue
+		}
+		switch {
+		case s == best:
+			out = append(out, s)
+		case scores[s] >= threshold:
+			out = append(out, s)
+		case s.DataAtom == atom.P:
+			text := collapse(textOf(s))
+			if w := weight(text); w > 80 && linkDensity(s) < 0.25 || w > 0 && w <= 80 && linkDensity(s) == 0 && endsSentence(text) {
+				out = append(out, s)
+			}
+		}
+	}
+	return out
+}
+
+// paragraphs returns the text blocks under n in document order.
+func paragraphs(n *html.Node) []string {
+	var out []string
+	walk(n, func(c *html.Node) bool {
+		if c.Type != html.ElementNode {
+			return true
+		}
+		switch c.DataAtom {
+		case atom.H2, atom.H3, atom.H4:
+			// subheadings are kept when short and followed by text
+			if t := collapse(textOf(c)); t != "" && utf8.RuneCountInString(t) <= 120 {
+				out = append(out, t)
+			}
+			return false
+		case atom.Ul, atom.Ol:
+			if linkDensity(c) > 0.5 {
+				return false
+			}
+		}
+		if !paragraphLike(c) {
+			return true
+		}
+		if linkDensity(c) > 0.5 || classWeight(c) < 0 {
+			return false
+		}
+		// <br><br> separated text inside one element becomes several paragraphs
+		for _, block := range strings.Split(textWithBreaks(c), "\n\n") {
+			if t := collapse(block); t != "" {
+				out = append(out, t)
+			}
+		}
+		return false
+	})
+	return out
+}
+
+// paragraphLike reports text containers: p, pre, blockquote, li, td and divs
+// without block children.
+func paragraphLike(n *html.Node) bool {
+	if n.Type != html.ElementNode {
+		return false
+	}
+	switch n.DataAtom {
+	case atom.P, atom.Pre, atom.Blockquote, atom.Li, atom.Td:
+		return true
+	case atom.Div, atom.Section, atom.Span:
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type == html.ElementNode && blockTags[c.DataAtom] {
+				return false
+			}
+		}
+		return strings.TrimSpace(textOf(n)) != ""
+	}
+	return false
+}
+
+// cleanParagraphs drops short fragments, boilerplate, the repeated title and
+// duplicates. Headings survive only when text follows them.
+func cleanParagraphs(in []string, title string) []string {
+	seen := map[string]bool{}
+	out := make([]string, 0, len(in))
+	for i, p := range in {
+		key := strings.ToLower(p)
+		if seen[key] || strings.EqualFold(p, title) || boilerplateRe.MatchString(p) {
+			continue
+		}
+		w := weight(p)
+		if w < minParagraphWeight {
+			heading := w >= 6 && !endsSentence(p) && i+1 < len(in) && weight(in[i+1]) >= minParagraphWeight
+			if !heading && !(endsSentence(p) && w >= 20) {
+				continue
+			}
+		}
+		seen[key] = true
+		out = append(out, p)
+	}
+	return out
+}
+
+// weight measures text length in Latin-letter equivalents: an Ethiopic
+// syllable stands for about two letters, so Amharic paragraphs are not
+// mistaken for fragments.
+func weight(s string) int {
+	w := 0
+	for _, r := range s {
+		switch {
+		case isEthiopic(r):
+			w += 2
+		case !unicode.IsSpace(r):
+			w++
+		}
+	}
+	return w
+}
+
+// punctuation counts clause and sentence marks, including the Ethiopic
+// comma (፣), semicolon (፤) and full stop (።).
+func punctuation(s string) int {
+	n := 0
+	for _, r := range s {
+		switch r {
+		case ',', '፣', '፤', '።', '،':
+			n++
+		}
+	}
+	return n
+}
+
+func endsSentence(s string) bool {
+	r, _ := utf8.DecodeLastRuneInString(strings.TrimRight(s, `"'”’») `))
+	switch r {
+	case '.', '!', '?', '።', '፧', '፨':
+		return true
+	}
+	return false
+}
+
+func isEthiopic(r rune) bool {
+	return unicode.Is(unicode.Ethiopic, r)
+}
+
+// detectLang prefers the script of the text, then the page's declared language.
+func detectLang(text, declared string) string {
+	letters, ethiopic := 0, 0
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			letters++
+			if isEthiopic(r) {
+				ethiopic++
+			}
+		}
+	}
+	if letters > 0 && float64(ethiopic)/float64(letters) > 0.3 {
+		return "am"
+	}
+	declared = strings.ToLower(declared)
+	if len(declared) >= 2 {
+		return declared[:2]
+	}
+	if letters > 0 {
+		return "en"
+	}
+	return ""
+}
+
+func linkDensity(n *html.Node) float64 {
+	total := weight(textOf(n))
+	if total == 0 {
+		return 0
+	}
+	linked := 0
+	walk(n, func(c *html.Node) bool {
+		if c.DataAtom == atom.A {
+			linked += weight(textOf(c))
+			return false
+		}
+		return true
+	})
+	return float64(linked) / float64(total)
+}
+
+func textOf(n *html.Node) string {
+	var b strings.Builder
+	walk(n, func(c *html.Node) bool {
+		if c.Type == html.TextNode {
+			b.WriteString(c.Data)
+			b.WriteByte(' ')
+		}
+		return true
+	})
+	return b.String()
+}
+
+// textWithBreaks renders text with a blank line for each run of two <br>s.
+func textWithBreaks(n *html.Node) string {
+	var b strings.Builder
+	breaks := 0
+	walk(n, func(c *html.Node) bool {
+		switch {
+		case c.Type == html.TextNode:
+			if strings.TrimSpace(c.Data) != "" {
+				breaks = 0
+			}
+			b.WriteString(c.Data)
+		case c.DataAtom == atom.Br:
+			breaks++
+			if breaks == 2 {
+				b.WriteString("\n\n")
+			} else {
+				b.WriteByte(' ')
+			}
+		}
+		return true
+	})
+	return b.String()
+}
+
+func collapse(s string) string {
+	return strings.Join(strings.Fields(strings.ReplaceAll(s, " ", " ")), " ")
+}
+
+// walk visits n and its descendants in document order; returning false skips
+// the children of the visited node.
+func walk(n *html.Node, visit func(*html.Node) bool) {
+	if !visit(n) {
+		return
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walk(c, visit)
+	}
+}
+
+func find(n *html.Node, a atom.Atom) *html.Node {
+	var found *html.Node
+	walk(n, func(c *html.Node) bool {
+		if found != nil {
+			return false
+		}
+		if c.DataAtom == a {
+			found = c
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+func depth(n *html.Node) int {
+	d := 0
+	for ; n != nil; n = n.Parent {
+		d++
+	}
+	return d
+}
+
+func attr(n *html.Node, key string) string {
+	v, _ := attrOK(n, key)
+	return v
+}
+
+func attrOK(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if strings.EqualFold(a.Key, key) {
+			return a.Val, true
+		}
+	}
+	return "", false
+}
+
+func firstAttr(n *html.Node, keys ...string) string {
+	for _, k := range keys {
+		if v := attr(n, k); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,94 @@
+package extractor
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// expectation describes what a correct extraction of a saved page looks
+// like. It lives next to the page in testdata as <name>.json.
+type expectation struct {
+	// URL is where the page was saved from; relative links resolve against it
+	URL   string `json:"url"`
+	Title string `json:"title"`
+	Lang  string `json:"lang"`
+	// MustContain are phrases from the article body
+	MustContain []string `json:"must_contain"`
+	// MustNotContain are phrases from navigation, comments and other boilerplate
+	MustNotContain []string `json:"must_not_contain"`
+	MinParagraphs  int      `json:"min_paragraphs"`
+	// LeadImage is the expected first image URL; Images the expected count
+	LeadImage string `json:"lead_image"`
+	Images    *int   `json:"images"`
+}
+
+// TestFixtures extracts every saved page in testdata and compares it with
+// its expectation.
+func TestFixtures(t *testing.T) {
+	pages, err := filepath.Glob(filepath.Join("testdata", "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pages) == 0 {
+		t.Fatal("no fixtures in testdata")
+	}
+	for _, page := range pages {
+		name := strings.TrimSuffix(page, ".html")
+		t.Run(filepath.Base(name), func(t *testing.T) {
+			raw, err := os.ReadFile(name + ".json")
+			if err != nil {
+				t.Fatalf("missing expectation: %v", err)
+			}
+			var want expectation
+			if err := json.Unmarshal(raw, &want); err != nil {
+				t.Fatalf("%s.json: %v", name, err)
+			}
+			f, err := os.Open(page)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			a, err := Extract(f, want.URL)
+			if err != nil {
+				t.Fatalf("extract: %v", err)
+			}
+			want.check(t, a)
+		})
+	}
+}
+
+func (want expectation) check(t *testing.T, a *Article) {
+	t.Helper()
+	if want.Title != "" && a.Title != want.Title {
+		t.Errorf("title %q, want %q", a.Title, want.Title)
+	}
+	if want.Lang != "" && a.Lang != want.Lang {
+		t.Errorf("lang %q, want %q", a.Lang, want.Lang)
+	}
+	if a.Paragraphs < want.MinParagraphs {
+		t.Errorf("%d paragraphs, want at least %d", a.Paragraphs, want.MinParagraphs)
+	}
+	lead := ""
+	if len(a.Images) > 0 {
+		lead = a.Images[0].URL
+	}
+	if want.LeadImage != "" && lead != want.LeadImage {
+		t.Errorf("lead image %q, want %q", lead, want.LeadImage)
+	}
+	if want.Images != nil && len(a.Images) != *want.Images {
+		t.Errorf("%d images, want %d", len(a.Images), *want.Images)
+	}
+	for _, s := range want.MustContain {
+		if !strings.Contains(a.Text, s) {
+			t.Errorf("missing %q", s)
+		}
+	}
+	for _, s := range want.MustNotContain {
+		if strings.Contains(a.Text, s) {
+			t.Errorf("boilerplate %q kept", s)
+		}
+	}
+}
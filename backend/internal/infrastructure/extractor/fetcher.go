@@ -0,0 +1,199 @@
+package extractor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"golang.org/x/net/html/charset"
+)
+
+const (
+	// maxPageBytes bounds the size of a fetched article page
+	maxPageBytes = 5 << 20
+	// robotsTTL is how long a host's robots.txt is trusted
+	robotsTTL = 24 * time.Hour
+	// robotsRetry is how long an unreachable robots.txt blocks the host
+	robotsRetry = 10 * time.Minute
+	// maxCrawlDelay caps a publisher's Crawl-delay so one host cannot stall ingestion
+	maxCrawlDelay    = 30 * time.Second
+	defaultUserAgent = "NewsBriefBot/1.0 (+https://github.com/RealEskalate/G6-NewsBrief)"
+)
+
+// ErrDisallowed is returned for pages the publisher's robots.txt excludes.
+var ErrDisallowed = errors.New("disallowed by robots.txt")
+
+type Fetcher struct {
+	client    *http.Client
+	userAgent string
+	interval  time.Duration
+
+	mu    sync.Mutex
+	hosts map[string]*hostState
+}
+
+// hostState serializes requests to one host and remembers its robots.txt.
+type hostState struct {
+	// slot is a one-token semaphore; the holder waits out the interval
+	slot    chan struct{}
+	next    time.Time
+	robots  *robots
+	robotMu sync.Mutex
+}
+
+// NewFetcher returns an article extractor that waits at least interval
+// between two requests to the same host (longer when robots.txt sets a
+// Crawl-delay). ARTICLE_USER_AGENT, then FEED_USER_AGENT, override the
+// User-Agent sent to publishers.
+func NewFetcher(interval time.Duration) contract.IArticleExtractor {
+	ua := os.Getenv("ARTICLE_USER_AGENT")
+	if ua == "" {
+		ua = os.Getenv("FEED_USER_AGENT")
+	}
+	if ua == "" {
+		ua = defaultUserAgent
+	}
+	return &Fetcher{
+		client:    &http.Client{Timeout: 30 * time.Second},
+		userAgent: ua,
+		interval:  interval,
+		hosts:     map[string]*hostState{},
+	}
+}
+
+func (f *Fetcher) Extract(ctx context.Context, pageURL string) (*contract.ExtractedArticle, error) {
+	u, err := url.Parse(pageURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: invalid article URL %q", contract.ErrInvalidInput, pageURL)
+	}
+	host := f.host(u.Host)
+	rb := f.robots(ctx, host, u)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if !rb.allowed(u.EscapedPath() + queryPart(u)) {
+		return nil, fmt.Errorf("%s: %w", pageURL, ErrDisallowed)
+	}
+
+	resp, err := f.get(ctx, host, rb, pageURL, "text/html,application/xhtml+xml;q=0.9,*/*;q=0.5")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("article status %d", resp.StatusCode)
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if mt, _, _ := mime.ParseMediaType(contentType); mt != "" && mt != "text/html" && mt != "application/xhtml+xml" {
+		return nil, fmt.Errorf("article content type %q is not HTML", mt)
+	}
+	body, err := charset.NewReader(io.LimitReader(resp.Body, maxPageBytes), contentType)
+	if err != nil {
+		return nil, err
+	}
+	// relative links resolve against the final URL after redirects
+	a, err := Extract(body, resp.Request.URL.String())
+	if err != nil {
+		return nil, err
+	}
+	out := &contract.ExtractedArticle{Title: a.Title, Text: a.Text, Lang: a.Lang, SiteName: a.SiteName, Published: a.Published}
+	for _, img := range a.Images {
+		out.Images = append(out.Images, contract.ImageRef{URL: img.URL, Width: img.Width, Height: img.Height, Alt: img.Alt})
+	}
+	return out, nil
+}
+
+func (f *Fetcher) host(name string) *hostState {
+	name = strings.ToLower(name)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	h := f.hosts[name]
+	if h == nil {
+		h = &hostState{slot: make(chan struct{}, 1)}
+		f.hosts[name] = h
+	}
+	return h
+}
+
+// robots returns the cached rules for the page's host, fetching robots.txt
+// when missing or stale. Following RFC 9309, a missing robots.txt (4xx)
+// allows everything and an unreachable one (network error or 5xx) disallows
+// everything until it is retried shortly after; a 401/403 also disallows
+// everything, as crawlers commonly do.
+func (f *Fetcher) robots(ctx context.Context, h *hostState, page *url.URL) *robots {
+	h.robotMu.Lock()
+	defer h.robotMu.Unlock()
+	if h.robots != nil && time.Now().Before(h.robots.expires) {
+		return h.robots
+	}
+	disallowAll := &robots{rules: []robotsRule{{pattern: "/"}}}
+	rb, ttl := disallowAll, robotsRetry
+	robotsURL := (&url.URL{Scheme: page.Scheme, Host: page.Host, Path: "/robots.txt"}).String()
+	resp, err := f.get(ctx, h, nil, robotsURL, "text/plain")
+	if err == nil {
+		switch {
+		case resp.StatusCode >= 200 && resp.StatusCode < 300:
+			rb, ttl = parseRobots(resp.Body, f.userAgent), robotsTTL
+		case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+			ttl = robotsTTL
+		case resp.StatusCode < 500:
+			rb, ttl = &robots{}, robotsTTL
+		}
+		resp.Body.Close()
+	}
+	if ctx.Err() != nil && err != nil {
+		// a cancelled lookup is not cached
+		return rb
+	}
+	rb.expires = time.Now().Add(ttl)
+	h.robots = rb
+	return rb
+}
+
+// get sends one request once the host's interval has passed.
+func (f *Fetcher) get(ctx context.Context, h *hostState, rb *robots, target, accept string) (*http.Response, error) {
+	select {
+	case h.slot <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-h.slot }()
+	if wait := time.Until(h.next); wait > 0 {
+		t := time.NewTimer(wait)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		}
+	}
+	gap := f.interval
+	if rb != nil && rb.crawlDelay > gap {
+		gap = min(rb.crawlDelay, maxCrawlDelay)
+	}
+	defer func() { h.next = time.Now().Add(gap) }()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", f.userAgent)
+	req.Header.Set("Accept", accept)
+	return f.client.Do(req)
+}
+
+func queryPart(u *url.URL) string {
+	if u.RawQuery == "" {
+		return ""
+	}
+	return "?" + u.RawQuery
+}
@@ -0,0 +1,149 @@
+package extractor
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Image is a picture of the article. Zero dimensions are unknown.
+type Image struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width,omitempty"`
+	Height int    `json:"height,omitempty"`
+	Alt    string `json:"alt,omitempty"`
+}
+
+const (
+	// maxImages caps the lead image plus gallery kept per article
+	maxImages = 10
+	// minImageSide drops icons and tracking pixels whose size is declared
+	minImageSide = 150
+)
+
+// decorativeRe matches image URLs that are site furniture rather than news photos.
+var decorativeRe = regexp.MustCompile(`(?i)logo|icon|avatar|sprite|pixel|spacer|blank\.|badge|emoji|gravatar|/ads?/|banner|placeholder|loading`)
+
+// collectImages returns the lead image first: og:image (or twitter:image)
+// when declared, otherwise the first picture of the article body. Pictures
+// inside the content and figures next to it make up the gallery.
+func collectImages(meta pageMeta, content []*html.Node, best *html.Node, base *url.URL) []Image {
+	var out []Image
+	seen := map[string]bool{}
+	add := func(img Image) {
+		img.URL = resolveImage(base, img.URL)
+		if img.URL == "" || seen[img.URL] || len(out) >= maxImages {
+			return
+		}
+		if decorativeRe.MatchString(img.URL) {
+			return
+		}
+		if img.Width > 0 && img.Width < minImageSide || img.Height > 0 && img.Height < minImageSide {
+			return
+		}
+		seen[img.URL] = true
+		out = append(out, img)
+	}
+	for _, img := range meta.images {
+		add(img)
+	}
+
+	var nodes []*html.Node
+	if best != nil && best.Parent != nil {
+		// figures beside the body container usually hold the lead photo
+		for s := best.Parent.FirstChild; s != nil && s != best; s = s.NextSibling {
+			if s.DataAtom == atom.Figure || s.DataAtom == atom.Img || s.DataAtom == atom.Picture {
+				nodes = append(nodes, s)
+			}
+		}
+	}
+	nodes = append(nodes, content...)
+	for _, n := range nodes {
+		walk(n, func(c *html.Node) bool {
+			if c.DataAtom == atom.Img {
+				add(imageOf(c))
+				return false
+			}
+			return true
+		})
+	}
+	return out
+}
+
+// imageOf reads an <img>, preferring the lazy-loading attributes and the
+// largest srcset candidate over a placeholder src.
+func imageOf(n *html.Node) Image {
+	src := strings.TrimSpace(attr(n, "src"))
+	for _, key := range []string{"data-src", "data-lazy-src", "data-original", "data-url"} {
+		if v := strings.TrimSpace(attr(n, key)); v != "" && (src == "" || strings.HasPrefix(src, "data:") || decorativeRe.MatchString(src)) {
+			src = v
+			break
+		}
+	}
+	width, height := atoi(attr(n, "width")), atoi(attr(n, "height"))
+	if best, w := largestSrcset(firstAttr(n, "srcset", "data-srcset")); best != "" && (w > width || src == "" || strings.HasPrefix(src, "data:")) {
+		if width > 0 && height > 0 && w > 0 {
+			height = height * w / width
+		}
+		src = best
+		if w > 0 {
+			width = w
+		}
+	}
+	if strings.HasPrefix(src, "data:") {
+		src = ""
+	}
+	return Image{URL: src, Width: width, Height: height, Alt: collapse(attr(n, "alt"))}
+}
+
+// largestSrcset picks the widest "url 640w" candidate of a srcset.
+func largestSrcset(srcset string) (string, int) {
+	best, bestW := "", 0
+	for _, cand := range strings.Split(srcset, ",") {
+		fields := strings.Fields(cand)
+		if len(fields) == 0 {
+			continue
+		}
+		w := 0
+		if len(fields) > 1 && strings.HasSuffix(fields[1], "w") {
+			w = atoi(strings.TrimSuffix(fields[1], "w"))
+		}
+		if best == "" || w > bestW {
+			best, bestW = fields[0], w
+		}
+	}
+	return best, bestW
+}
+
+func resolveImage(base *url.URL, raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || strings.HasPrefix(raw, "data:") {
+		return ""
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	if base != nil {
+		u = base.ResolveReference(u)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ""
+	}
+	if strings.HasSuffix(strings.ToLower(u.Path), ".svg") {
+		return ""
+	}
+	return u.String()
+}
+
+func atoi(s string) int {
+	n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(s), "px"))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,142 @@
+package extractor
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// robots holds the rules of one robots.txt group that apply to our agent.
+type robots struct {
+	rules      []robotsRule
+	crawlDelay time.Duration
+	// expires is when robots.txt is fetched again
+	expires time.Time
+}
+
+type robotsRule struct {
+	allow   bool
+	pattern string
+}
+
+// parseRobots reads a robots.txt and keeps the group for agent, falling back
+// to the "*" group. agent is matched case-insensitively against the product
+// token, e.g. "NewsBriefBot" for "NewsBriefBot/1.0 (+url)".
+func parseRobots(r io.Reader, agent string) *robots {
+	agent = strings.ToLower(agent)
+	if i := strings.IndexAny(agent, "/ "); i > 0 {
+		agent = agent[:i]
+	}
+	type group struct {
+		agents []string
+		rb     robots
+	}
+	var (
+		groups  []*group
+		current *group
+		// consecutive user-agent lines share one group
+		inAgents bool
+	)
+	sc := bufio.NewScanner(io.LimitReader(r, 512<<10))
+	for sc.Scan() {
+		line := sc.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		switch key {
+		case "user-agent":
+			if !inAgents || current == nil {
+				current = &group{}
+				groups = append(groups, current)
+			}
+			current.agents = append(current.agents, strings.ToLower(value))
+			inAgents = true
+		case "allow", "disallow":
+			inAgents = false
+			if current == nil {
+				continue
+			}
+			// an empty Disallow allows everything
+			if value == "" {
+				continue
+			}
+			current.rb.rules = append(current.rb.rules, robotsRule{allow: key == "allow", pattern: value})
+		case "crawl-delay":
+			inAgents = false
+			if current == nil {
+				continue
+			}
+			if secs, err := strconv.ParseFloat(value, 64); err == nil && secs > 0 {
+				current.rb.crawlDelay = time.Duration(secs * float64(time.Second))
+			}
+		default:
+			inAgents = false
+		}
+	}
+
+	var fallback *robots
+	for _, g := range groups {
+		for _, a := range g.agents {
+			if a == "*" {
+				if fallback == nil {
+					fallback = &g.rb
+				}
+			} else if agent != "" && strings.Contains(agent, a) {
+				return &g.rb
+			}
+		}
+	}
+	if fallback != nil {
+		return fallback
+	}
+	return &robots{}
+}
+
+// allowed applies the longest matching rule; on a tie Allow wins.
+func (rb *robots) allowed(path string) bool {
+	if rb == nil {
+		return true
+	}
+	best, allow := -1, true
+	for _, rule := range rb.rules {
+		if !matchRobots(rule.pattern, path) {
+			continue
+		}
+		if n := len(rule.pattern); n > best || n == best && rule.allow {
+			best, allow = n, rule.allow
+		}
+	}
+	return allow
+}
+
+// matchRobots matches a robots.txt path pattern, where * matches any run of
+// characters and a trailing $ anchors the end of the path.
+func matchRobots(pattern, path string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+	rest := path[len(parts[0]):]
+	for i, part := range parts[1:] {
+		last := i == len(parts)-2
+		if last && anchored {
+			return strings.HasSuffix(rest, part)
+		}
+		j := strings.Index(rest, part)
+		if j < 0 {
+			return false
+		}
+		rest = rest[j+len(part):]
+	}
+	return !anchored || rest == ""
+}
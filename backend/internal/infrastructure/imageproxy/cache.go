@@ -0,0 +1,83 @@
+package imageproxy
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// grow records bytes written and evicts the least recently served files once
+// the cache exceeds its limit, down to 90% of it.
+func (p *Proxy) grow(n int64) {
+	if p.opts.MaxCacheBytes <= 0 {
+		return
+	}
+	p.mu.Lock()
+	if p.size < 0 {
+		p.mu.Unlock()
+		total, _ := p.scan()
+		p.mu.Lock()
+		p.size = sum(total)
+	} else {
+		p.size += n
+	}
+	full := p.size > p.opts.MaxCacheBytes
+	p.mu.Unlock()
+	if full {
+		p.evict()
+	}
+}
+
+type cachedFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+func (p *Proxy) scan() ([]cachedFile, error) {
+	var files []cachedFile
+	err := filepath.WalkDir(p.opts.Dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || strings.HasPrefix(d.Name(), ".tmp-") {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		files = append(files, cachedFile{path: path, size: info.Size(), modTime: info.ModTime()})
+		return nil
+	})
+	return files, err
+}
+
+func (p *Proxy) evict() {
+	files, err := p.scan()
+	if err != nil {
+		return
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.Before(files[j].modTime) })
+	total := sum(files)
+	target := p.opts.MaxCacheBytes / 10 * 9
+	for _, f := range files {
+		if total <= target {
+			break
+		}
+		if os.Remove(f.path) == nil {
+			total -= f.size
+		}
+	}
+	p.mu.Lock()
+	p.size = total
+	p.mu.Unlock()
+}
+
+func sum(files []cachedFile) int64 {
+	var total int64
+	for _, f := range files {
+		total += f.size
+	}
+	return total
+}
@@ -0,0 +1,323 @@
+// Package imageproxy downloads publisher images into a local disk cache and
+// serves them scaled down to a small set of widths.
+package imageproxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+)
+
+const (
+	// maxImageBytes bounds a downloaded original
+	maxImageBytes = 10 << 20
+	// failureTTL keeps a failed URL from being fetched again for a while
+	failureTTL       = 10 * time.Minute
+	defaultUserAgent = "NewsBriefBot/1.0 (+https://github.com/RealEskalate/G6-NewsBrief)"
+)
+
+// widths are the sizes served; a requested width is rounded up to the next one
+// so the cache holds a bounded number of variants per image.
+var widths = []int{160, 320, 480, 640, 960, 1280, 1920}
+
+// Options configure a Proxy.
+type Options struct {
+	// Dir holds the cache; originals and each width live in their own subdirectory
+	Dir string
+	// MaxCacheBytes triggers eviction of the least recently served files (0 = unbounded)
+	MaxCacheBytes int64
+	// AllowPrivateHosts permits images on loopback and private networks (development only)
+	AllowPrivateHosts bool
+	UserAgent         string
+}
+
+type Proxy struct {
+	opts   Options
+	client *http.Client
+
+	mu       sync.Mutex
+	inflight map[string]*call
+	failed   map[string]time.Time
+	// size is the cache size in bytes, -1 until first measured
+	size int64
+}
+
+type call struct {
+	done chan struct{}
+	img  *contract.ProxiedImage
+	err  error
+}
+
+// New creates the cache directory and returns the proxy.
+func New(opts Options) (contract.IImageProxy, error) {
+	if opts.Dir == "" {
+		return nil, errors.New("imageproxy: cache directory required")
+	}
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, err
+	}
+	if opts.UserAgent == "" {
+		opts.UserAgent = defaultUserAgent
+	}
+	p := &Proxy{opts: opts, inflight: map[string]*call{}, failed: map[string]time.Time{}, size: -1}
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	if !opts.AllowPrivateHosts {
+		dialer.Control = refusePrivate
+	}
+	p.client = &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: &http.Transport{DialContext: dialer.DialContext, MaxIdleConnsPerHost: 4},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return errors.New("too many redirects")
+			}
+			return nil
+		},
+	}
+	return p, nil
+}
+
+// NewFromEnv reads IMAGE_CACHE_DIR (default <tmp>/newsbrief-images),
+// IMAGE_CACHE_MAX_MB (default 1024), IMAGE_PROXY_ALLOW_PRIVATE and
+// ARTICLE_USER_AGENT / FEED_USER_AGENT.
+func NewFromEnv() (contract.IImageProxy, error) {
+	opts := Options{
+		Dir:               os.Getenv("IMAGE_CACHE_DIR"),
+		MaxCacheBytes:     1024 << 20,
+		AllowPrivateHosts: os.Getenv("IMAGE_PROXY_ALLOW_PRIVATE") == "true",
+		UserAgent:         os.Getenv("ARTICLE_USER_AGENT"),
+	}
+	if opts.Dir == "" {
+		opts.Dir = filepath.Join(os.TempDir(), "newsbrief-images")
+	}
+	if v, err := strconv.ParseInt(os.Getenv("IMAGE_CACHE_MAX_MB"), 10, 64); err == nil && v >= 0 {
+		opts.MaxCacheBytes = v << 20
+	}
+	if opts.UserAgent == "" {
+		opts.UserAgent = os.Getenv("FEED_USER_AGENT")
+	}
+	return New(opts)
+}
+
+func (p *Proxy) Get(ctx context.Context, rawURL string, width int) (*contract.ProxiedImage, error) {
+	orig, err := p.original(ctx, rawURL)
+	if err != nil || width <= 0 {
+		return orig, err
+	}
+	width = bucket(width)
+	key := cacheKey(rawURL)
+	path := filepath.Join(p.opts.Dir, "w"+strconv.Itoa(width), key)
+	if img, err := p.readCached(path); err == nil {
+		return img, nil
+	}
+	return p.once(ctx, "w"+strconv.Itoa(width)+"/"+key, func() (*contract.ProxiedImage, error) {
+		data, contentType, err := resize(orig.Data, width)
+		if err != nil {
+			// formats we cannot decode (e.g. WebP) are served as they are
+			return orig, nil
+		}
+		if data == nil {
+			return orig, nil
+		}
+		if err := p.write(path, data); err != nil {
+			return nil, err
+		}
+		return &contract.ProxiedImage{Data: data, ContentType: contentType, ETag: etag(key, width), ModTime: time.Now().UTC()}, nil
+	})
+}
+
+func (p *Proxy) Probe(ctx context.Context, rawURL string) (int, int, error) {
+	orig, err := p.original(ctx, rawURL)
+	if err != nil {
+		return 0, 0, err
+	}
+	w, h, err := dimensions(orig.Data)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", contract.ErrNotFound, err)
+	}
+	return w, h, nil
+}
+
+// original returns the cached original, downloading it on a miss. Concurrent
+// requests for one URL share a single download.
+func (p *Proxy) original(ctx context.Context, rawURL string) (*contract.ProxiedImage, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: invalid image URL", contract.ErrInvalidInput)
+	}
+	key := cacheKey(rawURL)
+	path := filepath.Join(p.opts.Dir, "orig", key)
+	if img, err := p.readCached(path); err == nil {
+		return img, nil
+	}
+	p.mu.Lock()
+	if t, ok := p.failed[key]; ok && time.Since(t) < failureTTL {
+		p.mu.Unlock()
+		return nil, fmt.Errorf("%w: image unavailable", contract.ErrNotFound)
+	}
+	p.mu.Unlock()
+	return p.once(ctx, "orig/"+key, func() (*contract.ProxiedImage, error) {
+		// the download is shared, so one caller giving up must not cancel it
+		dctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+		defer cancel()
+		data, err := p.download(dctx, rawURL)
+		if err != nil {
+			p.mu.Lock()
+			for k, t := range p.failed {
+				if time.Since(t) >= failureTTL {
+					delete(p.failed, k)
+				}
+			}
+			p.failed[key] = time.Now()
+			p.mu.Unlock()
+			return nil, err
+		}
+		if err := p.write(path, data); err != nil {
+			return nil, err
+		}
+		return &contract.ProxiedImage{Data: data, ContentType: http.DetectContentType(data), ETag: etag(key, 0), ModTime: time.Now().UTC()}, nil
+	})
+}
+
+func (p *Proxy) download(ctx context.Context, rawURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", p.opts.UserAgent)
+	req.Header.Set("Accept", "image/jpeg,image/png,image/gif,image/webp;q=0.9,*/*;q=0.5")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", contract.ErrNotFound, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%w: image status %d", contract.ErrNotFound, resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImageBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxImageBytes {
+		return nil, fmt.Errorf("%w: image larger than %d bytes", contract.ErrNotFound, maxImageBytes)
+	}
+	// the body decides, not the declared type; SVG and HTML are never served
+	switch http.DetectContentType(data) {
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+		return data, nil
+	}
+	return nil, fmt.Errorf("%w: not a raster image", contract.ErrNotFound)
+}
+
+// once runs fn in the background, shared by every caller with the same key.
+// Callers stop waiting when their ctx ends; the work itself carries on and
+// its result lands in the cache.
+func (p *Proxy) once(ctx context.Context, key string, fn func() (*contract.ProxiedImage, error)) (*contract.ProxiedImage, error) {
+	p.mu.Lock()
+	c, ok := p.inflight[key]
+	if !ok {
+		c = &call{done: make(chan struct{})}
+		p.inflight[key] = c
+		go func() {
+			c.img, c.err = fn()
+			p.mu.Lock()
+			delete(p.inflight, key)
+			p.mu.Unlock()
+			close(c.done)
+		}()
+	}
+	p.mu.Unlock()
+	select {
+	case <-c.done:
+		return c.img, c.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// readCached returns a cached file and marks it as recently used.
+func (p *Proxy) readCached(path string) (*contract.ProxiedImage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+	key, width := filepath.Base(path), 0
+	if dir := filepath.Base(filepath.Dir(path)); dir != "orig" {
+		width, _ = strconv.Atoi(dir[1:])
+	}
+	return &contract.ProxiedImage{Data: data, ContentType: http.DetectContentType(data), ETag: etag(key, width), ModTime: now.UTC()}, nil
+}
+
+// write stores a file atomically and evicts old files when the cache is full.
+func (p *Proxy) write(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(tmp, bytes.NewReader(data)); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	p.grow(int64(len(data)))
+	return nil
+}
+
+func cacheKey(rawURL string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	return hex.EncodeToString(sum[:])
+}
+
+func etag(key string, width int) string {
+	return fmt.Sprintf(`"%s-%d"`, key[:16], width)
+}
+
+func bucket(width int) int {
+	for _, w := range widths {
+		if width <= w {
+			return w
+		}
+	}
+	return widths[len(widths)-1]
+}
+
+// refusePrivate keeps image URLs from reaching the server's own network.
+func refusePrivate(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() || ip.IsMulticast() {
+		return fmt.Errorf("image host %s is not public", host)
+	}
+	return nil
+}
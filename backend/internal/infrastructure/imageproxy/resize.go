@@ -0,0 +1,120 @@
+package imageproxy
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+)
+
+// maxPixels refuses to decode images that would take too much memory.
+const maxPixels = 40_000_000
+
+// resize scales data down to width, keeping the aspect ratio. It returns nil
+// data when the image is already narrow enough. PNG and GIF become PNG so
+// transparency survives; everything else becomes JPEG.
+func resize(data []byte, width int) ([]byte, string, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+	if cfg.Width <= width {
+		return nil, "", nil
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, "", errors.New("image too large to resize")
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+	height := cfg.Height * width / cfg.Width
+	if height < 1 {
+		height = 1
+	}
+	dst := scaleDown(src, width, height)
+
+	var buf bytes.Buffer
+	if format == "png" || format == "gif" {
+		enc := png.Encoder{CompressionLevel: png.BestSpeed}
+		if err := enc.Encode(&buf, dst); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), "image/png", nil
+	}
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 82}); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), "image/jpeg", nil
+}
+
+// scaleDown averages the source pixels covered by each destination pixel
+// (an area filter), which avoids the aliasing of nearest-neighbour sampling.
+func scaleDown(src image.Image, width, height int) *image.RGBA {
+	b := src.Bounds()
+	rgba, ok := src.(*image.RGBA)
+	if !ok || b.Min != (image.Point{}) {
+		rgba = image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+		draw.Draw(rgba, rgba.Bounds(), src, b.Min, draw.Src)
+	}
+	sw, sh := b.Dx(), b.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0, y1 := y*sh/height, (y+1)*sh/height
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < width; x++ {
+			x0, x1 := x*sw/width, (x+1)*sw/width
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+			var r, g, bl, a, n uint32
+			for sy := y0; sy < y1; sy++ {
+				row := rgba.Pix[sy*rgba.Stride+x0*4 : sy*rgba.Stride+x1*4]
+				for i := 0; i < len(row); i += 4 {
+					r += uint32(row[i])
+					g += uint32(row[i+1])
+					bl += uint32(row[i+2])
+					a += uint32(row[i+3])
+					n++
+				}
+			}
+			o := y*dst.Stride + x*4
+			dst.Pix[o], dst.Pix[o+1], dst.Pix[o+2], dst.Pix[o+3] = uint8(r/n), uint8(g/n), uint8(bl/n), uint8(a/n)
+		}
+	}
+	return dst
+}
+
+// dimensions reads the pixel size from the image header. WebP is parsed here
+// since the standard library has no WebP decoder.
+func dimensions(data []byte) (int, int, error) {
+	if cfg, _, err := image.DecodeConfig(bytes.NewReader(data)); err == nil {
+		return cfg.Width, cfg.Height, nil
+	}
+	if len(data) < 30 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return 0, 0, errors.New("unknown image format")
+	}
+	switch string(data[12:16]) {
+	case "VP8 ":
+		// lossy: 14-bit sizes after the 3-byte frame tag and start code
+		w := int(binary.LittleEndian.Uint16(data[26:28]) & 0x3fff)
+		h := int(binary.LittleEndian.Uint16(data[28:30]) & 0x3fff)
+		return w, h, nil
+	case "VP8L":
+		// lossless: 14-bit width-1 and height-1 after the signature byte
+		bits := binary.LittleEndian.Uint32(data[21:25])
+		return int(bits&0x3fff) + 1, int(bits>>14&0x3fff) + 1, nil
+	case "VP8X":
+		// extended: 24-bit canvas width-1 and height-1
+		w := int(data[24]) | int(data[25])<<8 | int(data[26])<<16
+		h := int(data[27]) | int(data[28])<<8 | int(data[29])<<16
+		return w + 1, h + 1, nil
+	}
+	return 0, 0, errors.New("unknown WebP format")
+}
@@ -0,0 +1,68 @@
+package prompts
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+//go:embed defaults.json
+var defaultPrompts []byte
+
+type builtinPrompt struct {
+	Name      string   `json:"name"`
+	Language  string   `json:"language"`
+	Version   int      `json:"version"`
+	Text      string   `json:"text"`
+	Variables []string `json:"variables"`
+	Required  []string `json:"required"`
+	Untrusted []string `json:"untrusted"`
+}
+
+// Defaults returns the prompt templates shipped with the binary. Every
+// placeholder they use must be declared, so a broken file fails at startup.
+func Defaults() ([]entity.PromptTemplate, error) {
+	var raw []builtinPrompt
+	if err := json.Unmarshal(defaultPrompts, &raw); err != nil {
+		return nil, fmt.Errorf("built-in prompts: %w", err)
+	}
+	out := make([]entity.PromptTemplate, 0, len(raw))
+	for _, p := range raw {
+		t := entity.PromptTemplate{
+			ID:        fmt.Sprintf("builtin:%s:%s", p.Name, p.Language),
+			Name:      p.Name,
+			Language:  p.Language,
+			Version:   p.Version,
+			Text:      p.Text,
+			Origin:    entity.PromptBuiltin,
+			Active:    true,
+			Weight:    100,
+			Variables: p.Variables,
+			Required:  p.Required,
+			Untrusted: p.Untrusted,
+		}
+		for _, v := range entity.PromptPlaceholders(t.Text) {
+			if !contains(t.Variables, v) {
+				return nil, fmt.Errorf("built-in prompt %s uses undeclared variable %q", t.Name, v)
+			}
+		}
+		for _, v := range t.Untrusted {
+			if !contains(t.Variables, v) {
+				return nil, fmt.Errorf("built-in prompt %s marks undeclared variable %q as untrusted", t.Name, v)
+			}
+		}
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type APIKeyRepository struct {
+	col *mongo.Collection
+}
+
+func NewAPIKeyRepository(col *mongo.Collection) contract.IAPIKeyRepository {
+	r := &APIKeyRepository{col: col}
+	_, _ = r.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "prefix", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return r
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, k *entity.APIKey) error {
+	_, err := r.col.InsertOne(ctx, k)
+	return err
+}
+
+func (r *APIKeyRepository) FindByID(ctx context.Context, id string) (*entity.APIKey, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+func (r *APIKeyRepository) FindByPrefix(ctx context.Context, prefix string) (*entity.APIKey, error) {
+	return r.findOne(ctx, bson.M{"prefix": prefix})
+}
+
+func (r *APIKeyRepository) findOne(ctx context.Context, filter bson.M) (*entity.APIKey, error) {
+	var k entity.APIKey
+	if err := r.col.FindOne(ctx, filter).Decode(&k); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, contract.ErrNotFound
+		}
+		return nil, err
+	}
+	return &k, nil
+}
+
+func (r *APIKeyRepository) List(ctx context.Context, includeRevoked bool) ([]*entity.APIKey, error) {
+	filter := bson.M{}
+	if !includeRevoked {
+		filter["revoked_at"] = bson.M{"$exists": false}
+	}
+	cur, err := r.col.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	keys := []*entity.APIKey{}
+	if err := cur.All(ctx, &keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (r *APIKeyRepository) Revoke(ctx context.Context, id string, at time.Time) error {
+	return r.update(ctx, id, bson.M{"revoked_at": at})
+}
+
+func (r *APIKeyRepository) MarkRotated(ctx context.Context, id, rotatedTo string, expiresAt time.Time) error {
+	return r.update(ctx, id, bson.M{"rotated_to": rotatedTo, "expires_at": expiresAt})
+}
+
+func (r *APIKeyRepository) TouchLastUsed(ctx context.Context, id string, at time.Time, ip string) error {
+	return r.update(ctx, id, bson.M{"last_used_at": at, "last_used_ip": ip})
+}
+
+func (r *APIKeyRepository) update(ctx context.Context, id string, set bson.M) error {
+	res, err := r.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": set})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return contract.ErrNotFound
+	}
+	return nil
+}
+
+// ReplayGuard stores the nonces of signed requests until they expire.
+type ReplayGuard struct {
+	col *mongo.Collection
+}
+
+func NewReplayGuard(col *mongo.Collection) contract.IReplayGuard {
+	g := &ReplayGuard{col: col}
+	_, _ = g.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	return g
+}
+
+func (g *ReplayGuard) Claim(ctx context.Context, nonce string, expiresAt time.Time) (bool, error) {
+	_, err := g.col.InsertOne(ctx, bson.M{"_id": nonce, "expires_at": expiresAt})
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
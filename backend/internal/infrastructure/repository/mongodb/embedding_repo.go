@@ -0,0 +1,173 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/vectorindex"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// fallbackScanLimit caps how many recent vectors the in-process scan loads.
+const fallbackScanLimit = 5000
+
+// EmbeddingRepository stores news embeddings in Mongo. When an Atlas Vector Search
+// index name is configured, queries use $vectorSearch; otherwise (or if that stage
+// fails, e.g. on a self-hosted server) recent vectors are ranked in-process.
+type EmbeddingRepository struct {
+	col           *mongo.Collection
+	atlasIndex    string
+	numCandidates int
+}
+
+func NewEmbeddingRepository(col *mongo.Collection, atlasIndex string) contract.IVectorIndex {
+	r := &EmbeddingRepository{col: col, atlasIndex: atlasIndex, numCandidates: 200}
+	_, _ = r.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "published_at", Value: -1}},
+	})
+	return r
+}
+
+func (r *EmbeddingRepository) Upsert(ctx context.Context, e *entity.NewsEmbedding) error {
+	e.ID = e.NewsID
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now().UTC()
+	}
+	_, err := r.col.ReplaceOne(ctx, bson.M{"_id": e.ID}, e, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (r *EmbeddingRepository) Search(ctx context.Context, vector []float32, k int, filter contract.VectorFilter) ([]contract.VectorMatch, error) {
+	if k <= 0 {
+		k = 5
+	}
+	if r.atlasIndex != "" {
+		if matches, err := r.atlasSearch(ctx, vector, k, filter); err == nil {
+			return matches, nil
+		}
+	}
+	return r.scan(ctx, vector, k, filter)
+}
+
+func (r *EmbeddingRepository) Get(ctx context.Context, newsID string) (*entity.NewsEmbedding, error) {
+	var e entity.NewsEmbedding
+	if err := r.col.FindOne(ctx, bson.M{"_id": newsID}).Decode(&e); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, contract.ErrNotFound
+		}
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *EmbeddingRepository) ExistingIDs(ctx context.Context, newsIDs []string) (map[string]bool, error) {
+	out := make(map[string]bool, len(newsIDs))
+	if len(newsIDs) == 0 {
+		return out, nil
+	}
+	cur, err := r.col.Find(ctx, bson.M{"_id": bson.M{"$in": newsIDs}}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var row struct {
+			ID string `bson:"_id"`
+		}
+		if err := cur.Decode(&row); err != nil {
+			return nil, err
+		}
+		out[row.ID] = true
+	}
+	return out, cur.Err()
+}
+
+// atlasSearch runs an approximate nearest-neighbour query on Atlas. Filtering happens
+// after the vector stage so no filter fields need to be declared on the search index.
+func (r *EmbeddingRepository) atlasSearch(ctx context.Context, vector []float32, k int, filter contract.VectorFilter) ([]contract.VectorMatch, error) {
+	// over-fetch to leave room for post-filtering
+	limit := k * 4
+	pipeline := mongo.Pipeline{
+		{{Key: "$vectorSearch", Value: bson.M{
+			"index":         r.atlasIndex,
+			"path":          "vector",
+			"queryVector":   vector,
+			"numCandidates": r.numCandidates,
+			"limit":         limit,
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"news_id": 1, "title": 1, "source_url": 1, "published_at": 1,
+			"score": bson.M{"$meta": "vectorSearchScore"},
+		}}},
+	}
+	cur, err := r.col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	excluded := make(map[string]struct{}, len(filter.ExcludeNewsIDs))
+	for _, id := range filter.ExcludeNewsIDs {
+		excluded[id] = struct{}{}
+	}
+	matches := []contract.VectorMatch{}
+	for cur.Next(ctx) {
+		var row struct {
+			NewsID      string    `bson:"news_id"`
+			Title       string    `bson:"title"`
+			SourceURL   string    `bson:"source_url"`
+			PublishedAt time.Time `bson:"published_at"`
+			Score       float64   `bson:"score"`
+		}
+		if err := cur.Decode(&row); err != nil {
+			return nil, err
+		}
+		if _, skip := excluded[row.NewsID]; skip {
+			continue
+		}
+		if !filter.PublishedAfter.IsZero() && row.PublishedAt.Before(filter.PublishedAfter) {
+			continue
+		}
+		if !filter.PublishedBefore.IsZero() && row.PublishedAt.After(filter.PublishedBefore) {
+			continue
+		}
+		if filter.ExcludeSourceURL != "" && row.SourceURL == filter.ExcludeSourceURL {
+			continue
+		}
+		matches = append(matches, contract.VectorMatch{NewsID: row.NewsID, Title: row.Title, SourceURL: row.SourceURL, PublishedAt: row.PublishedAt, Score: row.Score})
+		if len(matches) == k {
+			break
+		}
+	}
+	return matches, cur.Err()
+}
+
+// scan loads the most recent vectors matching the date filter and ranks them in-process.
+func (r *EmbeddingRepository) scan(ctx context.Context, vector []float32, k int, filter contract.VectorFilter) ([]contract.VectorMatch, error) {
+	q := bson.M{}
+	window := bson.M{}
+	if !filter.PublishedAfter.IsZero() {
+		window["$gte"] = filter.PublishedAfter
+	}
+	if !filter.PublishedBefore.IsZero() {
+		window["$lte"] = filter.PublishedBefore
+	}
+	if len(window) > 0 {
+		q["published_at"] = window
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "published_at", Value: -1}}).SetLimit(fallbackScanLimit)
+	cur, err := r.col.Find(ctx, q, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	var candidates []entity.NewsEmbedding
+	if err := cur.All(ctx, &candidates); err != nil {
+		return nil, err
+	}
+	return vectorindex.Rank(vector, candidates, k, filter), nil
+}
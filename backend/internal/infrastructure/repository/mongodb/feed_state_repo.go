@@ -0,0 +1,53 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type FeedStateRepository struct {
+	col *mongo.Collection
+}
+
+func NewFeedStateRepository(col *mongo.Collection) contract.IFeedStateRepository {
+	r := &FeedStateRepository{col: col}
+	_, _ = r.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "source_id", Value: 1}},
+	})
+	return r
+}
+
+func (r *FeedStateRepository) Get(ctx context.Context, url string) (*entity.FeedState, error) {
+	var s entity.FeedState
+	if err := r.col.FindOne(ctx, bson.M{"_id": url}).Decode(&s); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, contract.ErrNotFound
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *FeedStateRepository) Save(ctx context.Context, s *entity.FeedState) error {
+	_, err := r.col.ReplaceOne(ctx, bson.M{"_id": s.URL}, s, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (r *FeedStateRepository) List(ctx context.Context) ([]*entity.FeedState, error) {
+	cur, err := r.col.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "source_id", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	states := []*entity.FeedState{}
+	if err := cur.All(ctx, &states); err != nil {
+		return nil, err
+	}
+	return states, nil
+}
@@ -0,0 +1,84 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type IngestionPreviewRepository struct {
+	col *mongo.Collection
+}
+
+func NewIngestionPreviewRepository(col *mongo.Collection) contract.IIngestionPreviewRepository {
+	r := &IngestionPreviewRepository{col: col}
+	// previews are dropped once expires_at passes
+	_, _ = r.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	return r
+}
+
+func (r *IngestionPreviewRepository) Create(ctx context.Context, p *entity.IngestionPreview) error {
+	_, err := r.col.InsertOne(ctx, p)
+	return err
+}
+
+func (r *IngestionPreviewRepository) Get(ctx context.Context, id string) (*entity.IngestionPreview, error) {
+	var p entity.IngestionPreview
+	// the TTL monitor runs about once a minute, so expiry is checked here too
+	err := r.col.FindOne(ctx, bson.M{"_id": id, "expires_at": bson.M{"$gt": time.Now().UTC()}}).Decode(&p)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, contract.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *IngestionPreviewRepository) ClaimApproval(ctx context.Context, id string, until time.Time) (*entity.IngestionPreview, error) {
+	now := time.Now().UTC()
+	filter := bson.M{"_id": id, "expires_at": bson.M{"$gt": now}, "$or": bson.A{
+		bson.M{"approving_until": bson.M{"$exists": false}},
+		bson.M{"approving_until": bson.M{"$lt": now}},
+	}}
+	var p entity.IngestionPreview
+	err := r.col.FindOneAndUpdate(ctx, filter, bson.M{"$set": bson.M{"approving_until": until}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&p)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *IngestionPreviewRepository) FinishApproval(ctx context.Context, id string, until time.Time, items []entity.PreviewItem) error {
+	// a claim that lapsed may have been taken over; its outcome is not written
+	_, err := r.col.UpdateOne(ctx, bson.M{"_id": id, "approving_until": until}, bson.M{
+		"$set":   bson.M{"items": items},
+		"$unset": bson.M{"approving_until": ""},
+	})
+	return err
+}
+
+func (r *IngestionPreviewRepository) Delete(ctx context.Context, id string) error {
+	res, err := r.col.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return contract.ErrNotFound
+	}
+	return nil
+}
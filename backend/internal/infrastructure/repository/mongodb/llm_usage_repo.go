@@ -0,0 +1,132 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// usageRetention is how long individual call records are kept; daily
+// counters are small and kept indefinitely.
+const usageRetention = 90 * 24 * time.Hour
+
+type LLMUsageRepository struct {
+	col   *mongo.Collection
+	daily *mongo.Collection
+}
+
+// NewLLMUsageRepository stores call records in col and per-day budget
+// counters in daily.
+func NewLLMUsageRepository(col, daily *mongo.Collection) contract.IUsageRepository {
+	r := &LLMUsageRepository{col: col, daily: daily}
+	ctx := context.Background()
+	_, _ = r.col.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "created_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(usageRetention.Seconds())),
+	})
+	_, _ = r.col.Indexes().CreateOne(ctx, mongo.IndexModel{Keys: bson.D{{Key: "day", Value: 1}, {Key: "operation", Value: 1}}})
+	_, _ = r.daily.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "day", Value: 1}, {Key: "key", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return r
+}
+
+func (r *LLMUsageRepository) Save(ctx context.Context, usage *entity.LLMUsage) error {
+	_, err := r.col.InsertOne(ctx, usage)
+	return err
+}
+
+func (r *LLMUsageRepository) IncrementDaily(ctx context.Context, day string, keys []string, calls, tokens int64) error {
+	models := make([]mongo.WriteModel, 0, len(keys))
+	for _, key := range keys {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"day": day, "key": key}).
+			SetUpdate(bson.M{"$inc": bson.M{"calls": calls, "tokens": tokens}}).
+			SetUpsert(true))
+	}
+	if len(models) == 0 {
+		return nil
+	}
+	_, err := r.daily.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+func (r *LLMUsageRepository) GetDaily(ctx context.Context, day string, keys []string) (map[string]entity.UsageCounter, error) {
+	out := make(map[string]entity.UsageCounter, len(keys))
+	cur, err := r.daily.Find(ctx, bson.M{"day": day, "key": bson.M{"$in": keys}})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var c entity.UsageCounter
+		if err := cur.Decode(&c); err != nil {
+			return nil, err
+		}
+		out[c.Key] = c
+	}
+	return out, cur.Err()
+}
+
+func (r *LLMUsageRepository) Report(ctx context.Context, from, to string) ([]entity.UsageReportRow, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"day": bson.M{"$gte": from, "$lte": to}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":            bson.M{"day": "$day", "kind": "$kind", "operation": "$operation"},
+			"calls":          bson.M{"$sum": 1},
+			"errors":         bson.M{"$sum": bson.M{"$cond": bson.A{"$success", 0, 1}}},
+			"prompt_tokens":  bson.M{"$sum": "$prompt_tokens"},
+			"output_tokens":  bson.M{"$sum": "$output_tokens"},
+			"total_tokens":   bson.M{"$sum": "$total_tokens"},
+			"characters":     bson.M{"$sum": "$characters"},
+			"avg_latency_ms": bson.M{"$avg": "$latency_ms"},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id": 0, "day": "$_id.day", "kind": "$_id.kind", "operation": "$_id.operation",
+			"calls": 1, "errors": 1, "prompt_tokens": 1, "output_tokens": 1, "total_tokens": 1, "characters": 1, "avg_latency_ms": 1,
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "day", Value: 1}, {Key: "total_tokens", Value: -1}, {Key: "calls", Value: -1}}}},
+	}
+	cur, err := r.col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	rows := []entity.UsageReportRow{}
+	if err := cur.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *LLMUsageRepository) TopConsumers(ctx context.Context, from, to string, limit int) ([]entity.UsageConsumer, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"day": bson.M{"$gte": from, "$lte": to}, "key": bson.M{"$ne": "global"}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":          "$key",
+			"calls":        bson.M{"$sum": "$calls"},
+			"total_tokens": bson.M{"$sum": "$tokens"},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "total_tokens", Value: -1}, {Key: "calls", Value: -1}}}},
+		{{Key: "$limit", Value: limit}},
+	}
+	cur, err := r.daily.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	out := []entity.UsageConsumer{}
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
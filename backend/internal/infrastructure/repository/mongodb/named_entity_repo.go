@@ -0,0 +1,148 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type NamedEntityRepository struct {
+	col *mongo.Collection
+}
+
+func NewNamedEntityRepository(col *mongo.Collection) contract.INamedEntityRepository {
+	r := &NamedEntityRepository{col: col}
+	_, _ = r.col.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		// one document per (type, slug)
+		{Keys: bson.D{{Key: "type", Value: 1}, {Key: "slug", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "type", Value: 1}, {Key: "aliases", Value: 1}}},
+		{Keys: bson.D{{Key: "article_count", Value: -1}}},
+	})
+	return r
+}
+
+func (r *NamedEntityRepository) Resolve(ctx context.Context, e *entity.NamedEntity) (*entity.NamedEntity, error) {
+	now := time.Now().UTC()
+	match := bson.A{bson.M{"slug": e.Slug}}
+	if len(e.Aliases) > 0 {
+		match = append(match, bson.M{"aliases": bson.M{"$in": e.Aliases}})
+	}
+
+	var existing entity.NamedEntity
+	err := r.col.FindOne(ctx, bson.M{"type": e.Type, "$or": match}).Decode(&existing)
+	switch {
+	case err == nil:
+		set := bson.M{"updated_at": now}
+		if existing.Label.EN == "" && e.Label.EN != "" {
+			set["label.en"] = e.Label.EN
+			existing.Label.EN = e.Label.EN
+		}
+		if existing.Label.AM == "" && e.Label.AM != "" {
+			set["label.am"] = e.Label.AM
+			existing.Label.AM = e.Label.AM
+		}
+		update := bson.M{"$set": set}
+		if len(e.Aliases) > 0 {
+			update["$addToSet"] = bson.M{"aliases": bson.M{"$each": e.Aliases}}
+		}
+		if _, err := r.col.UpdateOne(ctx, bson.M{"_id": existing.ID}, update); err != nil {
+			return nil, err
+		}
+		return &existing, nil
+	case !errors.Is(err, mongo.ErrNoDocuments):
+		return nil, err
+	}
+
+	// Not seen before: upsert on (type, slug) so concurrent ingestion converges on one document
+	update := bson.M{
+		"$setOnInsert": bson.M{"_id": e.ID, "label": e.Label, "article_count": 0, "created_at": now},
+		"$set":         bson.M{"updated_at": now},
+	}
+	if len(e.Aliases) > 0 {
+		update["$addToSet"] = bson.M{"aliases": bson.M{"$each": e.Aliases}}
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var saved entity.NamedEntity
+	if err := r.col.FindOneAndUpdate(ctx, bson.M{"type": e.Type, "slug": e.Slug}, update, opts).Decode(&saved); err != nil {
+		return nil, err
+	}
+	return &saved, nil
+}
+
+func (r *NamedEntityRepository) IncrementArticleCount(ctx context.Context, ids []string, delta int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := r.col.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}}, bson.M{"$inc": bson.M{"article_count": delta}})
+	return err
+}
+
+func (r *NamedEntityRepository) FindByID(ctx context.Context, id string) (*entity.NamedEntity, error) {
+	var e entity.NamedEntity
+	if err := r.col.FindOne(ctx, bson.M{"_id": id}).Decode(&e); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, contract.ErrNotFound
+		}
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *NamedEntityRepository) FindByIDs(ctx context.Context, ids []string) ([]*entity.NamedEntity, error) {
+	list := []*entity.NamedEntity{}
+	if len(ids) == 0 {
+		return list, nil
+	}
+	cur, err := r.col.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	if err := cur.All(ctx, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *NamedEntityRepository) List(ctx context.Context, entityType entity.NamedEntityType, query string, page, limit int) ([]*entity.NamedEntity, int64, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	filter := bson.M{}
+	if entityType != "" {
+		filter["type"] = entityType
+	}
+	if query != "" {
+		prefix := bson.M{"$regex": "^" + regexp.QuoteMeta(query), "$options": "i"}
+		filter["$or"] = bson.A{bson.M{"label.en": prefix}, bson.M{"label.am": prefix}, bson.M{"aliases": prefix}}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "article_count", Value: -1}, {Key: "slug", Value: 1}}).
+		SetSkip(int64((page - 1) * limit)).
+		SetLimit(int64(limit))
+	cur, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	defer cur.Close(ctx)
+	list := []*entity.NamedEntity{}
+	if err := cur.All(ctx, &list); err != nil {
+		return nil, 0, 0, err
+	}
+	total, err := r.col.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	return list, total, totalPages, nil
+}
@@ -2,6 +2,8 @@ package mongodb
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -17,6 +19,20 @@ type NewsRepositoryMongo struct {
 }
 
 func NewNewsRepositoryMongo(collection *mongo.Collection) contract.INewsRepository {
+	// the translation worker polls on translation_due_at; most articles never have it set
+	_, _ = collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "translation_due_at", Value: 1}},
+		Options: options.Index().SetSparse(true),
+	})
+	// ingestion skips entries whose original URL is already stored
+	_, _ = collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "source_url", Value: 1}},
+		Options: options.Index().SetSparse(true),
+	})
+	// reliability scoring reads each source's recent articles
+	_, _ = collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "source_id", Value: 1}, {Key: "created_at", Value: -1}},
+	})
 	return &NewsRepositoryMongo{
 		collection: collection,
 	}
@@ -43,14 +59,61 @@ func (r *NewsRepositoryMongo) Update(news *entity.News) error {
 	news.UpdatedAt = time.Now()
 	filter := bson.M{"_id": news.ID}
 	update := bson.M{"$set": news}
+	if news.TranslationDueAt == nil {
+		// omitted by $set; clear it so the translation worker stops polling this article
+		update["$unset"] = bson.M{"translation_due_at": ""}
+	}
 	_, err := r.collection.UpdateOne(context.Background(), filter, update)
 	return err
 }
 
+func (r *NewsRepositoryMongo) UpdateFields(ctx context.Context, news *entity.News, fields []string, expect map[string]interface{}) error {
+	news.UpdatedAt = time.Now()
+	doc, err := bson.Marshal(news)
+	if err != nil {
+		return err
+	}
+	set, unset := bson.M{"updated_at": news.UpdatedAt}, bson.M{}
+	for _, path := range fields {
+		// fields dropped by omitempty are unset
+		if v, err := bson.Raw(doc).LookupErr(strings.Split(path, ".")...); err == nil {
+			set[path] = v
+		} else {
+			unset[path] = ""
+		}
+	}
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	filter := bson.M{"_id": news.ID}
+	for path, v := range expect {
+		if v == "" {
+			filter[path] = bson.M{"$in": bson.A{nil, ""}}
+		} else {
+			filter[path] = v
+		}
+	}
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		if len(expect) > 0 {
+			return contract.ErrConflict
+		}
+		return contract.ErrNotFound
+	}
+	return nil
+}
+
 func (r *NewsRepositoryMongo) FindByID(id string) (*entity.News, error) {
 	var news entity.News
 	err := r.collection.FindOne(context.Background(), bson.M{"_id": id}).Decode(&news)
 	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, contract.ErrNotFound
+		}
 		return nil, err
 	}
 	return &news, nil
@@ -276,3 +339,173 @@ func (r *NewsRepositoryMongo) FindByTopicID(ctx context.Context, topicID string,
 	}
 	return newsList, total, totalPages, nil
 }
+
+// FindByEntityID returns news whose entity_ids array contains the given entity ID, paginated
+func (r *NewsRepositoryMongo) FindByEntityID(ctx context.Context, entityID string, page, limit int) ([]*entity.News, int64, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	filter := bson.M{"entity_ids": entityID}
+	skip := int64((page - 1) * limit)
+	opts := options.Find().SetLimit(int64(limit)).SetSkip(skip).SetSort(bson.D{{Key: "published_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	newsList := []*entity.News{}
+	if err := cursor.All(ctx, &newsList); err != nil {
+		return nil, 0, 0, err
+	}
+
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	totalPages := int(total) / limit
+	if int(total)%limit != 0 {
+		totalPages++
+	}
+	return newsList, total, totalPages, nil
+}
+
+// ExistsBySourceURL reports whether an article with this original URL is stored
+func (r *NewsRepositoryMongo) ExistsBySourceURL(ctx context.Context, url string) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"source_url": url}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ClaimTranslationDue leases the article due soonest; a worker that dies
+// leaves it due again once the lease passes.
+func (r *NewsRepositoryMongo) ClaimTranslationDue(ctx context.Context, before, leaseUntil time.Time) (*entity.News, error) {
+	var news entity.News
+	err := r.collection.FindOneAndUpdate(ctx,
+		bson.M{"translation_due_at": bson.M{"$lte": before}},
+		bson.M{"$set": bson.M{"translation_due_at": leaseUntil}},
+		options.FindOneAndUpdate().SetSort(bson.D{{Key: "translation_due_at", Value: 1}}).SetReturnDocument(options.After),
+	).Decode(&news)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &news, nil
+}
+
+func (r *NewsRepositoryMongo) FindBySourceSince(ctx context.Context, sourceID string, since time.Time, limit int) ([]*entity.News, error) {
+	if limit <= 0 {
+		limit = 500
+	}
+	filter := bson.M{"source_id": sourceID, "created_at": bson.M{"$gte": since}}
+	// scoring reads titles and bodies only
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetLimit(int64(limit)).
+		SetProjection(bson.M{"title": 1, "body": 1, "language": 1, "source_id": 1, "source_url": 1, "published_at": 1, "created_at": 1})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	list := []*entity.News{}
+	if err := cursor.All(ctx, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *NewsRepositoryMongo) FindForReprocess(ctx context.Context, f entity.ReprocessFilter, afterID string, limit int) ([]*entity.News, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	filter := reprocessFilter(f)
+	if afterID != "" {
+		filter["_id"] = bson.M{"$gt": afterID}
+	}
+	// IDs only: each article is read again just before it is reprocessed
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(int64(limit)).SetProjection(bson.M{"_id": 1})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	list := []*entity.News{}
+	if err := cursor.All(ctx, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *NewsRepositoryMongo) FindAfterID(ctx context.Context, afterID string, limit int) ([]*entity.News, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	filter := bson.M{}
+	if afterID != "" {
+		filter["_id"] = bson.M{"$gt": afterID}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	list := []*entity.News{}
+	if err := cursor.All(ctx, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *NewsRepositoryMongo) CountForReprocess(ctx context.Context, f entity.ReprocessFilter) (int64, error) {
+	return r.collection.CountDocuments(ctx, reprocessFilter(f))
+}
+
+// reprocessFilter builds the query of a reprocess job; a missing field is
+// absent, null or empty.
+func reprocessFilter(f entity.ReprocessFilter) bson.M {
+	filter := bson.M{}
+	published := bson.M{}
+	if f.From != nil {
+		published["$gte"] = *f.From
+	}
+	if f.To != nil {
+		published["$lt"] = *f.To
+	}
+	if len(published) > 0 {
+		filter["published_at"] = published
+	}
+	if f.SourceID != "" {
+		filter["source_id"] = f.SourceID
+	}
+	if f.TopicID != "" {
+		filter["topics"] = f.TopicID
+	}
+	if f.Language != "" {
+		filter["language"] = f.Language
+	}
+	empty := bson.M{"$in": bson.A{nil, ""}}
+	switch f.Missing {
+	case entity.MissingSummary:
+		filter["summary_en"], filter["summary_am"] = empty, empty
+	case entity.MissingTopics:
+		filter["topics"] = bson.M{"$in": bson.A{nil, bson.A{}}}
+	case entity.MissingTranslation:
+		filter["$or"] = bson.A{
+			bson.M{"title_en": empty}, bson.M{"title_am": empty},
+			bson.M{"summary_en": empty}, bson.M{"summary_am": empty},
+		}
+	case entity.MissingLocalizedDate:
+		filter["published_date_localized"] = empty
+	}
+	return filter
+}
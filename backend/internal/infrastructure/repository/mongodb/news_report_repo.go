@@ -0,0 +1,72 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type NewsReportRepository struct {
+	col *mongo.Collection
+}
+
+func NewNewsReportRepository(col *mongo.Collection) contract.INewsReportRepository {
+	r := &NewsReportRepository{col: col}
+	// one report per reader and article
+	_, _ = r.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "news_id", Value: 1}, {Key: "user_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	_, _ = r.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "created_at", Value: 1}, {Key: "source_id", Value: 1}},
+	})
+	return r
+}
+
+func (r *NewsReportRepository) Create(ctx context.Context, rep *entity.NewsReport) error {
+	if rep.CreatedAt.IsZero() {
+		rep.CreatedAt = time.Now().UTC()
+	}
+	_, err := r.col.InsertOne(ctx, rep)
+	if mongo.IsDuplicateKeyError(err) {
+		return contract.ErrConflict
+	}
+	return err
+}
+
+func (r *NewsReportRepository) CountBySource(ctx context.Context, since time.Time) (map[string]contract.ReportCount, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"created_at": bson.M{"$gte": since}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     bson.M{"source_id": "$source_id", "news_id": "$news_id"},
+			"reports": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":      "$_id.source_id",
+			"reports":  bson.M{"$sum": "$reports"},
+			"articles": bson.M{"$sum": 1},
+		}}},
+	}
+	cur, err := r.col.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	var rows []struct {
+		SourceID             string `bson:"_id"`
+		contract.ReportCount `bson:",inline"`
+	}
+	if err := cur.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+	counts := make(map[string]contract.ReportCount, len(rows))
+	for _, row := range rows {
+		counts[row.SourceID] = row.ReportCount
+	}
+	return counts, nil
+}
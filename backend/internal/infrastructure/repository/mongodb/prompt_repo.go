@@ -0,0 +1,95 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PromptRepository struct {
+	col *mongo.Collection
+}
+
+func NewPromptRepository(col *mongo.Collection) contract.IPromptRepository {
+	r := &PromptRepository{col: col}
+	// versions are numbered per prompt name across languages
+	_, _ = r.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "name", Value: 1}, {Key: "version", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	_, _ = r.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{Keys: bson.D{{Key: "active", Value: 1}}})
+	return r
+}
+
+func (r *PromptRepository) Create(ctx context.Context, t *entity.PromptTemplate) error {
+	now := time.Now().UTC()
+	t.CreatedAt, t.UpdatedAt = now, now
+	_, err := r.col.InsertOne(ctx, t)
+	return err
+}
+
+func (r *PromptRepository) FindByID(ctx context.Context, id string) (*entity.PromptTemplate, error) {
+	var t entity.PromptTemplate
+	if err := r.col.FindOne(ctx, bson.M{"_id": id}).Decode(&t); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, contract.ErrNotFound
+		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *PromptRepository) List(ctx context.Context, name string) ([]*entity.PromptTemplate, error) {
+	filter := bson.M{}
+	if name != "" {
+		filter["name"] = name
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "version", Value: -1}})
+	return r.find(ctx, filter, opts)
+}
+
+func (r *PromptRepository) ListActive(ctx context.Context) ([]*entity.PromptTemplate, error) {
+	return r.find(ctx, bson.M{"active": true}, options.Find().SetSort(bson.D{{Key: "version", Value: -1}}))
+}
+
+func (r *PromptRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entity.PromptTemplate, error) {
+	cur, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	out := []*entity.PromptTemplate{}
+	if err := cur.All(ctx, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *PromptRepository) LatestVersion(ctx context.Context, name string) (int, error) {
+	var t entity.PromptTemplate
+	opts := options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}})
+	if err := r.col.FindOne(ctx, bson.M{"name": name}, opts).Decode(&t); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return t.Version, nil
+}
+
+func (r *PromptRepository) SetActive(ctx context.Context, id string, active bool, weight int) error {
+	res, err := r.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"active": active, "weight": weight, "updated_at": time.Now().UTC()}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return contract.ErrNotFound
+	}
+	return nil
+}
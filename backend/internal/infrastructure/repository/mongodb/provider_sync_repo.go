@@ -0,0 +1,67 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ProviderSyncRepository struct {
+	col *mongo.Collection
+}
+
+func NewProviderSyncRepository(col *mongo.Collection) contract.IProviderSyncRepository {
+	return &ProviderSyncRepository{col: col}
+}
+
+func (r *ProviderSyncRepository) Get(ctx context.Context, provider string) (*entity.ProviderSyncState, error) {
+	var s entity.ProviderSyncState
+	if err := r.col.FindOne(ctx, bson.M{"_id": provider}).Decode(&s); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, contract.ErrNotFound
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *ProviderSyncRepository) SaveSync(ctx context.Context, s *entity.ProviderSyncState) error {
+	_, err := r.col.UpdateOne(ctx, bson.M{"_id": s.Provider}, bson.M{"$set": bson.M{
+		"cursor_published_at": s.CursorPublishedAt,
+		"cursor_id":           s.CursorID,
+		"last_sync_at":        s.LastSyncAt,
+		"last_status":         s.LastStatus,
+		"last_error":          s.LastError,
+		"last_pages":          s.LastPages,
+		"last_fetched":        s.LastFetched,
+		"last_ingested":       s.LastIngested,
+		"last_skipped":        s.LastSkipped,
+		"total_ingested":      s.TotalIngested,
+		"caught_up":           s.CaughtUp,
+	}}, options.Update().SetUpsert(true))
+	return err
+}
+
+func (r *ProviderSyncRepository) StartBackfill(ctx context.Context, provider string, b *entity.ProviderBackfill) (bool, error) {
+	filter := bson.M{"_id": provider, "$or": bson.A{
+		bson.M{"backfill.status": bson.M{"$ne": entity.BackfillRunning}},
+		bson.M{"backfill.deadline": bson.M{"$lt": time.Now().UTC()}},
+	}}
+	_, err := r.col.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"backfill": b}}, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		// the document exists and its backfill is still running
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (r *ProviderSyncRepository) SaveBackfill(ctx context.Context, provider string, b *entity.ProviderBackfill) error {
+	_, err := r.col.UpdateOne(ctx, bson.M{"_id": provider}, bson.M{"$set": bson.M{"backfill": b}})
+	return err
+}
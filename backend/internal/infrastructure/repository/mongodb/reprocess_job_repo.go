@@ -0,0 +1,140 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ReprocessJobRepository stores reprocess jobs; like scheduled jobs, the
+// runner holds a lease on the job document.
+type ReprocessJobRepository struct {
+	col *mongo.Collection
+}
+
+func NewReprocessJobRepository(col *mongo.Collection) contract.IReprocessJobRepository {
+	_, _ = col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: -1}},
+	})
+	return &ReprocessJobRepository{col: col}
+}
+
+func (r *ReprocessJobRepository) Create(ctx context.Context, j *entity.ReprocessJob) error {
+	_, err := r.col.InsertOne(ctx, j)
+	if mongo.IsDuplicateKeyError(err) {
+		return contract.ErrConflict
+	}
+	return err
+}
+
+func (r *ReprocessJobRepository) Get(ctx context.Context, id string) (*entity.ReprocessJob, error) {
+	var j entity.ReprocessJob
+	if err := r.col.FindOne(ctx, bson.M{"_id": id}).Decode(&j); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, contract.ErrNotFound
+		}
+		return nil, err
+	}
+	return &j, nil
+}
+
+func (r *ReprocessJobRepository) List(ctx context.Context, status string, limit int) ([]*entity.ReprocessJob, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	filter := bson.M{}
+	if status != "" {
+		filter["status"] = status
+	}
+	cur, err := r.col.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	jobs := []*entity.ReprocessJob{}
+	if err := cur.All(ctx, &jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (r *ReprocessJobRepository) Claim(ctx context.Context, id, owner string, until time.Time) (bool, error) {
+	filter := bson.M{
+		"_id":    id,
+		"status": entity.ReprocessRunning,
+		// free, or left behind by a runner that died
+		"$or": bson.A{bson.M{"lease_until": nil}, bson.M{"lease_until": bson.M{"$lte": time.Now().UTC()}}},
+	}
+	res, err := r.col.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"lease_owner": owner, "lease_until": until}})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+func (r *ReprocessJobRepository) SaveProgress(ctx context.Context, j *entity.ReprocessJob, owner string, until time.Time) (string, error) {
+	set := progressFields(j)
+	set["lease_until"] = until
+	var stored struct {
+		Status string `bson:"status"`
+	}
+	err := r.col.FindOneAndUpdate(ctx, bson.M{"_id": j.ID, "lease_owner": owner}, bson.M{"$set": set},
+		options.FindOneAndUpdate().SetReturnDocument(options.After).SetProjection(bson.M{"status": 1}),
+	).Decode(&stored)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", contract.ErrConflict
+	}
+	return stored.Status, err
+}
+
+func (r *ReprocessJobRepository) Release(ctx context.Context, j *entity.ReprocessJob, owner string) error {
+	set := progressFields(j)
+	set["status"] = j.Status
+	set["error"] = j.Error
+	if j.FinishedAt != nil {
+		set["finished_at"] = j.FinishedAt
+	}
+	_, err := r.col.UpdateOne(ctx, bson.M{"_id": j.ID, "lease_owner": owner}, bson.M{
+		"$set":   set,
+		"$unset": bson.M{"lease_owner": "", "lease_until": ""},
+	})
+	return err
+}
+
+func (r *ReprocessJobRepository) SetStatus(ctx context.Context, id, status string, from []string) (bool, error) {
+	now := time.Now().UTC()
+	set := bson.M{"status": status, "updated_at": now}
+	update := bson.M{"$set": set}
+	switch status {
+	case entity.ReprocessDone, entity.ReprocessFailed, entity.ReprocessCancelled:
+		set["finished_at"] = now
+	case entity.ReprocessRunning:
+		// resuming a failed job clears its outcome
+		update["$unset"] = bson.M{"error": "", "finished_at": ""}
+	}
+	res, err := r.col.UpdateOne(ctx, bson.M{"_id": id, "status": bson.M{"$in": from}}, update)
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// progressFields are written by the runner; the status is left to SetStatus
+// so a pause or cancel request is not overwritten.
+func progressFields(j *entity.ReprocessJob) bson.M {
+	return bson.M{
+		"processed":    j.Processed,
+		"updated":      j.Updated,
+		"failed":       j.Failed,
+		"stage_counts": j.StageCounts,
+		"cursor":       j.Cursor,
+		"failures":     j.Failures,
+		"updated_at":   time.Now().UTC(),
+	}
+}
@@ -0,0 +1,49 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RevisionRepository struct {
+	col *mongo.Collection
+}
+
+func NewRevisionRepository(col *mongo.Collection) contract.IRevisionRepository {
+	r := &RevisionRepository{col: col}
+	_, _ = r.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "news_id", Value: 1}, {Key: "field", Value: 1}, {Key: "created_at", Value: -1}},
+	})
+	return r
+}
+
+func (r *RevisionRepository) Save(ctx context.Context, rev *entity.FieldRevision) error {
+	if rev.CreatedAt.IsZero() {
+		rev.CreatedAt = time.Now().UTC()
+	}
+	_, err := r.col.InsertOne(ctx, rev)
+	return err
+}
+
+func (r *RevisionRepository) ListByNews(ctx context.Context, newsID, field string) ([]*entity.FieldRevision, error) {
+	filter := bson.M{"news_id": newsID}
+	if field != "" {
+		filter["field"] = field
+	}
+	cur, err := r.col.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	list := []*entity.FieldRevision{}
+	if err := cur.All(ctx, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,71 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// safetyEventRetention bounds how long safety events are kept.
+const safetyEventRetention = 90 * 24 * time.Hour
+
+type SafetyEventRepository struct {
+	col *mongo.Collection
+}
+
+func NewSafetyEventRepository(col *mongo.Collection) contract.ISafetyEventRepository {
+	r := &SafetyEventRepository{col: col}
+	_, _ = r.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "created_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(safetyEventRetention.Seconds())),
+	})
+	_, _ = r.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "stage", Value: 1}, {Key: "created_at", Value: -1}},
+	})
+	return r
+}
+
+func (r *SafetyEventRepository) Save(ctx context.Context, e *entity.SafetyEvent) error {
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now().UTC()
+	}
+	_, err := r.col.InsertOne(ctx, e)
+	return err
+}
+
+func (r *SafetyEventRepository) List(ctx context.Context, stage entity.SafetyStage, page, limit int) ([]*entity.SafetyEvent, int64, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	filter := bson.M{}
+	if stage != "" {
+		filter["stage"] = stage
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(int64((page - 1) * limit)).
+		SetLimit(int64(limit))
+	cur, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	defer cur.Close(ctx)
+	events := []*entity.SafetyEvent{}
+	if err := cur.All(ctx, &events); err != nil {
+		return nil, 0, 0, err
+	}
+	total, err := r.col.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	return events, total, totalPages, nil
+}
@@ -0,0 +1,110 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ScheduledJobRepository stores job state; the lease is a conditional update
+// on the job document, so claiming needs no separate lock collection.
+type ScheduledJobRepository struct {
+	col *mongo.Collection
+}
+
+func NewScheduledJobRepository(col *mongo.Collection) contract.IJobRepository {
+	return &ScheduledJobRepository{col: col}
+}
+
+func (r *ScheduledJobRepository) Ensure(ctx context.Context, name, schedule string) error {
+	_, err := r.col.UpdateOne(ctx, bson.M{"_id": name}, bson.M{
+		"$set":         bson.M{"schedule": schedule},
+		"$setOnInsert": bson.M{"paused": false, "last_tick": time.Time{}, "run_count": 0},
+	}, options.Update().SetUpsert(true))
+	return err
+}
+
+func (r *ScheduledJobRepository) Get(ctx context.Context, name string) (*entity.ScheduledJob, error) {
+	var j entity.ScheduledJob
+	if err := r.col.FindOne(ctx, bson.M{"_id": name}).Decode(&j); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, contract.ErrNotFound
+		}
+		return nil, err
+	}
+	return &j, nil
+}
+
+func (r *ScheduledJobRepository) List(ctx context.Context) ([]*entity.ScheduledJob, error) {
+	cur, err := r.col.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	jobs := []*entity.ScheduledJob{}
+	if err := cur.All(ctx, &jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (r *ScheduledJobRepository) SetPaused(ctx context.Context, name string, paused bool, by string) error {
+	set := bson.M{"paused": paused, "paused_by": by}
+	if !paused {
+		set["paused_by"] = ""
+	}
+	res, err := r.col.UpdateOne(ctx, bson.M{"_id": name}, bson.M{"$set": set})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return contract.ErrNotFound
+	}
+	return nil
+}
+
+func (r *ScheduledJobRepository) Claim(ctx context.Context, name, owner string, tick, leaseUntil time.Time, force bool) (bool, error) {
+	filter := bson.M{
+		"_id": name,
+		// free, or left behind by a replica that died mid-run
+		"$or": bson.A{bson.M{"lease_until": nil}, bson.M{"lease_until": bson.M{"$lte": time.Now().UTC()}}},
+	}
+	set := bson.M{"lease_owner": owner, "lease_until": leaseUntil}
+	if !force {
+		filter["paused"] = bson.M{"$ne": true}
+		// every replica computes the same tick; only the first claims it
+		filter["last_tick"] = bson.M{"$lt": tick}
+		set["last_tick"] = tick
+	}
+	res, err := r.col.UpdateOne(ctx, filter, bson.M{"$set": set})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+func (r *ScheduledJobRepository) Finish(ctx context.Context, name, owner string, run entity.JobRun) error {
+	status, errText := "ok", ""
+	if run.Err != nil {
+		status, errText = "error", run.Err.Error()
+	}
+	_, err := r.col.UpdateOne(ctx, bson.M{"_id": name, "lease_owner": owner}, bson.M{
+		"$set": bson.M{
+			"last_run_at":      run.StartedAt,
+			"last_duration_ms": run.Duration.Milliseconds(),
+			"last_status":      status,
+			"last_error":       errText,
+			"last_result":      run.Result,
+			"last_trigger":     run.Trigger,
+		},
+		"$unset": bson.M{"lease_owner": "", "lease_until": ""},
+		"$inc":   bson.M{"run_count": 1},
+	})
+	return err
+}
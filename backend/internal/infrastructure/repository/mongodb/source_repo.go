@@ -73,3 +73,43 @@ func (r *sourceRepository) GetAll(ctx context.Context) ([]entity.Source, error)
 	}
 	return sources, nil
 }
+
+// UpdateFeeds replaces the feed URLs and poll interval of a source.
+func (r *sourceRepository) UpdateFeeds(ctx context.Context, slug string, urls []string, intervalMinutes int) error {
+	res, err := r.collection.UpdateOne(ctx, bson.M{"slug": slug}, bson.M{"$set": bson.M{"feed_urls": urls, "poll_interval_minutes": intervalMinutes}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("source not found")
+	}
+	return nil
+}
+
+// UpdateReliability stores the score of a source and its breakdown.
+func (r *sourceRepository) UpdateReliability(ctx context.Context, slug string, score float64, breakdown *entity.ReliabilityBreakdown) error {
+	res, err := r.collection.UpdateOne(ctx, bson.M{"slug": slug}, bson.M{"$set": bson.M{"reliability_score": score, "reliability": breakdown}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("source not found")
+	}
+	return nil
+}
+
+// SetReliabilityOverride sets or, when nil, removes the editorial override.
+func (r *sourceRepository) SetReliabilityOverride(ctx context.Context, slug string, override *entity.ReliabilityOverride) error {
+	update := bson.M{"$set": bson.M{"reliability_override": override}}
+	if override == nil {
+		update = bson.M{"$unset": bson.M{"reliability_override": ""}}
+	}
+	res, err := r.collection.UpdateOne(ctx, bson.M{"slug": slug}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("source not found")
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type StoryRepository struct {
+	col *mongo.Collection
+}
+
+func NewStoryRepository(col *mongo.Collection) contract.IStoryRepository {
+	r := &StoryRepository{col: col}
+	_, _ = r.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "last_published_at", Value: -1}},
+	})
+	return r
+}
+
+func (r *StoryRepository) Save(ctx context.Context, story *entity.Story) error {
+	now := time.Now().UTC()
+	story.CreatedAt = now
+	story.UpdatedAt = now
+	_, err := r.col.InsertOne(ctx, story)
+	return err
+}
+
+func (r *StoryRepository) Update(ctx context.Context, story *entity.Story) error {
+	filter := bson.M{"_id": story.ID, "version": story.Version}
+	if story.Version == 0 {
+		// stories stored before versioning have no version field
+		filter["version"] = bson.M{"$in": bson.A{nil, 0}}
+	}
+	next := *story
+	next.Version++
+	next.UpdatedAt = time.Now().UTC()
+	res, err := r.col.ReplaceOne(ctx, filter, &next)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return contract.ErrConflict
+	}
+	story.Version, story.UpdatedAt = next.Version, next.UpdatedAt
+	return nil
+}
+
+func (r *StoryRepository) SetSynthesis(ctx context.Context, id string, headline, summary entity.BilingualField, promptVersion string) error {
+	_, err := r.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set": bson.M{"headline": headline, "summary": summary, "prompt_version": promptVersion, "updated_at": time.Now().UTC()},
+		"$inc": bson.M{"version": 1},
+	})
+	return err
+}
+
+func (r *StoryRepository) FindByID(ctx context.Context, id string) (*entity.Story, error) {
+	var story entity.Story
+	if err := r.col.FindOne(ctx, bson.M{"_id": id}).Decode(&story); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, contract.ErrNotFound
+		}
+		return nil, err
+	}
+	return &story, nil
+}
+
+func (r *StoryRepository) FindActiveSince(ctx context.Context, since time.Time) ([]*entity.Story, error) {
+	cur, err := r.col.Find(ctx, bson.M{"last_published_at": bson.M{"$gte": since}})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	var stories []*entity.Story
+	if err := cur.All(ctx, &stories); err != nil {
+		return nil, err
+	}
+	return stories, nil
+}
+
+func (r *StoryRepository) List(ctx context.Context, since time.Time, page, limit int) ([]*entity.Story, int64, int, error) {
+	return r.list(ctx, since, page, limit, bson.D{{Key: "last_published_at", Value: -1}})
+}
+
+func (r *StoryRepository) ListTrending(ctx context.Context, since time.Time, page, limit int) ([]*entity.Story, int64, int, error) {
+	return r.list(ctx, since, page, limit, bson.D{
+		{Key: "source_count", Value: -1},
+		{Key: "article_count", Value: -1},
+		{Key: "last_published_at", Value: -1},
+	})
+}
+
+func (r *StoryRepository) list(ctx context.Context, since time.Time, page, limit int, sort bson.D) ([]*entity.Story, int64, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	filter := bson.M{}
+	if !since.IsZero() {
+		filter["last_published_at"] = bson.M{"$gte": since}
+	}
+	opts := options.Find().
+		SetSort(sort).
+		SetSkip(int64((page - 1) * limit)).
+		SetLimit(int64(limit)).
+		SetProjection(bson.M{"centroid": 0})
+	cur, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	defer cur.Close(ctx)
+	var stories []*entity.Story
+	if err := cur.All(ctx, &stories); err != nil {
+		return nil, 0, 0, err
+	}
+	total, err := r.col.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	return stories, total, totalPages, nil
+}
@@ -0,0 +1,79 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SummaryRepository struct {
+	col *mongo.Collection
+}
+
+func NewSummaryRepository(col *mongo.Collection) contract.ISummaryRepository {
+	r := &SummaryRepository{col: col}
+	// one cached variant per (news_id, type, language)
+	_, _ = r.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "news_id", Value: 1}, {Key: "type", Value: 1}, {Key: "language", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return r
+}
+
+func (r *SummaryRepository) Upsert(ctx context.Context, s *entity.Summary) error {
+	now := time.Now().UTC()
+	if s.CreatedAt.IsZero() {
+		s.CreatedAt = now
+	}
+	s.UpdatedAt = now
+	filter := bson.M{"news_id": s.NewsID, "type": s.Type, "language": s.Language}
+	update := bson.M{
+		"$set": bson.M{
+			"content":        s.Content,
+			"bullets":        s.Bullets,
+			"model":          s.Model,
+			"prompt_version": s.PromptVersion,
+			"source_hash":    s.SourceHash,
+			"updated_at":     s.UpdatedAt,
+		},
+		"$setOnInsert": bson.M{"_id": s.ID, "created_at": s.CreatedAt},
+	}
+	_, err := r.col.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+func (r *SummaryRepository) Find(ctx context.Context, newsID string, summaryType entity.SummaryType, lang string) (*entity.Summary, error) {
+	var s entity.Summary
+	err := r.col.FindOne(ctx, bson.M{"news_id": newsID, "type": summaryType, "language": lang}).Decode(&s)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, contract.ErrNotFound
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *SummaryRepository) DeleteByNews(ctx context.Context, newsID string) error {
+	_, err := r.col.DeleteMany(ctx, bson.M{"news_id": newsID})
+	return err
+}
+
+func (r *SummaryRepository) ListByNews(ctx context.Context, newsID string) ([]entity.Summary, error) {
+	cur, err := r.col.Find(ctx, bson.M{"news_id": newsID})
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	var list []entity.Summary
+	if err := cur.All(ctx, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,84 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type TranslationMemoryRepository struct {
+	col *mongo.Collection
+}
+
+func NewTranslationMemoryRepository(col *mongo.Collection) contract.ITranslationMemoryRepository {
+	r := &TranslationMemoryRepository{col: col}
+	// one approved translation per (direction, source phrase)
+	_, _ = r.col.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "source_lang", Value: 1}, {Key: "target_lang", Value: 1}, {Key: "key", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return r
+}
+
+func (r *TranslationMemoryRepository) Upsert(ctx context.Context, e *entity.TranslationMemoryEntry) error {
+	now := time.Now().UTC()
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = now
+	}
+	e.UpdatedAt = now
+	filter := bson.M{"source_lang": e.SourceLang, "target_lang": e.TargetLang, "key": e.Key}
+	update := bson.M{
+		"$set": bson.M{
+			"source":     e.Source,
+			"target":     e.Target,
+			"editor_id":  e.EditorID,
+			"news_id":    e.NewsID,
+			"updated_at": e.UpdatedAt,
+		},
+		"$setOnInsert": bson.M{"_id": e.ID, "created_at": e.CreatedAt},
+	}
+	_, err := r.col.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+func (r *TranslationMemoryRepository) Find(ctx context.Context, sourceLang, targetLang, key string) (*entity.TranslationMemoryEntry, error) {
+	var e entity.TranslationMemoryEntry
+	err := r.col.FindOne(ctx, bson.M{"source_lang": sourceLang, "target_lang": targetLang, "key": key}).Decode(&e)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, contract.ErrNotFound
+		}
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *TranslationMemoryRepository) List(ctx context.Context, sourceLang, targetLang string, limit int) ([]*entity.TranslationMemoryEntry, error) {
+	filter := bson.M{}
+	if sourceLang != "" {
+		filter["source_lang"] = sourceLang
+	}
+	if targetLang != "" {
+		filter["target_lang"] = targetLang
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "updated_at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	cur, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+	list := []*entity.TranslationMemoryEntry{}
+	if err := cur.All(ctx, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
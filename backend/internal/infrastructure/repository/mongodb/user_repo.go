@@ -266,6 +266,60 @@ func (r *UserRepository) GetUserSubscribedTopicsByID(ctx context.Context, userID
 	return result.Preferences.Topics, nil
 }
 
+// FollowEntities adds multiple entities to preferences.entities using $addToSet + $each
+func (r *UserRepository) FollowEntities(ctx context.Context, userID string, entityIDs []string) error {
+	if len(entityIDs) == 0 {
+		return nil
+	}
+	if err := r.ensureArrayField(ctx, userID, "preferences.entities"); err != nil {
+		return err
+	}
+	update := bson.M{
+		"$addToSet": bson.M{
+			"preferences.entities": bson.M{"$each": entityIDs},
+		},
+	}
+	res, err := r.collection.UpdateOne(ctx, bson.M{"_id": userID}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("user not found")
+	}
+	return nil
+}
+
+// UnfollowEntity pulls an entity from preferences.entities
+func (r *UserRepository) UnfollowEntity(ctx context.Context, userID, entityID string) error {
+	if err := r.ensureArrayField(ctx, userID, "preferences.entities"); err != nil {
+		return err
+	}
+	res, err := r.collection.UpdateOne(ctx, bson.M{"_id": userID}, bson.M{"$pull": bson.M{"preferences.entities": entityID}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return errors.New("user not found")
+	}
+	return nil
+}
+
+func (r *UserRepository) GetFollowedEntityIDs(ctx context.Context, userID string) ([]string, error) {
+	opts := options.FindOne().SetProjection(bson.M{"preferences.entities": 1, "_id": 0})
+	var result struct {
+		Preferences struct {
+			Entities []string `bson:"entities"`
+		} `bson:"preferences"`
+	}
+	if err := r.collection.FindOne(ctx, bson.M{"_id": userID}, opts).Decode(&result); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("user not found")
+		}
+		return nil, err
+	}
+	return result.Preferences.Entities, nil
+}
+
 // ensureArrayField initializes a nested array field to an empty array when it is missing or null.
 func (r *UserRepository) ensureArrayField(ctx context.Context, userID string, field string) error {
 	filter := bson.M{
@@ -0,0 +1,64 @@
+package secretbox
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"os"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+)
+
+// Box seals secrets with AES-256-GCM; the nonce is prepended to the ciphertext.
+type Box struct {
+	aead cipher.AEAD
+}
+
+// New derives the AES key from the passphrase with SHA-256.
+func New(passphrase string) (contract.ISecretBox, error) {
+	if passphrase == "" {
+		return nil, errors.New("secretbox: empty passphrase")
+	}
+	key := sha256.Sum256([]byte(passphrase))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Box{aead: aead}, nil
+}
+
+// NewFromEnv uses WEBHOOK_SECRET_KEY and returns nil when it is not set.
+func NewFromEnv() (contract.ISecretBox, error) {
+	passphrase := os.Getenv("WEBHOOK_SECRET_KEY")
+	if passphrase == "" {
+		return nil, nil
+	}
+	return New(passphrase)
+}
+
+func (b *Box) Seal(plain []byte) (string, error) {
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b.aead.Seal(nonce, nonce, plain, nil)), nil
+}
+
+func (b *Box) Open(sealed string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, err
+	}
+	n := b.aead.NonceSize()
+	if len(data) < n {
+		return nil, errors.New("secretbox: sealed value too short")
+	}
+	return b.aead.Open(nil, data[:n], data[n:], nil)
+}
@@ -0,0 +1,139 @@
+package translation
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// sentenceEnd reports whether r terminates a sentence in English or Amharic
+// (። full stop, ፧ question mark, ፨ paragraph separator).
+func sentenceEnd(r rune) bool {
+	switch r {
+	case '.', '!', '?', '።', '፧', '፨':
+		return true
+	}
+	return false
+}
+
+// splitSentences cuts text after sentence terminators and line breaks. Each unit
+// keeps its trailing whitespace so that concatenating the units gives back text.
+func splitSentences(text string) []string {
+	var units []string
+	start := 0
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		if !sentenceEnd(runes[i]) && runes[i] != '\n' {
+			continue
+		}
+		j := i + 1
+		// keep closing quotes/brackets with the sentence ("...said." → one unit)
+		for j < len(runes) && strings.ContainsRune(`"'”’)]»`, runes[j]) {
+			j++
+		}
+		if runes[i] != '\n' && j < len(runes) && !unicode.IsSpace(runes[j]) {
+			// inside an abbreviation or decimal ("U.S.", "3.5") — not a boundary
+			continue
+		}
+		if runes[i] == '.' && isAbbreviation(lastWord(runes[start:i])) {
+			continue
+		}
+		for j < len(runes) && unicode.IsSpace(runes[j]) {
+			j++
+		}
+		units = append(units, string(runes[start:j]))
+		start = j
+		i = j - 1
+	}
+	if start < len(runes) {
+		units = append(units, string(runes[start:]))
+	}
+	return units
+}
+
+// abbreviations that end with a period without ending the sentence.
+var abbreviations = map[string]struct{}{
+	"mr": {}, "mrs": {}, "ms": {}, "dr": {}, "prof": {}, "st": {}, "gen": {}, "col": {}, "lt": {},
+	"sgt": {}, "hon": {}, "amb": {}, "no": {}, "vs": {}, "etc": {}, "e.g": {}, "i.e": {}, "jan": {},
+	"feb": {}, "aug": {}, "sept": {}, "oct": {}, "nov": {}, "dec": {},
+}
+
+// lastWord returns the word right before position i of a sentence.
+func lastWord(runes []rune) string {
+	k := len(runes)
+	for k > 0 && !unicode.IsSpace(runes[k-1]) {
+		k--
+	}
+	return string(runes[k:])
+}
+
+// isAbbreviation reports whether a word followed by "." is an initial ("A."),
+// a dotted acronym ("U.S.") or a common title.
+func isAbbreviation(word string) bool {
+	if word == "" {
+		return false
+	}
+	if utf8.RuneCountInString(word) == 1 && unicode.IsUpper([]rune(word)[0]) {
+		return true
+	}
+	if strings.Contains(word, ".") && !strings.HasSuffix(word, ".") {
+		return true
+	}
+	_, ok := abbreviations[strings.ToLower(word)]
+	return ok
+}
+
+// Chunk groups sentences into pieces of at most maxChars characters, never
+// splitting inside a sentence unless the sentence alone exceeds the limit (then it
+// is cut at whitespace). Concatenating the returned chunks yields the input.
+func Chunk(text string, maxChars int) []string {
+	if maxChars <= 0 || utf8.RuneCountInString(text) <= maxChars {
+		return []string{text}
+	}
+	var chunks []string
+	var cur strings.Builder
+	curLen := 0
+	flush := func() {
+		if curLen > 0 {
+			chunks = append(chunks, cur.String())
+			cur.Reset()
+			curLen = 0
+		}
+	}
+	for _, unit := range splitSentences(text) {
+		n := utf8.RuneCountInString(unit)
+		if curLen+n > maxChars {
+			flush()
+		}
+		if n > maxChars {
+			chunks = append(chunks, splitLong(unit, maxChars)...)
+			continue
+		}
+		cur.WriteString(unit)
+		curLen += n
+	}
+	flush()
+	return chunks
+}
+
+// splitLong cuts an over-long sentence after the last whitespace within each
+// limit, keeping the whitespace at the end of the piece.
+func splitLong(s string, maxChars int) []string {
+	var out []string
+	runes := []rune(s)
+	for len(runes) > maxChars {
+		cut := maxChars
+		for k := maxChars; k > maxChars/2; k-- {
+			if unicode.IsSpace(runes[k-1]) {
+				cut = k
+				break
+			}
+		}
+		out = append(out, string(runes[:cut]))
+		runes = runes[cut:]
+	}
+	if len(runes) > 0 {
+		out = append(out, string(runes))
+	}
+	return out
+}
@@ -0,0 +1,74 @@
+package translation
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSplitSentences(t *testing.T) {
+	cases := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"plain", "One. Two! Three?", []string{"One. ", "Two! ", "Three?"}},
+		{"newlines", "Headline\nBody text.\n\nNext.", []string{"Headline\n", "Body text.\n\n", "Next."}},
+		{"closing quote", `He said "stop." Then left.`, []string{`He said "stop." `, "Then left."}},
+		{"decimal", "Growth was 3.5 percent. Good.", []string{"Growth was 3.5 percent. ", "Good."}},
+		{"acronym", "The U.S. embassy said so. Done.", []string{"The U.S. embassy said so. ", "Done."}},
+		{"initial", "Abiy A. Ali spoke. Done.", []string{"Abiy A. Ali spoke. ", "Done."}},
+		{"title", "Dr. Tedros met Mr. Smith. Done.", []string{"Dr. Tedros met Mr. Smith. ", "Done."}},
+		{"amharic", "ሰላም ነው። እንዴት ነህ፧ ደህና", []string{"ሰላም ነው። ", "እንዴት ነህ፧ ", "ደህና"}},
+		{"no terminator", "just words", []string{"just words"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := splitSentences(c.text)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("got %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	long := strings.Repeat("word ", 30) + "end."
+	cases := []struct {
+		name     string
+		text     string
+		maxChars int
+		want     []string
+	}{
+		{"fits", "One. Two.", 100, []string{"One. Two."}},
+		{"no limit", "One. Two.", 0, []string{"One. Two."}},
+		{"empty", "", 10, []string{""}},
+		{"sentence boundaries", "One. Two. Three.", 10, []string{"One. Two. ", "Three."}},
+		{"exact fit", "Aaaa. Bbbb.", 6, []string{"Aaaa. ", "Bbbb."}},
+		{"amharic counts runes", "ሰላም ነው። እንዴት ነህ።", 9, []string{"ሰላም ነው። ", "እንዴት ነህ።"}},
+		{"long sentence at whitespace", "aaaa bbbb cccc dddd", 10, []string{"aaaa bbbb ", "cccc dddd"}},
+		{"space on the limit", "aaaa bbbbb cc", 5, []string{"aaaa ", "bbbbb", " cc"}},
+		{"no whitespace", "abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+		{"long after short", "Hi. " + long, 40, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := Chunk(c.text, c.maxChars)
+			if c.want != nil && !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("got %q, want %q", got, c.want)
+			}
+			if joined := strings.Join(got, ""); joined != c.text {
+				t.Fatalf("chunks join to %q, want the input", joined)
+			}
+			if c.maxChars <= 0 {
+				return
+			}
+			for _, chunk := range got {
+				if n := utf8.RuneCountInString(chunk); n > c.maxChars {
+					t.Errorf("chunk %q has %d characters, limit %d", chunk, n, c.maxChars)
+				}
+			}
+		})
+	}
+}
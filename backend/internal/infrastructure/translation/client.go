@@ -0,0 +1,234 @@
+package translation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/concurrency"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/external_services"
+)
+
+// DefaultChunkChars keeps each backend call well below typical request limits.
+const DefaultChunkChars = 1500
+
+const (
+	// memoryRefresh is how long editor corrections are cached before reloading
+	memoryRefresh = time.Minute
+	// maxMemoryPhraseChars keeps whole paragraphs out of the phrase glossary;
+	// they still match exactly through the memory lookup
+	maxMemoryPhraseChars = 200
+	maxMemoryEntries     = 5000
+)
+
+// Client wraps a translation backend with glossary protection, translation
+// memory and sentence-aware chunking of long texts.
+type Client struct {
+	backend    contract.ITranslationClient
+	glossary   *Glossary
+	chunkChars int
+
+	meter    contract.IUsageMeter
+	provider string
+	// limit bounds the backend calls in flight
+	limit *concurrency.Limiter
+
+	memory   contract.ITranslationMemoryRepository
+	mu       sync.Mutex
+	merged   *Glossary
+	mergedAt time.Time
+}
+
+func NewClient(backend contract.ITranslationClient, glossary *Glossary, chunkChars int) *Client {
+	if chunkChars <= 0 {
+		chunkChars = DefaultChunkChars
+	}
+	return &Client{backend: backend, glossary: glossary, chunkChars: chunkChars}
+}
+
+// WithMemory makes editor-approved translations override the backend: an exact
+// match returns the stored translation and shorter phrases are applied like
+// glossary terms.
+func (c *Client) WithMemory(memory contract.ITranslationMemoryRepository) *Client {
+	c.memory = memory
+	return c
+}
+
+// WithMeter checks budgets before and records usage after every backend call.
+// Memory hits are free and not recorded.
+func (c *Client) WithMeter(meter contract.IUsageMeter, provider string) *Client {
+	c.meter, c.provider = meter, provider
+	return c
+}
+
+// WithConcurrency allows at most n backend calls in flight; n <= 0 removes
+// the bound.
+func (c *Client) WithConcurrency(n int) *Client {
+	c.limit = concurrency.NewLimiter(n)
+	return c
+}
+
+func (c *Client) Translate(ctx context.Context, text, sourceLang, targetLang string) (string, error) {
+	if strings.TrimSpace(text) == "" || sourceLang == targetLang {
+		return text, nil
+	}
+	if c.memory != nil {
+		lookupCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		e, err := c.memory.Find(lookupCtx, sourceLang, targetLang, entity.TranslationMemoryKey(text))
+		cancel()
+		if err == nil && e.Target != "" {
+			return e.Target, nil
+		}
+	}
+	if c.meter == nil {
+		return c.translate(ctx, text, sourceLang, targetLang)
+	}
+	if err := c.meter.Allow(ctx); err != nil {
+		return "", err
+	}
+	start := time.Now()
+	out, err := c.translate(ctx, text, sourceLang, targetLang)
+	usage := &entity.LLMUsage{
+		Kind:       entity.UsageTranslation,
+		Operation:  contract.UsageOperationFrom(ctx, "translate"),
+		Provider:   c.provider,
+		Characters: utf8.RuneCountInString(text),
+		LatencyMS:  time.Since(start).Milliseconds(),
+		Success:    err == nil,
+	}
+	if err != nil {
+		usage.Error = err.Error()
+	}
+	c.meter.Record(ctx, usage)
+	return out, err
+}
+
+// translate protects glossary terms and sends the text to the backend chunk by chunk.
+func (c *Client) translate(ctx context.Context, text, sourceLang, targetLang string) (string, error) {
+	var targets []string
+	glossary := c.activeGlossary()
+	if glossary != nil {
+		text, targets = glossary.Protect(text, sourceLang, targetLang)
+	}
+	var out strings.Builder
+	for _, chunk := range Chunk(text, c.chunkChars) {
+		body := strings.TrimSpace(chunk)
+		if body == "" {
+			out.WriteString(chunk)
+			continue
+		}
+		translated, err := c.translateChunk(ctx, body, sourceLang, targetLang)
+		if err != nil {
+			return "", err
+		}
+		// keep the whitespace that separated this chunk from the next
+		out.WriteString(strings.TrimSpace(translated))
+		out.WriteString(chunk[len(strings.TrimRight(chunk, " \t\r\n")):])
+	}
+	if glossary != nil {
+		return glossary.Restore(out.String(), targets), nil
+	}
+	return out.String(), nil
+}
+
+// translateChunk sends one chunk once a backend slot is free. The googletrans
+// backend ignores ctx, so cancellation is checked before each call.
+func (c *Client) translateChunk(ctx context.Context, text, sourceLang, targetLang string) (string, error) {
+	if err := c.limit.Acquire(ctx); err != nil {
+		return "", err
+	}
+	defer c.limit.Release()
+	return c.backend.Translate(ctx, text, sourceLang, targetLang)
+}
+
+// activeGlossary returns the static glossary extended with short translation
+// memory phrases, reloading them at most once per memoryRefresh.
+func (c *Client) activeGlossary() *Glossary {
+	if c.memory == nil || c.glossary == nil {
+		return c.glossary
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.merged != nil && time.Since(c.mergedAt) < memoryRefresh {
+		return c.merged
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	entries, err := c.memory.List(ctx, "", "", maxMemoryEntries)
+	if err != nil {
+		// keep serving the last good set rather than failing translations
+		if c.merged != nil {
+			return c.merged
+		}
+		return c.glossary
+	}
+	var terms []Term
+	for _, e := range entries {
+		if utf8.RuneCountInString(e.Source) > maxMemoryPhraseChars {
+			continue
+		}
+		switch {
+		case e.SourceLang == "en" && e.TargetLang == "am":
+			terms = append(terms, Term{EN: e.Source, AM: e.Target})
+		case e.SourceLang == "am" && e.TargetLang == "en":
+			terms = append(terms, Term{EN: e.Target, AM: e.Source})
+		}
+	}
+	c.merged, c.mergedAt = c.glossary.Extend(terms), time.Now()
+	return c.merged
+}
+
+// NewFromEnv builds the translation client selected by TRANSLATION_BACKEND, backed
+// by the given translation memory and usage meter (both may be nil); prompts
+// renders the "translate" prompt of the llm backend:
+// "googletrans" (default), "http" (LibreTranslate-compatible service at
+// TRANSLATION_API_URL), "llm" (Gemini) or "fake". TRANSLATION_MAX_CONCURRENCY
+// bounds the backend calls in flight (default 4, 0 for no bound).
+func NewFromEnv(gemini contract.IGeminiClient, prompts contract.IPromptRegistry, memory contract.ITranslationMemoryRepository, meter contract.IUsageMeter) (contract.ITranslationClient, error) {
+	var backend contract.ITranslationClient
+	name := strings.ToLower(os.Getenv("TRANSLATION_BACKEND"))
+	switch name {
+	case "", "googletrans":
+		name = "googletrans"
+		backend = external_services.NewTranslatorClient()
+	case "http":
+		url := os.Getenv("TRANSLATION_API_URL")
+		if url == "" {
+			return nil, fmt.Errorf("TRANSLATION_API_URL is required for the http translation backend")
+		}
+		backend = external_services.NewHTTPTranslationClient(url, os.Getenv("TRANSLATION_API_KEY"))
+	case "llm":
+		backend = external_services.NewLLMTranslationClient(gemini, prompts)
+	case "fake":
+		backend = external_services.NewFakeTranslationClient()
+	default:
+		return nil, fmt.Errorf("unknown TRANSLATION_BACKEND %q", name)
+	}
+	glossary, err := LoadGlossary(os.Getenv("TRANSLATION_GLOSSARY_FILE"))
+	if err != nil {
+		return nil, err
+	}
+	chunkChars, _ := strconv.Atoi(os.Getenv("TRANSLATION_CHUNK_CHARS"))
+	client := NewClient(backend, glossary, chunkChars)
+	// the public Google endpoint throttles bursts; default to a few calls at a time
+	maxConcurrent := 4
+	if v, err := strconv.Atoi(os.Getenv("TRANSLATION_MAX_CONCURRENCY")); err == nil && v >= 0 {
+		maxConcurrent = v
+	}
+	client.WithConcurrency(maxConcurrent)
+	if memory != nil {
+		client.WithMemory(memory)
+	}
+	// LLM translations are already metered by the Gemini client
+	if meter != nil && name != "llm" {
+		client.WithMeter(meter, name)
+	}
+	return client, nil
+}
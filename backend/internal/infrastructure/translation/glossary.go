@@ -0,0 +1,143 @@
+package translation
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed glossary_et.json
+var defaultGlossary []byte
+
+// Term is one glossary entry: the fixed rendering of a proper noun in each language.
+type Term struct {
+	EN string `json:"en"`
+	AM string `json:"am"`
+}
+
+// Glossary pins the translation of Ethiopian names, places and institutions.
+// Matching terms are swapped for placeholders before the text reaches the
+// backend and replaced with the target-language form afterwards.
+type Glossary struct {
+	terms    []Term
+	patterns map[string]*regexp.Regexp
+}
+
+var placeholderPattern = regexp.MustCompile(`\[\[\s*G\s*(\d+)\s*\]\]`)
+
+// LoadGlossary returns the built-in glossary, extended with the entries of the
+// JSON file at extraPath when it is not empty.
+func LoadGlossary(extraPath string) (*Glossary, error) {
+	var terms []Term
+	if err := json.Unmarshal(defaultGlossary, &terms); err != nil {
+		return nil, fmt.Errorf("built-in glossary: %w", err)
+	}
+	if extraPath != "" {
+		b, err := os.ReadFile(extraPath)
+		if err != nil {
+			return nil, err
+		}
+		var extra []Term
+		if err := json.Unmarshal(b, &extra); err != nil {
+			return nil, fmt.Errorf("glossary %s: %w", extraPath, err)
+		}
+		terms = append(terms, extra...)
+	}
+	return NewGlossary(terms), nil
+}
+
+func NewGlossary(terms []Term) *Glossary {
+	g := &Glossary{terms: terms, patterns: map[string]*regexp.Regexp{}}
+	g.patterns["en"] = g.compile(func(t Term) string { return t.EN }, true)
+	g.patterns["am"] = g.compile(func(t Term) string { return t.AM }, false)
+	return g
+}
+
+// Extend returns a glossary with the given terms placed ahead of the existing
+// ones, so they win when both define the same source phrase.
+func (g *Glossary) Extend(terms []Term) *Glossary {
+	if len(terms) == 0 {
+		return g
+	}
+	return NewGlossary(append(append([]Term{}, terms...), g.terms...))
+}
+
+// compile builds one alternation per language, longest terms first so that
+// "Addis Ababa University" wins over "Addis Ababa".
+func (g *Glossary) compile(field func(Term) string, wordBounded bool) *regexp.Regexp {
+	var alts []string
+	for _, t := range g.terms {
+		if v := strings.TrimSpace(field(t)); v != "" {
+			alts = append(alts, regexp.QuoteMeta(v))
+		}
+	}
+	if len(alts) == 0 {
+		return nil
+	}
+	sort.SliceStable(alts, func(i, j int) bool { return len(alts[i]) > len(alts[j]) })
+	expr := "(" + strings.Join(alts, "|") + ")"
+	if wordBounded {
+		expr = `(?i)\b` + expr + `\b`
+	}
+	return regexp.MustCompile(expr)
+}
+
+// Protect replaces known terms in the source language with placeholders and
+// returns the target-language forms indexed by placeholder number.
+func (g *Glossary) Protect(text, sourceLang, targetLang string) (string, []string) {
+	re := g.patterns[sourceLang]
+	if re == nil || sourceLang == targetLang {
+		return text, nil
+	}
+	var targets []string
+	out := re.ReplaceAllStringFunc(text, func(m string) string {
+		target := g.lookup(m, sourceLang, targetLang)
+		if target == "" {
+			return m
+		}
+		targets = append(targets, target)
+		return "[[G" + strconv.Itoa(len(targets)-1) + "]]"
+	})
+	return out, targets
+}
+
+// Restore swaps placeholders back for their target-language terms.
+func (g *Glossary) Restore(text string, targets []string) string {
+	if len(targets) == 0 {
+		return text
+	}
+	return placeholderPattern.ReplaceAllStringFunc(text, func(m string) string {
+		i, err := strconv.Atoi(placeholderPattern.FindStringSubmatch(m)[1])
+		if err != nil || i >= len(targets) {
+			return m
+		}
+		return targets[i]
+	})
+}
+
+func (g *Glossary) lookup(match, sourceLang, targetLang string) string {
+	for _, t := range g.terms {
+		var src, tgt string
+		switch sourceLang {
+		case "en":
+			src = t.EN
+		case "am":
+			src = t.AM
+		}
+		switch targetLang {
+		case "en":
+			tgt = t.EN
+		case "am":
+			tgt = t.AM
+		}
+		if src != "" && strings.EqualFold(src, match) {
+			return tgt
+		}
+	}
+	return ""
+}
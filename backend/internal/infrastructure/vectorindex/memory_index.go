@@ -0,0 +1,117 @@
+package vectorindex
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// MemoryIndex is an in-process vector index using brute-force cosine similarity.
+// It is used when no persistent index is configured (local development) and
+// its ranking helpers back the Mongo fallback scan.
+type MemoryIndex struct {
+	mu    sync.RWMutex
+	items map[string]entity.NewsEmbedding
+}
+
+// NewMemoryIndex creates an empty in-process index.
+func NewMemoryIndex() *MemoryIndex {
+	return &MemoryIndex{items: map[string]entity.NewsEmbedding{}}
+}
+
+var _ contract.IVectorIndex = (*MemoryIndex)(nil)
+
+func (m *MemoryIndex) Upsert(ctx context.Context, e *entity.NewsEmbedding) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.items[e.NewsID] = *e
+	return nil
+}
+
+func (m *MemoryIndex) Search(ctx context.Context, vector []float32, k int, filter contract.VectorFilter) ([]contract.VectorMatch, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	candidates := make([]entity.NewsEmbedding, 0, len(m.items))
+	for _, e := range m.items {
+		candidates = append(candidates, e)
+	}
+	return Rank(vector, candidates, k, filter), nil
+}
+
+func (m *MemoryIndex) Get(ctx context.Context, newsID string) (*entity.NewsEmbedding, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	e, ok := m.items[newsID]
+	if !ok {
+		return nil, contract.ErrNotFound
+	}
+	return &e, nil
+}
+
+func (m *MemoryIndex) ExistingIDs(ctx context.Context, newsIDs []string) (map[string]bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	out := make(map[string]bool, len(newsIDs))
+	for _, id := range newsIDs {
+		if _, ok := m.items[id]; ok {
+			out[id] = true
+		}
+	}
+	return out, nil
+}
+
+// Rank scores candidates against the query vector, applies the filter and returns the top k.
+func Rank(vector []float32, candidates []entity.NewsEmbedding, k int, filter contract.VectorFilter) []contract.VectorMatch {
+	excluded := make(map[string]struct{}, len(filter.ExcludeNewsIDs))
+	for _, id := range filter.ExcludeNewsIDs {
+		excluded[id] = struct{}{}
+	}
+	matches := make([]contract.VectorMatch, 0, len(candidates))
+	for _, e := range candidates {
+		if _, skip := excluded[e.NewsID]; skip {
+			continue
+		}
+		if !filter.PublishedAfter.IsZero() && e.PublishedAt.Before(filter.PublishedAfter) {
+			continue
+		}
+		if !filter.PublishedBefore.IsZero() && e.PublishedAt.After(filter.PublishedBefore) {
+			continue
+		}
+		if filter.ExcludeSourceURL != "" && e.SourceURL == filter.ExcludeSourceURL {
+			continue
+		}
+		matches = append(matches, contract.VectorMatch{
+			NewsID:      e.NewsID,
+			Title:       e.Title,
+			SourceURL:   e.SourceURL,
+			PublishedAt: e.PublishedAt,
+			Score:       Cosine(vector, e.Vector),
+		})
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	if k > 0 && len(matches) > k {
+		matches = matches[:k]
+	}
+	return matches
+}
+
+// Cosine returns the cosine similarity of two vectors (0 when either is empty or lengths differ).
+func Cosine(a, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+const (
+	// apiKeyPrefix starts every key; the public part is apiKeyPrefix plus 8 hex characters
+	apiKeyPrefix = "nbk_"
+	apiKeyIDLen  = len(apiKeyPrefix) + 8
+	// signatureSkew is how far a signed request's timestamp may be from now
+	signatureSkew = 5 * time.Minute
+	// lastUsedEvery throttles last-used writes for busy keys
+	lastUsedEvery = time.Minute
+	// defaultRotationGrace keeps a rotated key working while clients switch over
+	defaultRotationGrace = 24 * time.Hour
+	maxRotationGrace     = 30 * 24 * time.Hour
+)
+
+type apiKeyUsecase struct {
+	repo      contract.IAPIKeyRepository
+	replay    contract.IReplayGuard
+	box       contract.ISecretBox
+	sources   contract.ISourceRepository
+	uuidGen   contract.IUUIDGenerator
+	randomGen contract.IRandomGenerator
+}
+
+// NewAPIKeyUsecase manages machine credentials. box may be nil, in which case
+// only bearer keys can be issued.
+func NewAPIKeyUsecase(repo contract.IAPIKeyRepository, replay contract.IReplayGuard, box contract.ISecretBox, sources contract.ISourceRepository, uuidGen contract.IUUIDGenerator, randomGen contract.IRandomGenerator) contract.IAPIKeyUsecase {
+	return &apiKeyUsecase{repo: repo, replay: replay, box: box, sources: sources, uuidGen: uuidGen, randomGen: randomGen}
+}
+
+func (uc *apiKeyUsecase) Issue(ctx context.Context, in contract.IssueAPIKeyInput) (*entity.APIKey, string, error) {
+	in.Name = strings.TrimSpace(in.Name)
+	if in.Name == "" {
+		return nil, "", fmt.Errorf("%w: name is required", contract.ErrInvalidInput)
+	}
+	if in.Kind == "" {
+		in.Kind = entity.APIKeyBearer
+	}
+	if in.Kind != entity.APIKeyBearer && in.Kind != entity.APIKeySigned {
+		return nil, "", fmt.Errorf("%w: kind must be bearer or signed", contract.ErrInvalidInput)
+	}
+	if in.Kind == entity.APIKeySigned && uc.box == nil {
+		return nil, "", fmt.Errorf("%w: signed keys need WEBHOOK_SECRET_KEY to be configured", contract.ErrInvalidInput)
+	}
+	if len(in.Scopes) == 0 {
+		return nil, "", fmt.Errorf("%w: at least one scope is required", contract.ErrInvalidInput)
+	}
+	for _, s := range in.Scopes {
+		if !containsString(entity.APIKeyScopes, s) {
+			return nil, "", fmt.Errorf("%w: unknown scope %q", contract.ErrInvalidInput, s)
+		}
+	}
+	if in.ExpiresAt != nil && !in.ExpiresAt.After(time.Now()) {
+		return nil, "", fmt.Errorf("%w: expiry must be in the future", contract.ErrInvalidInput)
+	}
+	key := &entity.APIKey{
+		ID:        uc.uuidGen.NewUUID(),
+		Name:      in.Name,
+		Kind:      in.Kind,
+		Scopes:    in.Scopes,
+		CreatedBy: in.CreatedBy,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: in.ExpiresAt,
+	}
+	if in.SourceSlug != "" {
+		src, err := uc.sources.GetBySlug(ctx, in.SourceSlug)
+		if err != nil || src == nil {
+			return nil, "", fmt.Errorf("%w: unknown source %q", contract.ErrInvalidInput, in.SourceSlug)
+		}
+		key.SourceID = src.ID
+	}
+	full, err := uc.create(ctx, key)
+	if err != nil {
+		return nil, "", err
+	}
+	return key, full, nil
+}
+
+// create generates the secret, stores the key and returns the full key string.
+func (uc *apiKeyUsecase) create(ctx context.Context, key *entity.APIKey) (string, error) {
+	secret, err := uc.randomGen.GenerateRandomToken(32)
+	if err != nil {
+		return "", err
+	}
+	key.Prefix = apiKeyPrefix + strings.ReplaceAll(uc.uuidGen.NewUUID(), "-", "")[:8]
+	switch key.Kind {
+	case entity.APIKeySigned:
+		if key.SecretSealed, err = uc.box.Seal([]byte(secret)); err != nil {
+			return "", err
+		}
+	default:
+		key.SecretHash = hashSecret(secret)
+	}
+	if err := uc.repo.Create(ctx, key); err != nil {
+		return "", err
+	}
+	return key.Prefix + "_" + secret, nil
+}
+
+func (uc *apiKeyUsecase) Rotate(ctx context.Context, id string, grace time.Duration, by string) (*entity.APIKey, string, error) {
+	old, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	now := time.Now().UTC()
+	if !old.Active(now) {
+		return nil, "", fmt.Errorf("%w: key is revoked or expired", contract.ErrInvalidInput)
+	}
+	if old.Kind == entity.APIKeySigned && uc.box == nil {
+		return nil, "", fmt.Errorf("%w: signed keys need WEBHOOK_SECRET_KEY to be configured", contract.ErrInvalidInput)
+	}
+	if grace <= 0 {
+		grace = defaultRotationGrace
+	}
+	if grace > maxRotationGrace {
+		return nil, "", fmt.Errorf("%w: grace period is at most %d days", contract.ErrInvalidInput, int(maxRotationGrace.Hours()/24))
+	}
+	key := &entity.APIKey{
+		ID:        uc.uuidGen.NewUUID(),
+		Name:      old.Name,
+		Kind:      old.Kind,
+		Scopes:    old.Scopes,
+		SourceID:  old.SourceID,
+		CreatedBy: by,
+		CreatedAt: now,
+		ExpiresAt: old.ExpiresAt,
+	}
+	full, err := uc.create(ctx, key)
+	if err != nil {
+		return nil, "", err
+	}
+	until := now.Add(grace)
+	if old.ExpiresAt != nil && old.ExpiresAt.Before(until) {
+		until = *old.ExpiresAt
+	}
+	if err := uc.repo.MarkRotated(ctx, old.ID, key.ID, until); err != nil {
+		return nil, "", err
+	}
+	return key, full, nil
+}
+
+func (uc *apiKeyUsecase) Revoke(ctx context.Context, id string) error {
+	return uc.repo.Revoke(ctx, id, time.Now().UTC())
+}
+
+func (uc *apiKeyUsecase) List(ctx context.Context, includeRevoked bool) ([]*entity.APIKey, error) {
+	return uc.repo.List(ctx, includeRevoked)
+}
+
+func (uc *apiKeyUsecase) Authenticate(ctx context.Context, req contract.MachineRequest, scope string) (*entity.APIKey, error) {
+	var (
+		key *entity.APIKey
+		err error
+	)
+	switch {
+	case req.APIKey != "":
+		key, err = uc.authenticateBearer(ctx, req.APIKey)
+	case req.KeyPrefix != "" || req.Signature != "":
+		key, err = uc.authenticateSigned(ctx, req)
+	default:
+		return nil, fmt.Errorf("%w: API key or request signature required", contract.ErrUnauthorized)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !key.HasScope(scope) {
+		return nil, fmt.Errorf("%w: key lacks scope %s", contract.ErrForbidden, scope)
+	}
+	now := time.Now().UTC()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedEvery {
+		touchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 3*time.Second)
+		_ = uc.repo.TouchLastUsed(touchCtx, key.ID, now, req.ClientIP)
+		cancel()
+	}
+	return key, nil
+}
+
+func (uc *apiKeyUsecase) authenticateBearer(ctx context.Context, full string) (*entity.APIKey, error) {
+	if len(full) <= apiKeyIDLen+1 || !strings.HasPrefix(full, apiKeyPrefix) || full[apiKeyIDLen] != '_' {
+		return nil, fmt.Errorf("%w: malformed API key", contract.ErrUnauthorized)
+	}
+	key, err := uc.activeKey(ctx, full[:apiKeyIDLen])
+	if err != nil {
+		return nil, err
+	}
+	if key.Kind != entity.APIKeyBearer {
+		return nil, fmt.Errorf("%w: signed keys must sign requests", contract.ErrUnauthorized)
+	}
+	if subtle.ConstantTimeCompare([]byte(hashSecret(full[apiKeyIDLen+1:])), []byte(key.SecretHash)) != 1 {
+		return nil, fmt.Errorf("%w: invalid API key", contract.ErrUnauthorized)
+	}
+	return key, nil
+}
+
+// authenticateSigned verifies "sha256=" + hex(HMAC-SHA256(secret, timestamp + "." + body))
+// and rejects timestamps outside signatureSkew and signatures seen before.
+func (uc *apiKeyUsecase) authenticateSigned(ctx context.Context, req contract.MachineRequest) (*entity.APIKey, error) {
+	if req.KeyPrefix == "" || req.Timestamp == "" || req.Signature == "" {
+		return nil, fmt.Errorf("%w: signed requests need key, timestamp and signature headers", contract.ErrUnauthorized)
+	}
+	sec, err := strconv.ParseInt(req.Timestamp, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: timestamp must be Unix seconds", contract.ErrUnauthorized)
+	}
+	ts := time.Unix(sec, 0)
+	if d := time.Since(ts); d > signatureSkew || d < -signatureSkew {
+		return nil, fmt.Errorf("%w: timestamp outside the allowed window", contract.ErrUnauthorized)
+	}
+	key, err := uc.activeKey(ctx, req.KeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	if key.Kind != entity.APIKeySigned || uc.box == nil {
+		return nil, fmt.Errorf("%w: key cannot sign requests", contract.ErrUnauthorized)
+	}
+	secret, err := uc.box.Open(key.SecretSealed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: key secret unavailable", contract.ErrUnauthorized)
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(req.Timestamp + "."))
+	mac.Write(req.Body)
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(want), []byte(strings.ToLower(req.Signature))) {
+		return nil, fmt.Errorf("%w: invalid signature", contract.ErrUnauthorized)
+	}
+	fresh, err := uc.replay.Claim(ctx, key.Prefix+":"+want, ts.Add(signatureSkew))
+	if err != nil {
+		return nil, err
+	}
+	if !fresh {
+		return nil, fmt.Errorf("%w: request was already received", contract.ErrUnauthorized)
+	}
+	return key, nil
+}
+
+func (uc *apiKeyUsecase) activeKey(ctx context.Context, prefix string) (*entity.APIKey, error) {
+	key, err := uc.repo.FindByPrefix(ctx, prefix)
+	if errors.Is(err, contract.ErrNotFound) {
+		return nil, fmt.Errorf("%w: unknown API key", contract.ErrUnauthorized)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !key.Active(time.Now()) {
+		return nil, fmt.Errorf("%w: API key is revoked or expired", contract.ErrUnauthorized)
+	}
+	return key, nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// keyRepo serves keys by prefix; the other repository methods are not used.
+type keyRepo struct {
+	contract.IAPIKeyRepository
+	keys map[string]*entity.APIKey
+}
+
+func (r *keyRepo) FindByPrefix(_ context.Context, prefix string) (*entity.APIKey, error) {
+	if k, ok := r.keys[prefix]; ok {
+		return k, nil
+	}
+	return nil, contract.ErrNotFound
+}
+
+func (r *keyRepo) TouchLastUsed(context.Context, string, time.Time, string) error { return nil }
+
+type memoryReplay map[string]bool
+
+func (m memoryReplay) Claim(_ context.Context, nonce string, _ time.Time) (bool, error) {
+	if m[nonce] {
+		return false, nil
+	}
+	m[nonce] = true
+	return true, nil
+}
+
+// plainBox "seals" by prefixing, which is enough to exercise Open.
+type plainBox struct{}
+
+func (plainBox) Seal(plain []byte) (string, error) { return "sealed:" + string(plain), nil }
+
+func (plainBox) Open(sealed string) ([]byte, error) {
+	plain, ok := strings.CutPrefix(sealed, "sealed:")
+	if !ok {
+		return nil, errors.New("not sealed")
+	}
+	return []byte(plain), nil
+}
+
+func sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestAuthenticateSigned(t *testing.T) {
+	const (
+		prefix = "nbk_0123abcd"
+		secret = "s3cret"
+	)
+	body := []byte(`{"title":"Addis Ababa light rail extended"}`)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	stale := strconv.FormatInt(time.Now().Add(-signatureSkew-time.Minute).Unix(), 10)
+	future := strconv.FormatInt(time.Now().Add(signatureSkew+time.Minute).Unix(), 10)
+	revoked := time.Now().Add(-time.Hour)
+
+	cases := []struct {
+		name string
+		key  entity.APIKey
+		req  contract.MachineRequest
+		// err is the expected error text; empty means the request is accepted
+		err string
+	}{
+		{
+			name: "valid",
+			req:  contract.MachineRequest{KeyPrefix: prefix, Timestamp: now, Signature: sign(secret, now, body), Body: body},
+		},
+		{
+			name: "upper-case hex",
+			req:  contract.MachineRequest{KeyPrefix: prefix, Timestamp: now, Signature: strings.ToUpper(sign(secret, now, body)), Body: body},
+		},
+		{
+			name: "tampered body",
+			req:  contract.MachineRequest{KeyPrefix: prefix, Timestamp: now, Signature: sign(secret, now, body), Body: append([]byte(" "), body...)},
+			err:  "invalid signature",
+		},
+		{
+			name: "wrong secret",
+			req:  contract.MachineRequest{KeyPrefix: prefix, Timestamp: now, Signature: sign("other", now, body), Body: body},
+			err:  "invalid signature",
+		},
+		{
+			name: "timestamp not signed",
+			req:  contract.MachineRequest{KeyPrefix: prefix, Timestamp: now, Signature: sign(secret, stale, body), Body: body},
+			err:  "invalid signature",
+		},
+		{
+			name: "missing prefix",
+			req:  contract.MachineRequest{KeyPrefix: prefix, Timestamp: now, Signature: strings.TrimPrefix(sign(secret, now, body), "sha256="), Body: body},
+			err:  "invalid signature",
+		},
+		{
+			name: "stale timestamp",
+			req:  contract.MachineRequest{KeyPrefix: prefix, Timestamp: stale, Signature: sign(secret, stale, body), Body: body},
+			err:  "outside the allowed window",
+		},
+		{
+			name: "future timestamp",
+			req:  contract.MachineRequest{KeyPrefix: prefix, Timestamp: future, Signature: sign(secret, future, body), Body: body},
+			err:  "outside the allowed window",
+		},
+		{
+			name: "timestamp not numeric",
+			req:  contract.MachineRequest{KeyPrefix: prefix, Timestamp: "yesterday", Signature: sign(secret, "yesterday", body), Body: body},
+			err:  "Unix seconds",
+		},
+		{
+			name: "missing headers",
+			req:  contract.MachineRequest{KeyPrefix: prefix, Signature: sign(secret, now, body), Body: body},
+			err:  "need key, timestamp and signature",
+		},
+		{
+			name: "unknown key",
+			req:  contract.MachineRequest{KeyPrefix: "nbk_ffffffff", Timestamp: now, Signature: sign(secret, now, body), Body: body},
+			err:  "unknown API key",
+		},
+		{
+			name: "revoked key",
+			key:  entity.APIKey{RevokedAt: &revoked},
+			req:  contract.MachineRequest{KeyPrefix: prefix, Timestamp: now, Signature: sign(secret, now, body), Body: body},
+			err:  "revoked or expired",
+		},
+		{
+			name: "bearer key",
+			key:  entity.APIKey{Kind: entity.APIKeyBearer},
+			req:  contract.MachineRequest{KeyPrefix: prefix, Timestamp: now, Signature: sign(secret, now, body), Body: body},
+			err:  "cannot sign requests",
+		},
+		{
+			name: "missing scope",
+			key:  entity.APIKey{Scopes: []string{"other"}},
+			req:  contract.MachineRequest{KeyPrefix: prefix, Timestamp: now, Signature: sign(secret, now, body), Body: body},
+			err:  "lacks scope",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			key := c.key
+			key.ID, key.Prefix, key.SecretSealed = "key-1", prefix, "sealed:"+secret
+			if key.Kind == "" {
+				key.Kind = entity.APIKeySigned
+			}
+			if key.Scopes == nil {
+				key.Scopes = []string{entity.ScopeNewsIngest}
+			}
+			uc := NewAPIKeyUsecase(&keyRepo{keys: map[string]*entity.APIKey{prefix: &key}}, memoryReplay{}, plainBox{}, nil, nil, nil)
+			got, err := uc.Authenticate(context.Background(), c.req, entity.ScopeNewsIngest)
+			if c.err == "" {
+				if err != nil {
+					t.Fatalf("rejected: %v", err)
+				}
+				if got.ID != key.ID {
+					t.Fatalf("authenticated %q, want %q", got.ID, key.ID)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("accepted, want %q", c.err)
+			}
+			if !strings.Contains(err.Error(), c.err) {
+				t.Fatalf("error %q, want %q", err, c.err)
+			}
+			wantKind := contract.ErrUnauthorized
+			if c.err == "lacks scope" {
+				wantKind = contract.ErrForbidden
+			}
+			if !errors.Is(err, wantKind) {
+				t.Fatalf("error %v is not %v", err, wantKind)
+			}
+		})
+	}
+}
+
+func TestAuthenticateSignedReplay(t *testing.T) {
+	const prefix = "nbk_0123abcd"
+	key := &entity.APIKey{ID: "key-1", Kind: entity.APIKeySigned, Prefix: prefix, SecretSealed: "sealed:s3cret", Scopes: []string{entity.ScopeNewsIngest}}
+	uc := NewAPIKeyUsecase(&keyRepo{keys: map[string]*entity.APIKey{prefix: key}}, memoryReplay{}, plainBox{}, nil, nil, nil)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	body := []byte(`{}`)
+	req := contract.MachineRequest{KeyPrefix: prefix, Timestamp: now, Signature: sign("s3cret", now, body), Body: body}
+
+	if _, err := uc.Authenticate(context.Background(), req, entity.ScopeNewsIngest); err != nil {
+		t.Fatalf("first request rejected: %v", err)
+	}
+	_, err := uc.Authenticate(context.Background(), req, entity.ScopeNewsIngest)
+	if err == nil || !strings.Contains(err.Error(), "already received") {
+		t.Fatalf("replayed request: got %v, want a replay error", err)
+	}
+}
@@ -0,0 +1,234 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+const (
+	// maxChatArticles bounds the articles placed in one multi-article prompt
+	maxChatArticles = 8
+	// chatArticleChars bounds the text of each article in that prompt
+	chatArticleChars = 1500
+)
+
+// ChatArticles answers over the selected articles with per-source attribution.
+func (uc *ChatbotUsecase) ChatArticles(ctx context.Context, set contract.ArticleSet, sessionID, message string) (entity.ChatReply, error) {
+	lang := "en"
+	if isAmharic(message) {
+		lang = "am"
+	}
+	if refusal, blocked := uc.screen(ctx, "chat_articles", sessionID, lang, message); blocked {
+		return refusal, nil
+	}
+	articles, err := uc.resolveArticles(ctx, set)
+	if err != nil {
+		return entity.ChatReply{}, err
+	}
+	names := uc.sourceNames(ctx)
+	system, err := uc.prompts.Render(ctx, "chat_articles", lang, sessionID, map[string]string{"articles": attributedArticles(articles, names, lang)})
+	if err != nil {
+		return entity.ChatReply{}, err
+	}
+	reply, err := uc.geminiClient.Chat(ctx, []string{message}, system.Text)
+	citations := citationsFor(reply, articles)
+	for i := range citations {
+		for _, n := range articles {
+			if n.ID == citations[i].NewsID {
+				citations[i].Source = names[n.SourceID]
+			}
+		}
+	}
+	out, err := uc.moderate(ctx, "chat_articles", sessionID, lang, message, entity.ChatReply{Reply: reply, Citations: citations}, err)
+	if err != nil {
+		return entity.ChatReply{}, err
+	}
+	var titles strings.Builder
+	for _, n := range articles {
+		fmt.Fprintf(&titles, "- %s (%s)\n", firstNonEmpty(n.TitleEN, n.Title), names[n.SourceID])
+	}
+	return uc.withFollowUps(ctx, sessionID, "", lang, message, titles.String(), out, nil), nil
+}
+
+// CompareCoverage contrasts the selected articles, which should come from at
+// least two different sources.
+func (uc *ChatbotUsecase) CompareCoverage(ctx context.Context, set contract.ArticleSet, lang string) (*entity.CoverageComparison, error) {
+	if lang != "am" {
+		lang = "en"
+	}
+	articles, err := uc.resolveArticles(ctx, set)
+	if err != nil {
+		return nil, err
+	}
+	sources := map[string]bool{}
+	for _, n := range articles {
+		sources[firstNonEmpty(n.SourceID, n.ID)] = true
+	}
+	if len(sources) < 2 {
+		return nil, fmt.Errorf("%w: comparing coverage needs articles from at least two sources", contract.ErrInvalidInput)
+	}
+	names := uc.sourceNames(ctx)
+	key := strings.Join(set.NewsIDs, ",") + set.StoryID + set.TopicID
+	p, err := uc.prompts.Render(ctx, "compare_coverage", lang, key, map[string]string{"articles": attributedArticles(articles, names, lang)})
+	if err != nil {
+		return nil, err
+	}
+	raw, err := uc.geminiClient.Generate(contract.WithUsageOperation(ctx, "compare_coverage"), p.Text)
+	if err != nil {
+		return nil, err
+	}
+	var parsed struct {
+		Event       string   `json:"event"`
+		Summary     string   `json:"summary"`
+		Agreements  []string `json:"agreements"`
+		Differences []string `json:"differences"`
+		Sources     []struct {
+			Article  int    `json:"article"`
+			Emphasis string `json:"emphasis"`
+		} `json:"sources"`
+	}
+	if err := json.Unmarshal([]byte(extractJSON(raw)), &parsed); err != nil {
+		return nil, fmt.Errorf("parse coverage comparison: %w", err)
+	}
+	if uc.safety != nil {
+		if err := uc.safety.ModerateOutput(ctx, "compare_coverage", key, raw); err != nil {
+			return nil, err
+		}
+	}
+	emphasis := map[int]string{}
+	for _, s := range parsed.Sources {
+		emphasis[s.Article] = s.Emphasis
+	}
+	out := &entity.CoverageComparison{
+		Event:       parsed.Event,
+		Summary:     parsed.Summary,
+		Agreements:  nonNil(parsed.Agreements),
+		Differences: nonNil(parsed.Differences),
+		Sources:     make([]entity.SourceCoverage, 0, len(articles)),
+		Language:    lang,
+	}
+	for i, n := range articles {
+		out.Sources = append(out.Sources, entity.SourceCoverage{
+			NewsID:     n.ID,
+			Title:      articleTitle(n, lang),
+			SourceID:   n.SourceID,
+			SourceName: names[n.SourceID],
+			SourceURL:  n.SourceURL,
+			Emphasis:   emphasis[i+1],
+		})
+	}
+	return out, nil
+}
+
+// resolveArticles loads the articles of the set, newest first, capped at
+// maxChatArticles.
+func (uc *ChatbotUsecase) resolveArticles(ctx context.Context, set contract.ArticleSet) ([]*entity.News, error) {
+	var articles []*entity.News
+	switch {
+	case len(set.NewsIDs) > 0:
+		if len(set.NewsIDs) > maxChatArticles {
+			return nil, fmt.Errorf("%w: at most %d articles can be used at once", contract.ErrInvalidInput, maxChatArticles)
+		}
+		found, err := uc.newsRepo.FindByIDs(ctx, set.NewsIDs)
+		if err != nil {
+			return nil, err
+		}
+		articles = found
+	case set.StoryID != "":
+		if uc.stories == nil {
+			return nil, fmt.Errorf("%w: stories are not available", contract.ErrInvalidInput)
+		}
+		_, found, err := uc.stories.GetStory(ctx, set.StoryID)
+		if err != nil {
+			return nil, err
+		}
+		articles = found
+	case set.TopicID != "":
+		found, _, _, err := uc.newsRepo.FindByTopicID(ctx, set.TopicID, 1, maxChatArticles)
+		if err != nil {
+			return nil, err
+		}
+		articles = found
+	case set.BookmarksOf != "":
+		if uc.bookmarks == nil {
+			return nil, fmt.Errorf("%w: bookmarks are not available", contract.ErrInvalidInput)
+		}
+		marks, _, _, err := uc.bookmarks.ListByUser(ctx, set.BookmarksOf, 1, maxChatArticles)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]string, 0, len(marks))
+		for _, b := range marks {
+			ids = append(ids, b.NewsID)
+		}
+		if len(ids) > 0 {
+			found, err := uc.newsRepo.FindByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			articles = found
+		}
+	default:
+		return nil, fmt.Errorf("%w: select articles by news_ids, story_id, topic_id or bookmarks", contract.ErrInvalidInput)
+	}
+	if len(articles) == 0 {
+		return nil, contract.ErrNotFound
+	}
+	sort.SliceStable(articles, func(i, j int) bool { return articles[i].PublishedAt.After(articles[j].PublishedAt) })
+	if len(articles) > maxChatArticles {
+		articles = articles[:maxChatArticles]
+	}
+	return articles, nil
+}
+
+// sourceNames maps source IDs to outlet names; lookup failures leave
+// articles unattributed rather than failing the request.
+func (uc *ChatbotUsecase) sourceNames(ctx context.Context) map[string]string {
+	names := map[string]string{}
+	if uc.sources == nil {
+		return names
+	}
+	list, err := uc.sources.GetAll(ctx)
+	if err != nil {
+		return names
+	}
+	for _, s := range list {
+		names[s.ID] = s.Name
+	}
+	return names
+}
+
+// attributedArticles lists the articles with their source, as cited in the
+// multi-article prompts.
+func attributedArticles(articles []*entity.News, names map[string]string, lang string) string {
+	var b strings.Builder
+	for i, n := range articles {
+		source := firstNonEmpty(names[n.SourceID], "unknown source")
+		text := firstNonEmpty(n.SummaryEN, n.SummaryAM, n.BodyEN, n.Body)
+		if lang == "am" {
+			text = firstNonEmpty(n.SummaryAM, n.SummaryEN, n.BodyAM, n.Body)
+		}
+		fmt.Fprintf(&b, "[%d] %s — %s (published %s)\n%s\n\n", i+1, articleTitle(n, lang), source, n.PublishedAt.Format("2006-01-02"), truncateText(text, chatArticleChars))
+	}
+	return b.String()
+}
+
+func articleTitle(n *entity.News, lang string) string {
+	if lang == "am" {
+		return firstNonEmpty(n.TitleAM, n.Title)
+	}
+	return firstNonEmpty(n.TitleEN, n.Title)
+}
+
+func nonNil(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+	return list
+}
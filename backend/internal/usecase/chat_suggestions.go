@@ -0,0 +1,307 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+const (
+	// suggestedQuestionCount is how many starter questions are generated per language
+	suggestedQuestionCount = 4
+	// suggestionRetryAfter is how long a set missing a language is served
+	// before generation is tried again
+	suggestionRetryAfter = 6 * time.Hour
+	// suggestionTimeout bounds one generation of starter questions
+	suggestionTimeout = 2 * time.Minute
+	// followUpCount is how many follow-up questions accompany a chat reply
+	followUpCount = 3
+	// maxSuggestions caps what is kept from a model answer
+	maxSuggestions = 5
+
+	// chatSessionMessages is how many recent messages a session remembers
+	chatSessionMessages = 10
+	// chatSessionTTL forgets sessions idle for longer
+	chatSessionTTL = 30 * time.Minute
+	// chatMaxSessions bounds the memory held by session history
+	chatMaxSessions = 10000
+)
+
+// SuggestedQuestions returns the cached starter questions of the article, or
+// generates them in English and Amharic and stores them on the article. A set
+// missing a language is served as is until suggestionRetryAfter has passed.
+func (uc *ChatbotUsecase) SuggestedQuestions(ctx context.Context, newsID string) (entity.SuggestedQuestions, error) {
+	news, err := uc.newsRepo.FindByID(newsID)
+	if err != nil {
+		return entity.SuggestedQuestions{}, err
+	}
+	if q := news.SuggestedQuestions; q != nil && ((len(q.EN) > 0 && len(q.AM) > 0) || time.Since(q.GeneratedAt) < suggestionRetryAfter) {
+		return *q, nil
+	}
+
+	// concurrent readers of the article share one generation, which carries on
+	// for the next reader if this one gives up
+	uc.suggestMu.Lock()
+	c, ok := uc.suggesting[newsID]
+	if !ok {
+		c = &suggestionCall{done: make(chan struct{})}
+		uc.suggesting[newsID] = c
+		genCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), suggestionTimeout)
+		go func() {
+			defer cancel()
+			c.questions, c.err = uc.generateQuestions(genCtx, news)
+			uc.suggestMu.Lock()
+			delete(uc.suggesting, newsID)
+			uc.suggestMu.Unlock()
+			close(c.done)
+		}()
+	}
+	uc.suggestMu.Unlock()
+	select {
+	case <-c.done:
+		return c.questions, c.err
+	case <-ctx.Done():
+		return entity.SuggestedQuestions{}, ctx.Err()
+	}
+}
+
+type suggestionCall struct {
+	done      chan struct{}
+	questions entity.SuggestedQuestions
+	err       error
+}
+
+// generateQuestions fills the languages the article has no questions for and
+// stores the result, even when a language came back empty. It fails only
+// when no language has any questions and generation returned an error.
+func (uc *ChatbotUsecase) generateQuestions(ctx context.Context, news *entity.News) (entity.SuggestedQuestions, error) {
+	ctx = contract.WithUsageOperation(ctx, "suggest_questions")
+	out := entity.SuggestedQuestions{GeneratedAt: time.Now().UTC()}
+	if q := news.SuggestedQuestions; q != nil {
+		out.EN, out.AM, out.PromptVersion = q.EN, q.AM, q.PromptVersion
+	}
+	var firstErr error
+	for _, lang := range []string{"en", "am"} {
+		if len(out.ForLanguage(lang)) > 0 {
+			continue
+		}
+		questions, version, err := uc.questionsFor(ctx, news, lang)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if lang == "am" {
+			out.AM = questions
+		} else {
+			out.EN = questions
+		}
+		out.PromptVersion = version
+	}
+	if firstErr != nil && len(out.EN) == 0 && len(out.AM) == 0 {
+		return entity.SuggestedQuestions{}, firstErr
+	}
+	if out.EN == nil {
+		out.EN = []string{}
+	}
+	if out.AM == nil {
+		out.AM = []string{}
+	}
+	news.SuggestedQuestions = &out
+	if err := uc.newsRepo.UpdateFields(ctx, news, []string{"suggested_questions"}, nil); err != nil {
+		return entity.SuggestedQuestions{}, err
+	}
+	return out, nil
+}
+
+// questionsFor generates the starter questions of one language, returning them
+// with the prompt version used.
+func (uc *ChatbotUsecase) questionsFor(ctx context.Context, news *entity.News, lang string) ([]string, string, error) {
+	title, summary := firstNonEmpty(news.TitleEN, news.Title), firstNonEmpty(news.SummaryEN, news.SummaryAM)
+	if lang == "am" {
+		title, summary = firstNonEmpty(news.TitleAM, news.Title), firstNonEmpty(news.SummaryAM, news.SummaryEN)
+	}
+	if summary == "" {
+		summary = truncateText(firstNonEmpty(news.BodyEN, news.Body), 4000)
+	}
+	p, err := uc.prompts.Render(ctx, "suggest_questions", lang, news.ID, map[string]string{
+		"count":   strconv.Itoa(suggestedQuestionCount),
+		"title":   title,
+		"summary": summary,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	raw, err := uc.geminiClient.Generate(ctx, p.Text)
+	if err != nil {
+		return nil, "", fmt.Errorf("generate %s questions: %w", languageName(lang), err)
+	}
+	return uc.safeQuestions(ctx, "suggest_questions", news.ID, parseQuestions(raw, nil)), p.Version, nil
+}
+
+// followUps suggests what to ask next from the conversation so far. Failures
+// are not fatal to the reply: article chats fall back to the cached starter
+// questions that have not been asked yet.
+func (uc *ChatbotUsecase) followUps(ctx context.Context, key, contextID, lang, newsContext string, history []entity.ChatMessage, fallback []string) []string {
+	asked := make([]string, 0, len(history))
+	var conv strings.Builder
+	for _, m := range history {
+		if m.Role == "user" {
+			asked = append(asked, m.Text)
+			fmt.Fprintf(&conv, "Reader: %s\n", m.Text)
+		} else {
+			fmt.Fprintf(&conv, "Answer: %s\n", truncateText(m.Text, 1500))
+		}
+	}
+	p, err := uc.prompts.Render(ctx, "suggest_followups", lang, key, map[string]string{
+		"count":        strconv.Itoa(followUpCount),
+		"context":      newsContext,
+		"conversation": conv.String(),
+	})
+	if err == nil {
+		var raw string
+		raw, err = uc.geminiClient.Generate(contract.WithUsageOperation(ctx, "suggest_followups"), p.Text)
+		if err == nil {
+			if questions := uc.safeQuestions(ctx, "suggest_followups", contextID, parseQuestions(raw, asked)); len(questions) > 0 {
+				return questions
+			}
+		}
+	}
+	var out []string
+	for _, q := range fallback {
+		if !containsFold(asked, q) {
+			out = append(out, q)
+		}
+	}
+	return out
+}
+
+// safeQuestions drops suggestions the output checks reject.
+func (uc *ChatbotUsecase) safeQuestions(ctx context.Context, feature, key string, questions []string) []string {
+	if uc.safety == nil {
+		return questions
+	}
+	out := make([]string, 0, len(questions))
+	for _, q := range questions {
+		if uc.safety.ModerateOutput(ctx, feature, key, q) == nil {
+			out = append(out, q)
+		}
+	}
+	return out
+}
+
+// parseQuestions reads a JSON array of questions from a model answer, falling
+// back to one question per line. Duplicates and already asked questions are dropped.
+func parseQuestions(raw string, asked []string) []string {
+	var list []string
+	if err := json.Unmarshal([]byte(extractJSON(raw)), &list); err != nil {
+		list = nil
+		for _, line := range strings.Split(raw, "\n") {
+			list = append(list, strings.Trim(strings.TrimLeft(strings.TrimSpace(line), "-*•0123456789.) "), "\""))
+		}
+	}
+	out := []string{}
+	for _, q := range list {
+		q = strings.TrimSpace(q)
+		if q == "" || containsFold(out, q) || containsFold(asked, q) {
+			continue
+		}
+		out = append(out, q)
+		if len(out) == maxSuggestions {
+			break
+		}
+	}
+	return out
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(strings.TrimSpace(v), strings.TrimSpace(s)) {
+			return true
+		}
+	}
+	return false
+}
+
+// chatSessions keeps the recent messages of each chat session in memory, so
+// follow-up suggestions can build on the conversation so far.
+type chatSessions struct {
+	mu       sync.Mutex
+	sessions map[string]*chatSession
+}
+
+type chatSession struct {
+	messages []entity.ChatMessage
+	touched  time.Time
+}
+
+func newChatSessions() *chatSessions {
+	return &chatSessions{sessions: map[string]*chatSession{}}
+}
+
+// add appends messages to the session; an empty session ID is not remembered.
+func (s *chatSessions) add(sessionID string, messages ...entity.ChatMessage) {
+	if sessionID == "" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	sess, ok := s.sessions[sessionID]
+	if !ok {
+		if len(s.sessions) >= chatMaxSessions {
+			s.evict(now)
+		}
+		sess = &chatSession{}
+		s.sessions[sessionID] = sess
+	}
+	sess.messages = append(sess.messages, messages...)
+	if n := len(sess.messages); n > chatSessionMessages {
+		sess.messages = append([]entity.ChatMessage(nil), sess.messages[n-chatSessionMessages:]...)
+	}
+	sess.touched = now
+}
+
+// history returns the remembered messages of the session, optionally only
+// those about one article.
+func (s *chatSessions) history(sessionID, contextID string) []entity.ChatMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, ok := s.sessions[sessionID]
+	if !ok || time.Since(sess.touched) > chatSessionTTL {
+		return []entity.ChatMessage{}
+	}
+	out := []entity.ChatMessage{}
+	for _, m := range sess.messages {
+		if contextID == "" || m.ContextID == contextID {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// evict drops expired sessions, or the least recently used one when none expired.
+func (s *chatSessions) evict(now time.Time) {
+	var oldestID string
+	var oldest time.Time
+	for id, sess := range s.sessions {
+		if now.Sub(sess.touched) > chatSessionTTL {
+			delete(s.sessions, id)
+			continue
+		}
+		if oldestID == "" || sess.touched.Before(oldest) {
+			oldestID, oldest = id, sess.touched
+		}
+	}
+	if len(s.sessions) >= chatMaxSessions && oldestID != "" {
+		delete(s.sessions, oldestID)
+	}
+}
@@ -0,0 +1,320 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+const (
+	// maxToolSteps bounds the model turns that may request tool calls
+	maxToolSteps = 4
+	// maxToolCallsPerStep bounds the calls executed from one model turn
+	maxToolCallsPerStep = 4
+	// toolListLimit caps the articles returned by one listing tool
+	toolListLimit = 5
+	// toolSnippetChars bounds the summary included in listings
+	toolSnippetChars = 300
+	// toolHistoryMessages is how much of the session is replayed to the model
+	toolHistoryMessages = 6
+)
+
+func toolParams(required []string, props map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"type": "object", "properties": props, "required": required}
+}
+
+var limitParam = map[string]interface{}{"type": "integer", "description": fmt.Sprintf("Maximum number of articles, 1-%d", toolListLimit)}
+
+// newsTools are the read-only functions the general chatbot may call.
+var newsTools = []contract.ToolSpec{
+	{
+		Name:        "search_news",
+		Description: "Search stored news articles by meaning. Use for questions about a subject, person, place or event.",
+		Parameters: toolParams([]string{"query"}, map[string]interface{}{
+			"query": map[string]interface{}{"type": "string", "description": "What to search for"},
+			"limit": limitParam,
+		}),
+	},
+	{
+		Name:        "todays_headlines",
+		Description: "List today's headlines, newest first.",
+		Parameters:  toolParams([]string{}, map[string]interface{}{"limit": limitParam}),
+	},
+	{
+		Name:        "news_by_topic",
+		Description: "List the latest articles of a topic such as sports, politics or business. Accepts the topic slug or its English or Amharic name.",
+		Parameters: toolParams([]string{"topic"}, map[string]interface{}{
+			"topic": map[string]interface{}{"type": "string"},
+			"limit": limitParam,
+		}),
+	},
+	{
+		Name:        "news_by_source",
+		Description: "List the latest articles of a news outlet, by its name or slug.",
+		Parameters: toolParams([]string{"source"}, map[string]interface{}{
+			"source": map[string]interface{}{"type": "string"},
+			"limit":  limitParam,
+		}),
+	},
+	{
+		Name:        "article_summary",
+		Description: "Get the full summary of one article by the id returned from another tool.",
+		Parameters: toolParams([]string{"news_id"}, map[string]interface{}{
+			"news_id": map[string]interface{}{"type": "string"},
+		}),
+	},
+}
+
+// toolRun executes tool calls for one chat request and remembers the
+// articles they returned; an article's ref is its position in used, from 1.
+type toolRun struct {
+	uc    *ChatbotUsecase
+	lang  string
+	names map[string]string
+	used  []*entity.News
+	refs  map[string]int
+}
+
+// chatWithTools answers a general question by letting the model query the
+// news database through newsTools, within maxToolSteps.
+func (uc *ChatbotUsecase) chatWithTools(ctx context.Context, sessionID, lang, message string) (entity.ChatReply, error) {
+	system, err := uc.prompts.Render(ctx, "chat_tools", lang, sessionID, map[string]string{"today": time.Now().Format("2006-01-02")})
+	if err != nil {
+		return entity.ChatReply{}, err
+	}
+	run := &toolRun{uc: uc, lang: lang, names: uc.sourceNames(ctx), refs: map[string]int{}}
+
+	var turns []contract.ChatTurn
+	history := uc.sessions.history(sessionID, "")
+	if len(history) > toolHistoryMessages {
+		history = history[len(history)-toolHistoryMessages:]
+	}
+	for _, m := range history {
+		role := "user"
+		if m.Role != "user" {
+			role = "model"
+		}
+		turns = append(turns, contract.ChatTurn{Role: role, Text: m.Text})
+	}
+	turns = append(turns, contract.ChatTurn{Role: "user", Text: message})
+
+	for step := 0; step < maxToolSteps; step++ {
+		res, err := uc.geminiClient.ChatWithTools(ctx, turns, system.Text, newsTools)
+		if err != nil {
+			return entity.ChatReply{}, err
+		}
+		if len(res.Calls) == 0 {
+			return entity.ChatReply{Reply: res.Text, Citations: run.citations(res.Text)}, nil
+		}
+		calls := res.Calls
+		if len(calls) > maxToolCallsPerStep {
+			calls = calls[:maxToolCallsPerStep]
+		}
+		results := make([]contract.ToolResult, 0, len(calls))
+		for _, call := range calls {
+			results = append(results, contract.ToolResult{Name: call.Name, Response: run.call(ctx, call)})
+		}
+		turns = append(turns, contract.ChatTurn{Role: "model", Calls: calls}, contract.ChatTurn{Role: "tool", Results: results})
+	}
+
+	// step limit reached: answer from what the tools found so far
+	grounded, err := uc.prompts.Render(ctx, "chat_grounded", lang, sessionID, map[string]string{"articles": numberedArticles(run.used)})
+	if err != nil {
+		return entity.ChatReply{}, err
+	}
+	reply, err := uc.geminiClient.Chat(ctx, []string{message}, grounded.Text)
+	if err != nil {
+		return entity.ChatReply{}, err
+	}
+	return entity.ChatReply{Reply: reply, Citations: run.citations(reply)}, nil
+}
+
+// call executes one tool call; failures are reported to the model, not the user.
+func (r *toolRun) call(ctx context.Context, call contract.ToolCall) map[string]interface{} {
+	limit := argInt(call.Args, "limit", toolListLimit)
+	if limit < 1 || limit > toolListLimit {
+		limit = toolListLimit
+	}
+	var (
+		list []*entity.News
+		err  error
+	)
+	switch call.Name {
+	case "search_news":
+		list, err = r.search(ctx, argString(call.Args, "query"), limit)
+	case "todays_headlines":
+		list, _, _, err = r.uc.newsRepo.FindToday(limit)
+	case "news_by_topic":
+		list, err = r.byTopic(ctx, argString(call.Args, "topic"), limit)
+	case "news_by_source":
+		list, err = r.bySource(ctx, argString(call.Args, "source"), limit)
+	case "article_summary":
+		n, ferr := r.uc.newsRepo.FindByID(argString(call.Args, "news_id"))
+		if ferr != nil {
+			return map[string]interface{}{"error": "article not found"}
+		}
+		article, ok := r.describe(ctx, n, 0)
+		if !ok {
+			return map[string]interface{}{"error": "article withheld: its text contains instructions"}
+		}
+		return map[string]interface{}{"article": article}
+	default:
+		return map[string]interface{}{"error": "unknown tool " + call.Name}
+	}
+	if err != nil {
+		return map[string]interface{}{"error": err.Error()}
+	}
+	articles := make([]map[string]interface{}, 0, len(list))
+	for _, n := range list {
+		if article, ok := r.describe(ctx, n, toolSnippetChars); ok {
+			articles = append(articles, article)
+		}
+	}
+	return map[string]interface{}{"articles": articles, "count": len(articles)}
+}
+
+func (r *toolRun) search(ctx context.Context, query string, limit int) ([]*entity.News, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+	if r.uc.embeddings == nil {
+		return nil, fmt.Errorf("search is not available")
+	}
+	matches, err := r.uc.embeddings.SearchText(ctx, query, limit, contract.VectorFilter{})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(matches))
+	for _, m := range matches {
+		if m.Score >= chatMinScore {
+			ids = append(ids, m.NewsID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return r.uc.newsRepo.FindByIDs(ctx, ids)
+}
+
+func (r *toolRun) byTopic(ctx context.Context, topic string, limit int) ([]*entity.News, error) {
+	if r.uc.topics == nil {
+		return nil, fmt.Errorf("topics are not available")
+	}
+	want := strings.ToLower(strings.TrimSpace(topic))
+	all, err := r.uc.topics.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range all {
+		if strings.ToLower(t.Slug) == want || strings.ToLower(t.Label.EN) == want || t.Label.AM == topic {
+			list, _, _, err := r.uc.newsRepo.FindByTopicID(ctx, t.ID, 1, limit)
+			return list, err
+		}
+	}
+	return nil, fmt.Errorf("unknown topic %q", topic)
+}
+
+func (r *toolRun) bySource(ctx context.Context, source string, limit int) ([]*entity.News, error) {
+	if r.uc.sources == nil {
+		return nil, fmt.Errorf("sources are not available")
+	}
+	want := strings.ToLower(strings.TrimSpace(source))
+	all, err := r.uc.sources.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, s := range all {
+		if strings.ToLower(s.Slug) == want || strings.ToLower(s.Name) == want {
+			ids = append(ids, s.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("unknown source %q", source)
+	}
+	list, _, _, err := r.uc.newsRepo.FindBySourceIDs(ids, 1, limit)
+	return list, err
+}
+
+// describe renders an article for the model and records it as used.
+// snippet bounds the summary; zero includes it whole. Title and summary are
+// screened like untrusted prompt variables, and an article whose text tries
+// to instruct the model is dropped (ok is false).
+func (r *toolRun) describe(ctx context.Context, n *entity.News, snippet int) (map[string]interface{}, bool) {
+	summary := firstNonEmpty(n.SummaryEN, n.SummaryAM, n.BodyEN, n.Body)
+	if r.lang == "am" {
+		summary = firstNonEmpty(n.SummaryAM, n.SummaryEN, n.BodyAM, n.Body)
+	}
+	if snippet > 0 {
+		summary = truncateText(summary, snippet)
+	}
+	title, ok := r.isolate(ctx, n.ID, articleTitle(n, r.lang))
+	if !ok {
+		return nil, false
+	}
+	if summary, ok = r.isolate(ctx, n.ID, summary); !ok {
+		return nil, false
+	}
+	ref, seen := r.refs[n.ID]
+	if !seen {
+		r.used = append(r.used, n)
+		ref = len(r.used)
+		r.refs[n.ID] = ref
+	}
+	return map[string]interface{}{
+		"ref":       ref,
+		"id":        n.ID,
+		"title":     title,
+		"source":    r.names[n.SourceID],
+		"published": n.PublishedAt.Format("2006-01-02"),
+		"summary":   summary,
+	}, true
+}
+
+// isolate encloses article text in the untrusted delimiters; ok is false when
+// the safety guard found an injection attempt in it (the match is logged).
+func (r *toolRun) isolate(ctx context.Context, newsID, text string) (string, bool) {
+	if r.uc.safety == nil || strings.TrimSpace(text) == "" {
+		return text, true
+	}
+	if _, found := r.uc.safety.Redact(ctx, "chat_tools", newsID, text); found {
+		return "", false
+	}
+	return untrustedOpen + "\n" + text + "\n" + untrustedClose, true
+}
+
+// citations returns the articles cited by ref in the reply, or every article
+// the tools returned when none was cited explicitly.
+func (r *toolRun) citations(reply string) []entity.Citation {
+	if len(r.used) == 0 {
+		return []entity.Citation{}
+	}
+	out := citationsFor(reply, r.used)
+	for i := range out {
+		if idx, ok := r.refs[out[i].NewsID]; ok {
+			out[i].Source = r.names[r.used[idx-1].SourceID]
+		}
+	}
+	return out
+}
+
+func argString(args map[string]interface{}, key string) string {
+	if v, ok := args[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+func argInt(args map[string]interface{}, key string, fallback int) int {
+	switch v := args[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	}
+	return fallback
+}
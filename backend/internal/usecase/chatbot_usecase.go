@@ -1,8 +1,14 @@
 package usecase
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	// "time"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
@@ -12,97 +18,295 @@ type ChatbotUsecase struct {
 	geminiClient     contract.IGeminiClient
 	translatorClient contract.ITranslationClient
 	newsRepo         contract.INewsRepository
+	embeddings       contract.IEmbeddingService
+	prompts          contract.IPromptRegistry
+	safety           contract.ISafetyGuard
+	bookmarks        contract.IBookmarkRepository
+	sources          contract.ISourceRepository
+	stories          contract.IStoryUsecase
+	topics           contract.ITopicRepository
+	sessions         *chatSessions
+
+	suggestMu  sync.Mutex
+	suggesting map[string]*suggestionCall
 }
 
-func NewChatbotUsecase(gemini contract.IGeminiClient, translator contract.ITranslationClient, repo contract.INewsRepository) contract.IChatbotService {
+func NewChatbotUsecase(gemini contract.IGeminiClient, translator contract.ITranslationClient, repo contract.INewsRepository, embeddings contract.IEmbeddingService, prompts contract.IPromptRegistry, safety contract.ISafetyGuard, bookmarks contract.IBookmarkRepository, sources contract.ISourceRepository, stories contract.IStoryUsecase, topics contract.ITopicRepository) contract.IChatbotService {
 	return &ChatbotUsecase{
 		geminiClient:     gemini,
 		translatorClient: translator,
 		newsRepo:         repo,
+		embeddings:       embeddings,
+		prompts:          prompts,
+		safety:           safety,
+		bookmarks:        bookmarks,
+		sources:          sources,
+		stories:          stories,
+		topics:           topics,
+		sessions:         newChatSessions(),
+		suggesting:       map[string]*suggestionCall{},
+	}
+}
+
+const (
+	// chatRetrievalK is the number of articles retrieved as context for general chat
+	chatRetrievalK = 5
+	// chatMinScore drops weakly related articles from the context
+	chatMinScore = 0.3
+)
+
+var citationMarker = regexp.MustCompile(`\[(\d+)\]`)
+
+// ChatGeneral handles general news queries (knowledge restricted to news domain).
+// The model queries the news database through read-only tools and the articles
+// it used are returned. If tool calling fails, the question is embedded, the
+// closest stored articles are passed to the model as numbered context, and the
+// cited articles are returned.
+func (uc *ChatbotUsecase) ChatGeneral(ctx context.Context, sessionID, message string) (entity.ChatReply, error) {
+	lang := "en"
+	if isAmharic(message) {
+		lang = "am"
+	}
+	if refusal, blocked := uc.screen(ctx, "chat_general", sessionID, lang, message); blocked {
+		return refusal, nil
+	}
+	answer, err := uc.chatWithTools(ctx, sessionID, lang, message)
+	if err == nil || errors.Is(err, contract.ErrBudgetExceeded) || errors.Is(err, contract.ErrUnsafeContent) {
+		out, err := uc.moderate(ctx, "chat_tools", sessionID, lang, message, answer, err)
+		if err != nil {
+			return entity.ChatReply{}, err
+		}
+		return uc.withFollowUps(ctx, sessionID, "", lang, message, citedTitles(out.Citations), out, nil), nil
+	}
+
+	// tool calling failed: answer from the articles closest to the question
+	articles := uc.retrieve(ctx, message)
+	if len(articles) == 0 {
+		system, err := uc.prompts.Render(ctx, "chat_general", lang, sessionID, nil)
+		if err != nil {
+			return entity.ChatReply{}, err
+		}
+		reply, err := uc.geminiClient.Chat(ctx, []string{message}, system.Text)
+		out, err := uc.moderate(ctx, "chat_general", sessionID, lang, message, entity.ChatReply{Reply: reply}, err)
+		if err != nil {
+			return entity.ChatReply{}, err
+		}
+		return uc.withFollowUps(ctx, sessionID, "", lang, message, "", out, nil), nil
+	}
+
+	system, err := uc.prompts.Render(ctx, "chat_grounded", lang, sessionID, map[string]string{"articles": numberedArticles(articles)})
+	if err != nil {
+		return entity.ChatReply{}, err
+	}
+	reply, err := uc.geminiClient.Chat(ctx, []string{message}, system.Text)
+	out, err := uc.moderate(ctx, "chat_grounded", sessionID, lang, message, entity.ChatReply{Reply: reply, Citations: citationsFor(reply, articles)}, err)
+	if err != nil {
+		return entity.ChatReply{}, err
+	}
+	return uc.withFollowUps(ctx, sessionID, "", lang, message, citedTitles(out.Citations), out, nil), nil
+}
+
+// citedTitles lists the cited articles as context for follow-up suggestions.
+func citedTitles(citations []entity.Citation) string {
+	var b strings.Builder
+	for _, c := range citations {
+		fmt.Fprintf(&b, "- %s\n", c.Title)
+	}
+	return b.String()
+}
+
+// withFollowUps remembers the exchange in the session and attaches follow-up
+// questions built from the conversation so far. Refused exchanges are not
+// remembered and only get the fallback questions.
+func (uc *ChatbotUsecase) withFollowUps(ctx context.Context, sessionID, contextID, lang, message, newsContext string, reply entity.ChatReply, fallback []string) entity.ChatReply {
+	if reply.Refused {
+		reply.FollowUps = fallback
+		return reply
+	}
+	now := time.Now()
+	exchange := []entity.ChatMessage{
+		{ContextID: contextID, Role: "user", Text: message, Timestamp: now},
+		{ContextID: contextID, Role: "assistant", Text: reply.Reply, Timestamp: now},
+	}
+	uc.sessions.add(sessionID, exchange...)
+	history := exchange
+	if sessionID != "" {
+		history = uc.sessions.history(sessionID, contextID)
 	}
+	key := sessionID
+	if key == "" {
+		key = contextID
+	}
+	reply.FollowUps = uc.followUps(ctx, key, contextID, lang, newsContext, history, fallback)
+	return reply
+}
+
+// screen refuses messages that try to override the instructions or ask for
+// harmful content, before any model call is made.
+func (uc *ChatbotUsecase) screen(ctx context.Context, feature, key, lang, message string) (entity.ChatReply, bool) {
+	if uc.safety == nil {
+		return entity.ChatReply{}, false
+	}
+	if err := uc.safety.CheckInput(ctx, feature, key, message); err != nil {
+		return entity.ChatReply{Reply: uc.safety.Refusal(lang), Refused: true}, true
+	}
+	return entity.ChatReply{}, false
 }
 
-// ChatGeneral handles general news queries (knowledge restricted to news domain)
-func (uc *ChatbotUsecase) ChatGeneral(sessionID, message string) (string, error) {
-	context := "General news chatbot. You can only answer questions about news topics."
-	reply, err := uc.geminiClient.Chat([]string{message}, context)
+// moderate replaces a reply that the model's filters or the output checks
+// blocked with a refusal; other errors are returned unchanged.
+func (uc *ChatbotUsecase) moderate(ctx context.Context, feature, key, lang, message string, reply entity.ChatReply, err error) (entity.ChatReply, error) {
+	if uc.safety == nil {
+		return reply, err
+	}
+	refusal := entity.ChatReply{Reply: uc.safety.Refusal(lang), Refused: true}
+	if errors.Is(err, contract.ErrUnsafeContent) {
+		uc.safety.Log(ctx, &entity.SafetyEvent{
+			Stage:   entity.SafetyOutput,
+			Action:  entity.SafetyBlocked,
+			Rule:    "model_filter",
+			Feature: feature,
+			Key:     key,
+			Excerpt: message,
+		})
+		return refusal, nil
+	}
 	if err != nil {
-		return "", err
-	}
-
-	// Save chat history - in a real implementation, this would involve saving to a database
-	// _ = entity.ChatMessage{
-	// 	ContextID: sessionID,
-	// 	Role:      "user",
-	// 	Text:   message,
-	// 	Timestamp: time.Now(),
-	// }
-
-	// _ = entity.ChatMessage{
-	// 	ContextID: sessionID,
-	// 	Role:      "assistant",
-	// 	Text:   reply,
-	// 	Timestamp: time.Now(),
-	// }
+		return entity.ChatReply{}, err
+	}
+	if uc.safety.ModerateOutput(ctx, feature, key, reply.Reply) != nil {
+		return refusal, nil
+	}
 	return reply, nil
 }
 
-// ChatForNews answers questions about a specific news item
-func (uc *ChatbotUsecase) ChatForNews(newsID, sessionID, message string) (string, error) {
-	news, err := uc.newsRepo.FindByID(newsID)
+// retrieve returns the stored articles closest to the query, best first.
+// Retrieval failures degrade to an ungrounded answer rather than an error.
+func (uc *ChatbotUsecase) retrieve(ctx context.Context, query string) []*entity.News {
+	if uc.embeddings == nil {
+		return nil
+	}
+	matches, err := uc.embeddings.SearchText(ctx, query, chatRetrievalK, contract.VectorFilter{})
 	if err != nil {
-		return "", fmt.Errorf("news not found: %w", err)
+		return nil
+	}
+	ids := make([]string, 0, len(matches))
+	for _, m := range matches {
+		if m.Score >= chatMinScore {
+			ids = append(ids, m.NewsID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	found, err := uc.newsRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil
+	}
+	byID := make(map[string]*entity.News, len(found))
+	for _, n := range found {
+		byID[n.ID] = n
 	}
+	ordered := make([]*entity.News, 0, len(found))
+	for _, id := range ids {
+		if n, ok := byID[id]; ok {
+			ordered = append(ordered, n)
+		}
+	}
+	return ordered
+}
 
-	var context string
+// numberedArticles lists the context articles as cited in the grounded chat prompt.
+func numberedArticles(articles []*entity.News) string {
+	var b strings.Builder
+	for i, n := range articles {
+		title := firstNonEmpty(n.TitleEN, n.Title)
+		summary := firstNonEmpty(n.SummaryEN, n.SummaryAM, n.Body)
+		fmt.Fprintf(&b, "[%d] %s (published %s)\n%s\n\n", i+1, title, n.PublishedAt.Format("2006-01-02"), summary)
+	}
+	return b.String()
+}
 
-	// Detect Amharic (basic Ethiopic Unicode block check)
-	isAmharic := func(s string) bool {
-		for _, r := range s {
-			if r >= 0x1200 && r <= 0x137F { // Ethiopic block
-				return true
-			}
+// citationsFor returns the articles referenced in the reply; if the model cited
+// nothing explicitly, all context articles are returned.
+func citationsFor(reply string, articles []*entity.News) []entity.Citation {
+	toCitation := func(n *entity.News) entity.Citation {
+		return entity.Citation{NewsID: n.ID, Title: firstNonEmpty(n.TitleEN, n.Title), SourceURL: n.SourceURL}
+	}
+	seen := map[int]bool{}
+	out := []entity.Citation{}
+	for _, m := range citationMarker.FindAllStringSubmatch(reply, -1) {
+		idx, err := strconv.Atoi(m[1])
+		if err != nil || idx < 1 || idx > len(articles) || seen[idx] {
+			continue
 		}
-		return false
-	}
-
-	amharic := isAmharic(message)
-
-	if amharic {
-		if news.SummaryAM != "" {
-			context = fmt.Sprintf("You are a chatbot restricted to this news article only. Respond in Amharic only. Title: %s. Summary: %s", news.Title, news.SummaryAM)
-		} else if news.SummaryEN != "" {
-			translated, err := uc.translatorClient.Translate(news.SummaryEN, "en", "am")
-			if err != nil {
-				return "", fmt.Errorf("failed to translate summary to Amharic: %w", err)
-			}
-			context = fmt.Sprintf("You are a chatbot restricted to this news article only. Respond in Amharic only. Title: %s. Summary: %s", news.Title, translated)
-		} else {
-			return "", fmt.Errorf("there is no summary for the news")
+		seen[idx] = true
+		out = append(out, toCitation(articles[idx-1]))
+	}
+	if len(out) == 0 {
+		for _, n := range articles {
+			out = append(out, toCitation(n))
 		}
-	} else {
-		if news.SummaryEN != "" {
-			context = fmt.Sprintf("You are a chatbot restricted to this news article only. Respond in English only. Title: %s. Summary: %s", news.Title, news.SummaryEN)
-		} else if news.SummaryAM != "" {
-			translated, err := uc.translatorClient.Translate(news.SummaryAM, "am", "en")
-			if err != nil {
-				return "", fmt.Errorf("failed to translate summary to English: %w", err)
-			}
-			context = fmt.Sprintf("You are a chatbot restricted to this news article only. Respond in English only. Title: %s. Summary: %s", news.Title, translated)
-		} else {
-			return "", fmt.Errorf("there is no summary for the news")
+	}
+	return out
+}
+
+// isAmharic detects Amharic text (basic Ethiopic Unicode block check)
+func isAmharic(s string) bool {
+	for _, r := range s {
+		if r >= 0x1200 && r <= 0x137F { // Ethiopic block
+			return true
 		}
 	}
+	return false
+}
 
-	reply, err := uc.geminiClient.Chat([]string{message}, context)
+// ChatForNews answers questions about a specific news item
+func (uc *ChatbotUsecase) ChatForNews(ctx context.Context, newsID, sessionID, message string) (entity.ChatReply, error) {
+	news, err := uc.newsRepo.FindByID(newsID)
 	if err != nil {
-		return "", err
+		return entity.ChatReply{}, fmt.Errorf("news not found: %w", err)
 	}
 
-	return reply, nil
+	lang, summary := "en", news.SummaryEN
+	if isAmharic(message) {
+		lang, summary = "am", news.SummaryAM
+	}
+	if refusal, blocked := uc.screen(ctx, "chat_news", newsID, lang, message); blocked {
+		return refusal, nil
+	}
+	if summary == "" {
+		srcLang, other := "am", news.SummaryAM
+		if lang == "am" {
+			srcLang, other = "en", news.SummaryEN
+		}
+		if other == "" {
+			return entity.ChatReply{}, fmt.Errorf("there is no summary for the news")
+		}
+		translated, err := uc.translatorClient.Translate(ctx, other, srcLang, lang)
+		if err != nil {
+			return entity.ChatReply{}, fmt.Errorf("failed to translate summary to %s: %w", languageName(lang), err)
+		}
+		summary = translated
+	}
+
+	system, err := uc.prompts.Render(ctx, "chat_news", lang, newsID, map[string]string{"title": news.Title, "summary": summary})
+	if err != nil {
+		return entity.ChatReply{}, err
+	}
+	reply, err := uc.geminiClient.Chat(ctx, []string{message}, system.Text)
+	out, err := uc.moderate(ctx, "chat_news", newsID, lang, message, entity.ChatReply{Reply: reply}, err)
+	if err != nil {
+		return entity.ChatReply{}, err
+	}
+	var starters []string
+	if news.SuggestedQuestions != nil {
+		starters = news.SuggestedQuestions.ForLanguage(lang)
+	}
+	return uc.withFollowUps(ctx, sessionID, newsID, lang, message, news.Title+"\n"+summary, out, starters), nil
 }
 
-// (Optional) Return chat history if stored
+// GetHistory returns the recent messages remembered for the session.
 func (uc *ChatbotUsecase) GetHistory(sessionID string) ([]entity.ChatMessage, error) {
-	return []entity.ChatMessage{}, nil
+	return uc.sessions.history(sessionID, ""), nil
 }
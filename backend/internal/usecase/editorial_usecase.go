@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// maxAutoMemoryChars bounds whole-field corrections stored as memory without
+// explicit segments (titles and one-line summaries).
+const maxAutoMemoryChars = 200
+
+type editorialUsecase struct {
+	newsRepo   contract.INewsRepository
+	revisions  contract.IRevisionRepository
+	memory     contract.ITranslationMemoryRepository
+	embeddings contract.IEmbeddingService
+	summaries  contract.ISummaryRepository
+	uuidGen    contract.IUUIDGenerator
+}
+
+func NewEditorialUsecase(newsRepo contract.INewsRepository, revisions contract.IRevisionRepository, memory contract.ITranslationMemoryRepository, embeddings contract.IEmbeddingService, summaries contract.ISummaryRepository, uuidGen contract.IUUIDGenerator) contract.IEditorialUsecase {
+	return &editorialUsecase{newsRepo: newsRepo, revisions: revisions, memory: memory, embeddings: embeddings, summaries: summaries, uuidGen: uuidGen}
+}
+
+func (u *editorialUsecase) GetArticle(ctx context.Context, newsID string) (*entity.News, []*entity.FieldRevision, error) {
+	news, err := u.newsRepo.FindByID(newsID)
+	if err != nil {
+		return nil, nil, err
+	}
+	revs, err := u.revisions.ListByNews(ctx, newsID, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	return news, revs, nil
+}
+
+func (u *editorialUsecase) CorrectField(ctx context.Context, newsID, field, content, editorID, note string, segments []contract.SegmentCorrection) (*entity.News, error) {
+	sourceField, ok := entity.TranslatableFields[field]
+	if !ok {
+		return nil, fmt.Errorf("unsupported field: %s", field)
+	}
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, errors.New("content is required")
+	}
+	news, err := u.newsRepo.FindByID(newsID)
+	if err != nil {
+		return nil, err
+	}
+	value := news.FieldValue(field)
+	if *value == content {
+		return news, nil
+	}
+
+	now := time.Now()
+	state, translated := news.Translations[field]
+	rev := &entity.FieldRevision{
+		ID:             u.uuidGen.NewUUID(),
+		NewsID:         news.ID,
+		Field:          field,
+		Previous:       *value,
+		Content:        content,
+		Origin:         entity.RevisionEditor,
+		PreviousStatus: state.Status,
+		EditorID:       editorID,
+		Note:           note,
+		CreatedAt:      now,
+	}
+
+	*value = content
+	fields := []string{field}
+	if translated {
+		state.Status, state.LastError, state.UpdatedAt = entity.TranslationEdited, "", now
+		news.Translations[field] = state
+		fields = append(fields, "translations."+field)
+		if state.SourceField != "" {
+			sourceField = state.SourceField
+		}
+	}
+	// only while the field still holds what the editor corrected, so a
+	// concurrent translation or correction is not silently replaced
+	if err := u.newsRepo.UpdateFields(ctx, news, fields, map[string]interface{}{field: rev.Previous}); err != nil {
+		return nil, err
+	}
+	if err := u.revisions.Save(ctx, rev); err != nil {
+		return nil, err
+	}
+	// cached summary variants were written from the old body or summary, and
+	// the vector falls back to Amharic text when English is missing
+	if u.summaries != nil && (strings.HasPrefix(field, "body_") || strings.HasPrefix(field, "summary_")) {
+		if err := u.summaries.DeleteByNews(ctx, news.ID); err != nil {
+			return nil, err
+		}
+	}
+	if u.embeddings != nil {
+		_ = u.embeddings.IndexNews(ctx, news)
+	}
+
+	// Feed translation memory: explicit segments always, the whole field when it
+	// is a short translation of its counterpart
+	srcLang, tgtLang := entity.FieldLanguage(sourceField), entity.FieldLanguage(field)
+	if translated && len(segments) == 0 && utf8.RuneCountInString(content) <= maxAutoMemoryChars {
+		if src := *news.FieldValue(sourceField); src != "" {
+			segments = []contract.SegmentCorrection{{Source: src, Target: content}}
+		}
+	}
+	for _, seg := range segments {
+		if _, err := u.addMemory(ctx, srcLang, tgtLang, seg.Source, seg.Target, editorID, news.ID); err != nil {
+			return nil, err
+		}
+	}
+	return news, nil
+}
+
+func (u *editorialUsecase) ListRevisions(ctx context.Context, newsID, field string) ([]*entity.FieldRevision, error) {
+	if field != "" {
+		if _, ok := entity.TranslatableFields[field]; !ok {
+			return nil, fmt.Errorf("unsupported field: %s", field)
+		}
+	}
+	return u.revisions.ListByNews(ctx, newsID, field)
+}
+
+func (u *editorialUsecase) AddMemoryEntry(ctx context.Context, sourceLang, targetLang, source, target, editorID string) (*entity.TranslationMemoryEntry, error) {
+	return u.addMemory(ctx, sourceLang, targetLang, source, target, editorID, "")
+}
+
+func (u *editorialUsecase) ListMemory(ctx context.Context, sourceLang, targetLang string, limit int) ([]*entity.TranslationMemoryEntry, error) {
+	return u.memory.List(ctx, sourceLang, targetLang, limit)
+}
+
+func (u *editorialUsecase) addMemory(ctx context.Context, sourceLang, targetLang, source, target, editorID, newsID string) (*entity.TranslationMemoryEntry, error) {
+	if !supportedLanguage(sourceLang) || !supportedLanguage(targetLang) || sourceLang == targetLang {
+		return nil, fmt.Errorf("unsupported language pair: %s -> %s", sourceLang, targetLang)
+	}
+	source, target = strings.TrimSpace(source), strings.TrimSpace(target)
+	if source == "" || target == "" {
+		return nil, errors.New("source and target are required")
+	}
+	e := &entity.TranslationMemoryEntry{
+		ID:         u.uuidGen.NewUUID(),
+		SourceLang: sourceLang,
+		TargetLang: targetLang,
+		Key:        entity.TranslationMemoryKey(source),
+		Source:     source,
+		Target:     target,
+		EditorID:   editorID,
+		NewsID:     newsID,
+	}
+	if err := u.memory.Upsert(ctx, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func supportedLanguage(code string) bool {
+	return code == "en" || code == "am"
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// maxEmbeddingChars bounds the text sent to the embedding model.
+const maxEmbeddingChars = 6000
+
+type embeddingUsecase struct {
+	client   contract.IEmbeddingClient
+	index    contract.IVectorIndex
+	newsRepo contract.INewsRepository
+}
+
+func NewEmbeddingUsecase(client contract.IEmbeddingClient, index contract.IVectorIndex, newsRepo contract.INewsRepository) contract.IEmbeddingService {
+	return &embeddingUsecase{client: client, index: index, newsRepo: newsRepo}
+}
+
+// IndexNews embeds the English title and summary (falling back to the original
+// body) so that articles in both languages share one vector space.
+func (u *embeddingUsecase) IndexNews(ctx context.Context, news *entity.News) error {
+	if news == nil || news.ID == "" {
+		return errors.New("news is required")
+	}
+	text := embeddingText(news)
+	if text == "" {
+		return errors.New("news has no text to embed")
+	}
+	vector, err := u.client.Embed(ctx, text)
+	if err != nil {
+		return err
+	}
+	title := news.TitleEN
+	if title == "" {
+		title = news.Title
+	}
+	return u.index.Upsert(ctx, &entity.NewsEmbedding{
+		NewsID:      news.ID,
+		Title:       title,
+		SourceID:    news.SourceID,
+		SourceURL:   news.SourceURL,
+		Language:    news.Language,
+		Vector:      vector,
+		Model:       u.client.EmbeddingModel(),
+		PublishedAt: news.PublishedAt,
+		CreatedAt:   time.Now().UTC(),
+	})
+}
+
+func (u *embeddingUsecase) SearchText(ctx context.Context, query string, k int, filter contract.VectorFilter) ([]contract.VectorMatch, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return []contract.VectorMatch{}, nil
+	}
+	vector, err := u.client.Embed(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return u.index.Search(ctx, vector, k, filter)
+}
+
+func (u *embeddingUsecase) SimilarTo(ctx context.Context, news *entity.News, k int, filter contract.VectorFilter) ([]contract.VectorMatch, error) {
+	stored, err := u.index.Get(ctx, news.ID)
+	if errors.Is(err, contract.ErrNotFound) {
+		// Not embedded yet (e.g. ingested before embeddings existed): index it now
+		if err := u.IndexNews(ctx, news); err != nil {
+			return nil, err
+		}
+		stored, err = u.index.Get(ctx, news.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	filter.ExcludeNewsIDs = append(filter.ExcludeNewsIDs, news.ID)
+	if filter.ExcludeSourceURL == "" {
+		filter.ExcludeSourceURL = news.SourceURL
+	}
+	return u.index.Search(ctx, stored.Vector, k, filter)
+}
+
+// Backfill walks all stored news in ID order and embeds those missing from the index.
+// Individual failures are counted and skipped so one bad article does not stop the run.
+func (u *embeddingUsecase) Backfill(ctx context.Context, batchSize int) (int, int, error) {
+	if batchSize <= 0 {
+		batchSize = 50
+	}
+	indexed, failed := 0, 0
+	// a keyset cursor neither skips nor repeats articles saved during the run
+	afterID := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			return indexed, failed, err
+		}
+		list, err := u.newsRepo.FindAfterID(ctx, afterID, batchSize)
+		if err != nil {
+			return indexed, failed, err
+		}
+		if len(list) == 0 {
+			return indexed, failed, nil
+		}
+		ids := make([]string, 0, len(list))
+		for _, n := range list {
+			ids = append(ids, n.ID)
+		}
+		existing, err := u.index.ExistingIDs(ctx, ids)
+		if err != nil {
+			return indexed, failed, err
+		}
+		for _, n := range list {
+			if existing[n.ID] {
+				continue
+			}
+			if err := u.IndexNews(ctx, n); err != nil {
+				failed++
+				continue
+			}
+			indexed++
+		}
+		if len(list) < batchSize {
+			return indexed, failed, nil
+		}
+		afterID = list[len(list)-1].ID
+	}
+}
+
+func embeddingText(n *entity.News) string {
+	title := firstNonEmpty(n.TitleEN, n.Title, n.TitleAM)
+	summary := firstNonEmpty(n.SummaryEN, n.BodyEN, n.Body, n.SummaryAM)
+	return truncateText(strings.TrimSpace(title+"\n\n"+summary), maxEmbeddingChars)
+}
+
+// truncateText cuts s to at most n bytes without splitting a UTF-8 character.
+func truncateText(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+)
+
+type evalPipeline struct {
+	gemini     contract.IGeminiClient
+	translator contract.ITranslationClient
+	prompts    contract.IPromptRegistry
+}
+
+// NewEvalPipeline runs fixtures through the same prompts and post-processing
+// as provider ingestion, without touching the database.
+func NewEvalPipeline(gemini contract.IGeminiClient, translator contract.ITranslationClient, prompts contract.IPromptRegistry) contract.IEvalPipeline {
+	return &evalPipeline{gemini: gemini, translator: translator, prompts: prompts}
+}
+
+func (p *evalPipeline) Summarize(ctx context.Context, key, text, lang string) (string, string, error) {
+	summary, version, err := summarizeWithPrompt(ctx, p.gemini, p.prompts, key, text, lang)
+	if err != nil {
+		return "", "", err
+	}
+	return enforceMultiLineFiveSentence(summary), version, nil
+}
+
+func (p *evalPipeline) Classify(ctx context.Context, key, title, text, lang string) ([]string, error) {
+	labels, err := classifyWithPrompt(ctx, p.gemini, p.prompts, key, text, lang, 4)
+	if err != nil {
+		return nil, err
+	}
+	slugs := []string{}
+	for _, l := range labels {
+		if s := mapLabelToAllowed(l); s != "" && !containsString(slugs, s) {
+			slugs = append(slugs, s)
+		}
+	}
+	for _, s := range inferFallbackTopics(title, text) {
+		if len(slugs) >= 2 {
+			break
+		}
+		if !containsString(slugs, s) {
+			slugs = append(slugs, s)
+		}
+	}
+	return slugs, nil
+}
+
+func (p *evalPipeline) Translate(ctx context.Context, text, sourceLang, targetLang string) (string, error) {
+	return p.translator.Translate(ctx, text, sourceLang, targetLang)
+}
+
+func (p *evalPipeline) Topics() []string {
+	out := make([]string, 0, len(allowedTopics))
+	for slug := range allowedTopics {
+		out = append(out, slug)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+const (
+	// defaultFeedPollInterval applies to sources without PollIntervalMinutes
+	defaultFeedPollInterval = 30 * time.Minute
+	// minFeedPollInterval keeps publishers from being polled too often
+	minFeedPollInterval = 5 * time.Minute
+	// maxFeedItemsPerPoll caps the entries ingested from one feed per poll, newest first
+	maxFeedItemsPerPoll = 20
+	// maxFeedsPerSource bounds the feed URLs configured on one source
+	maxFeedsPerSource = 10
+)
+
+type feedIngestion struct {
+	feeds     contract.IFeedClient
+	states    contract.IFeedStateRepository
+	sources   contract.ISourceRepository
+	ingestion contract.IProviderIngestionUsecase
+	logger    contract.IAppLogger
+}
+
+// NewFeedIngestionUsecase polls the feeds configured on sources, independent
+// of the external news provider.
+func NewFeedIngestionUsecase(feeds contract.IFeedClient, states contract.IFeedStateRepository, sources contract.ISourceRepository, ingestion contract.IProviderIngestionUsecase, logger contract.IAppLogger) contract.IFeedIngestionUsecase {
+	return &feedIngestion{feeds: feeds, states: states, sources: sources, ingestion: ingestion, logger: logger}
+}
+
+func (uc *feedIngestion) PollDue(ctx context.Context) (contract.FeedPollResult, error) {
+	sources, err := uc.sources.GetAll(ctx)
+	if err != nil {
+		return contract.FeedPollResult{}, err
+	}
+	var res contract.FeedPollResult
+	now := time.Now().UTC()
+	for i := range sources {
+		src := &sources[i]
+		interval := feedPollInterval(src)
+		for _, feedURL := range src.FeedURLs {
+			if ctx.Err() != nil {
+				return res, ctx.Err()
+			}
+			state, err := uc.state(ctx, src, feedURL)
+			if err != nil {
+				return res, err
+			}
+			if now.Sub(state.LastPolledAt) < backoff(interval, state.Failures) {
+				continue
+			}
+			uc.poll(ctx, src, state, &res)
+		}
+	}
+	return res, nil
+}
+
+func (uc *feedIngestion) PollSource(ctx context.Context, slug string) (contract.FeedPollResult, error) {
+	src, err := uc.sources.GetBySlug(ctx, slug)
+	if err != nil || src == nil {
+		return contract.FeedPollResult{}, contract.ErrNotFound
+	}
+	if len(src.FeedURLs) == 0 {
+		return contract.FeedPollResult{}, fmt.Errorf("%w: source %s has no feeds", contract.ErrInvalidInput, slug)
+	}
+	var res contract.FeedPollResult
+	for _, feedURL := range src.FeedURLs {
+		if ctx.Err() != nil {
+			return res, ctx.Err()
+		}
+		state, err := uc.state(ctx, src, feedURL)
+		if err != nil {
+			return res, err
+		}
+		uc.poll(ctx, src, state, &res)
+	}
+	return res, nil
+}
+
+func (uc *feedIngestion) SetFeeds(ctx context.Context, slug string, urls []string, intervalMinutes int) (*entity.Source, error) {
+	src, err := uc.sources.GetBySlug(ctx, slug)
+	if err != nil || src == nil {
+		return nil, contract.ErrNotFound
+	}
+	if intervalMinutes < 0 || intervalMinutes > 0 && time.Duration(intervalMinutes)*time.Minute < minFeedPollInterval {
+		return nil, fmt.Errorf("%w: poll interval must be at least %d minutes", contract.ErrInvalidInput, int(minFeedPollInterval.Minutes()))
+	}
+	clean, err := cleanFeedURLs(urls)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.sources.UpdateFeeds(ctx, slug, clean, intervalMinutes); err != nil {
+		return nil, err
+	}
+	src.FeedURLs, src.PollIntervalMinutes = clean, intervalMinutes
+	return src, nil
+}
+
+// cleanFeedURLs trims and de-duplicates feed URLs; each must be absolute http(s).
+func cleanFeedURLs(urls []string) ([]string, error) {
+	if len(urls) > maxFeedsPerSource {
+		return nil, fmt.Errorf("%w: at most %d feeds per source", contract.ErrInvalidInput, maxFeedsPerSource)
+	}
+	clean := []string{}
+	for _, raw := range urls {
+		raw = strings.TrimSpace(raw)
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("%w: invalid feed URL %q", contract.ErrInvalidInput, raw)
+		}
+		if !containsString(clean, raw) {
+			clean = append(clean, raw)
+		}
+	}
+	return clean, nil
+}
+
+func (uc *feedIngestion) ListStates(ctx context.Context) ([]*entity.FeedState, error) {
+	return uc.states.List(ctx)
+}
+
+func (uc *feedIngestion) state(ctx context.Context, src *entity.Source, feedURL string) (*entity.FeedState, error) {
+	state, err := uc.states.Get(ctx, feedURL)
+	if errors.Is(err, contract.ErrNotFound) {
+		return &entity.FeedState{URL: feedURL, SourceID: src.ID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state.SourceID = src.ID
+	return state, nil
+}
+
+// poll fetches one feed, ingests its newest entries and records the outcome.
+// Failures are kept on the feed state so one broken feed does not stop the pass.
+func (uc *feedIngestion) poll(ctx context.Context, src *entity.Source, state *entity.FeedState, res *contract.FeedPollResult) {
+	res.Feeds++
+	state.TotalPolls++
+	state.LastPolledAt = time.Now().UTC()
+	fetched, err := uc.feeds.Fetch(ctx, state.URL, state.ETag, state.LastModified)
+	switch {
+	case err != nil:
+		res.Failed++
+		state.LastStatus, state.LastError = "error", err.Error()
+		state.Failures++
+		state.TotalFailures++
+		uc.logger.Errorf("feed %s (%s): %v", state.URL, src.Slug, err)
+	case fetched.NotModified:
+		res.NotModified++
+		state.LastStatus, state.LastError, state.Failures = "not_modified", "", 0
+	default:
+		items := feedItemsForSource(src, fetched.Items)
+		ids, skipped, ierr := uc.ingestion.IngestItems(ctx, items)
+		res.Items += len(items)
+		res.Ingested = append(res.Ingested, ids...)
+		res.Skipped += skipped
+		state.LastItems, state.LastIngested = len(fetched.Items), len(ids)
+		state.TotalIngested += len(ids)
+		if ierr != nil {
+			// keep the old validators so the unprocessed entries are fetched again
+			res.Failed++
+			state.LastStatus, state.LastError = "error", ierr.Error()
+			state.Failures++
+			state.TotalFailures++
+			break
+		}
+		state.ETag, state.LastModified = fetched.ETag, fetched.LastModified
+		state.LastStatus, state.LastError, state.Failures = "ok", "", 0
+	}
+	// the poll outcome is saved even when the pass was cancelled
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := uc.states.Save(saveCtx, state); err != nil {
+		uc.logger.Errorf("feed %s: save state: %v", state.URL, err)
+	}
+}
+
+// feedItemsForSource keeps the newest entries and attributes them to the
+// source. Ethiopic titles are Amharic; otherwise the declared language wins,
+// then the source's.
+func feedItemsForSource(src *entity.Source, items []contract.ProviderItem) []contract.ProviderItem {
+	sort.SliceStable(items, func(i, j int) bool { return items[i].PublishedDate > items[j].PublishedDate })
+	if len(items) > maxFeedItemsPerPoll {
+		items = items[:maxFeedItemsPerPoll]
+	}
+	out := make([]contract.ProviderItem, 0, len(items))
+	for _, it := range items {
+		if strings.TrimSpace(it.SourceURL) == "" {
+			continue
+		}
+		it.SourceID = src.ID
+		it.SourceSite = src.Name
+		switch {
+		case isAmharic(firstNonEmpty(it.Title, it.Text)):
+			// bilingual outlets often declare one language for the whole feed
+			it.Lang = "am"
+		case it.Lang != "":
+		case src.Languages == entity.LanguageAM:
+			it.Lang = "am"
+		default:
+			it.Lang = "en"
+		}
+		out = append(out, it)
+	}
+	return out
+}
+
+func feedPollInterval(src *entity.Source) time.Duration {
+	if src.PollIntervalMinutes <= 0 {
+		return defaultFeedPollInterval
+	}
+	d := time.Duration(src.PollIntervalMinutes) * time.Minute
+	if d < minFeedPollInterval {
+		return minFeedPollInterval
+	}
+	return d
+}
+
+// backoff doubles the wait after each consecutive failure, up to a day.
+func backoff(interval time.Duration, failures int) time.Duration {
+	for i := 0; i < failures && interval < 24*time.Hour; i++ {
+		interval *= 2
+	}
+	if interval > 24*time.Hour {
+		return 24 * time.Hour
+	}
+	return interval
+}
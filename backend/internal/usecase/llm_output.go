@@ -0,0 +1,25 @@
+package usecase
+
+import "strings"
+
+// extractJSON returns the JSON object or array embedded in a model reply,
+// dropping markdown code fences and any surrounding prose.
+func extractJSON(s string) string {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "```json")
+	s = strings.TrimPrefix(s, "```")
+	s = strings.TrimSuffix(s, "```")
+	start := strings.IndexAny(s, "{[")
+	if start < 0 {
+		return s
+	}
+	closer := "}"
+	if s[start] == '[' {
+		closer = "]"
+	}
+	end := strings.LastIndex(s, closer)
+	if end < start {
+		return s[start:]
+	}
+	return s[start : end+1]
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+const (
+	// maxArticleImages caps the lead image plus gallery stored per article
+	maxArticleImages = 8
+	// minArticleImageSide drops thumbnails, icons and tracking pixels
+	minArticleImageSide = 200
+	// probeTimeout bounds measuring the images of one article
+	probeTimeout = 20 * time.Second
+)
+
+// decorativeImageRe matches URLs of logos, icons and ad pixels.
+var decorativeImageRe = regexp.MustCompile(`(?i)logo|icon|avatar|sprite|pixel|spacer|badge|emoji|gravatar|/ads?/|placeholder`)
+
+type mediaUsecase struct {
+	proxy   contract.IImageProxy
+	news    contract.INewsRepository
+	sources contract.ISourceRepository
+}
+
+// NewMediaUsecase serves article images and source logos through the image
+// proxy, so only URLs stored on articles and sources can be proxied.
+func NewMediaUsecase(proxy contract.IImageProxy, news contract.INewsRepository, sources contract.ISourceRepository) contract.IMediaUsecase {
+	return &mediaUsecase{proxy: proxy, news: news, sources: sources}
+}
+
+func (uc *mediaUsecase) NewsImage(ctx context.Context, newsID string, index, width int) (*contract.ProxiedImage, error) {
+	n, err := uc.news.FindByID(newsID)
+	if err != nil {
+		return nil, err
+	}
+	if n == nil || index < 0 || index >= len(n.Images) {
+		return nil, contract.ErrNotFound
+	}
+	return uc.proxy.Get(ctx, n.Images[index].URL, width)
+}
+
+func (uc *mediaUsecase) SourceLogo(ctx context.Context, slug string, width int) (*contract.ProxiedImage, error) {
+	src, err := uc.sources.GetBySlug(ctx, slug)
+	if err != nil || src == nil || strings.TrimSpace(src.LogoURL) == "" {
+		return nil, contract.ErrNotFound
+	}
+	return uc.proxy.Get(ctx, src.LogoURL, width)
+}
+
+func (uc *mediaUsecase) PrepareImages(ctx context.Context, refs []contract.ImageRef) []entity.NewsImage {
+	var candidates []entity.NewsImage
+	seen := map[string]bool{}
+	for _, r := range refs {
+		u, err := url.Parse(strings.TrimSpace(r.URL))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			continue
+		}
+		key := u.Host + u.EscapedPath() + "?" + u.RawQuery
+		if seen[key] || decorativeImageRe.MatchString(u.Path) || tooSmall(r.Width, r.Height) {
+			continue
+		}
+		seen[key] = true
+		candidates = append(candidates, entity.NewsImage{URL: u.String(), Width: r.Width, Height: r.Height, Alt: strings.TrimSpace(r.Alt)})
+		if len(candidates) == maxArticleImages {
+			break
+		}
+	}
+	if uc.proxy == nil {
+		return candidates
+	}
+
+	// Measuring also warms the proxy cache. Images that cannot be fetched or
+	// turn out to be small are dropped; on timeout the unmeasured are kept.
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	keep := make([]bool, len(candidates))
+	var wg sync.WaitGroup
+	for i := range candidates {
+		wg.Add(1)
+		go func(img *entity.NewsImage, keep *bool) {
+			defer wg.Done()
+			w, h, err := uc.proxy.Probe(ctx, img.URL)
+			switch {
+			case err != nil:
+				*keep = ctx.Err() != nil
+			case tooSmall(w, h):
+			default:
+				img.Width, img.Height, *keep = w, h, true
+			}
+		}(&candidates[i], &keep[i])
+	}
+	wg.Wait()
+	out := make([]entity.NewsImage, 0, len(candidates))
+	for i, img := range candidates {
+		if keep[i] {
+			out = append(out, img)
+		}
+	}
+	return out
+}
+
+func tooSmall(w, h int) bool {
+	return w > 0 && w < minArticleImageSide || h > 0 && h < minArticleImageSide
+}
+
+// imageRefs collects the pictures of an item: the provider's lead image,
+// then its other images, then those found on the article page.
+func imageRefs(it contract.ProviderItem, extracted *contract.ExtractedArticle) []contract.ImageRef {
+	var refs []contract.ImageRef
+	if it.ImageURL != "" {
+		refs = append(refs, contract.ImageRef{URL: it.ImageURL})
+	}
+	refs = append(refs, it.Images...)
+	if extracted != nil {
+		refs = append(refs, extracted.Images...)
+	}
+	return refs
+}
@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+const (
+	// maxEntitiesPerArticle bounds how many mentions are kept for one article
+	maxEntitiesPerArticle = 15
+	// entityExtractionChars bounds the article text sent to the model
+	entityExtractionChars = 6000
+)
+
+type namedEntityUsecase struct {
+	entities contract.INamedEntityRepository
+	newsRepo contract.INewsRepository
+	userRepo contract.IUserRepository
+	gemini   contract.IGeminiClient
+	prompts  contract.IPromptRegistry
+	uuidGen  contract.IUUIDGenerator
+}
+
+func NewNamedEntityUsecase(entities contract.INamedEntityRepository, newsRepo contract.INewsRepository, userRepo contract.IUserRepository, gemini contract.IGeminiClient, prompts contract.IPromptRegistry, uuidGen contract.IUUIDGenerator) contract.INamedEntityUsecase {
+	return &namedEntityUsecase{entities: entities, newsRepo: newsRepo, userRepo: userRepo, gemini: gemini, prompts: prompts, uuidGen: uuidGen}
+}
+
+// extractedEntity is one item of the model's JSON reply.
+type extractedEntity struct {
+	Type    string   `json:"type"`
+	NameEN  string   `json:"name_en"`
+	NameAM  string   `json:"name_am"`
+	Aliases []string `json:"aliases"`
+}
+
+func (u *namedEntityUsecase) ExtractForNews(ctx context.Context, news *entity.News) ([]*entity.NamedEntity, error) {
+	title := firstNonEmpty(news.TitleEN, news.Title)
+	if news.TitleAM != "" && news.TitleAM != title {
+		title += "\n" + news.TitleAM
+	}
+	p, err := u.prompts.Render(ctx, "extract_entities", "en", news.ID, map[string]string{
+		"title": title,
+		"text":  truncateText(firstNonEmpty(news.BodyEN, news.Body, news.BodyAM), entityExtractionChars),
+	})
+	if err != nil {
+		return nil, err
+	}
+	raw, err := u.gemini.Generate(contract.WithUsageOperation(ctx, "entity_extraction"), p.Text)
+	if err != nil {
+		return nil, err
+	}
+	var items []extractedEntity
+	if err := json.Unmarshal([]byte(extractJSON(raw)), &items); err != nil {
+		return nil, fmt.Errorf("parse entities: %w", err)
+	}
+
+	resolved := []*entity.NamedEntity{}
+	ids := []string{}
+	for _, it := range items {
+		if len(resolved) >= maxEntitiesPerArticle {
+			break
+		}
+		candidate, ok := u.normalize(it)
+		if !ok {
+			continue
+		}
+		e, err := u.entities.Resolve(ctx, candidate)
+		if err != nil {
+			return nil, err
+		}
+		if containsString(ids, e.ID) {
+			continue
+		}
+		ids = append(ids, e.ID)
+		resolved = append(resolved, e)
+	}
+
+	// Only count articles newly linked to an entity so re-extraction is idempotent
+	added := []string{}
+	for _, id := range ids {
+		if !containsString(news.EntityIDs, id) {
+			added = append(added, id)
+		}
+	}
+	if len(added) == 0 && news.EntityPromptVersion == p.Version {
+		return resolved, nil
+	}
+	news.EntityIDs = append(news.EntityIDs, added...)
+	news.EntityPromptVersion = p.Version
+	news.UpdatedAt = time.Now()
+	if err := u.newsRepo.UpdateFields(ctx, news, []string{"entity_ids", "entity_prompt_version"}, nil); err != nil {
+		return nil, err
+	}
+	if len(added) == 0 {
+		return resolved, nil
+	}
+	if err := u.entities.IncrementArticleCount(ctx, added, 1); err != nil {
+		return nil, err
+	}
+	return resolved, nil
+}
+
+// normalize validates a model item and builds the slug and lower-cased alias set.
+func (u *namedEntityUsecase) normalize(it extractedEntity) (*entity.NamedEntity, bool) {
+	t, ok := entity.ParseNamedEntityType(strings.ToLower(strings.TrimSpace(it.Type)))
+	if !ok {
+		return nil, false
+	}
+	nameEN := strings.TrimSpace(it.NameEN)
+	nameAM := strings.TrimSpace(it.NameAM)
+	slug := entitySlug(firstNonEmpty(nameEN, nameAM))
+	if slug == "" {
+		return nil, false
+	}
+	aliases := []string{}
+	for _, a := range append([]string{nameEN, nameAM}, it.Aliases...) {
+		a = strings.ToLower(strings.Join(strings.Fields(a), " "))
+		if a != "" && !containsString(aliases, a) {
+			aliases = append(aliases, a)
+		}
+	}
+	return &entity.NamedEntity{
+		ID:      u.uuidGen.NewUUID(),
+		Slug:    slug,
+		Type:    t,
+		Label:   entity.BilingualField{EN: nameEN, AM: nameAM},
+		Aliases: aliases,
+	}, true
+}
+
+// entitySlug lower-cases the name and joins letter/digit runs with hyphens.
+// Non-Latin letters (Ge'ez) are kept so Amharic-only names still get a slug.
+func entitySlug(name string) string {
+	fields := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.Is(unicode.Mn, r)
+	})
+	return strings.Join(fields, "-")
+}
+
+func (u *namedEntityUsecase) GetEntity(ctx context.Context, id string) (*entity.NamedEntity, error) {
+	return u.entities.FindByID(ctx, id)
+}
+
+func (u *namedEntityUsecase) ListEntities(ctx context.Context, entityType entity.NamedEntityType, query string, page, limit int) ([]*entity.NamedEntity, int64, int, error) {
+	return u.entities.List(ctx, entityType, strings.TrimSpace(query), page, limit)
+}
+
+func (u *namedEntityUsecase) ListNews(ctx context.Context, entityID string, page, limit int) ([]*entity.News, int64, int, error) {
+	if _, err := u.entities.FindByID(ctx, entityID); err != nil {
+		return nil, 0, 0, err
+	}
+	return u.newsRepo.FindByEntityID(ctx, entityID, page, limit)
+}
+
+// FollowEntities follows multiple entities; if the list is empty, no-op.
+func (u *namedEntityUsecase) FollowEntities(ctx context.Context, userID string, entityIDs []string) error {
+	if userID == "" {
+		return errors.New("user ID is required")
+	}
+	if len(entityIDs) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(entityIDs))
+	for _, id := range entityIDs {
+		if id == "" {
+			return errors.New("invalid entity id")
+		}
+		if !containsString(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	existing, err := u.entities.FindByIDs(ctx, ids)
+	if err != nil {
+		return errors.New("failed to validate entities")
+	}
+	found := make([]string, 0, len(existing))
+	for _, e := range existing {
+		found = append(found, e.ID)
+	}
+	var missing []string
+	for _, id := range ids {
+		if !containsString(found, id) {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("entities not found: %v", missing)
+	}
+	return u.userRepo.FollowEntities(ctx, userID, ids)
+}
+
+func (u *namedEntityUsecase) UnfollowEntity(ctx context.Context, userID, entityID string) error {
+	if userID == "" {
+		return errors.New("user ID is required")
+	}
+	if _, err := u.entities.FindByID(ctx, entityID); err != nil {
+		if errors.Is(err, contract.ErrNotFound) {
+			return errors.New("entity not found")
+		}
+		return err
+	}
+	return u.userRepo.UnfollowEntity(ctx, userID, entityID)
+}
+
+func (u *namedEntityUsecase) GetFollowedEntities(ctx context.Context, userID string) ([]*entity.NamedEntity, error) {
+	ids, err := u.userRepo.GetFollowedEntityIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return u.entities.FindByIDs(ctx, ids)
+}
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"time"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
@@ -12,27 +13,36 @@ type NewsIngestionUsecase struct {
 	geminiClient contract.IGeminiClient
 	newsRepo     contract.INewsRepository
 	uuidGen      contract.IUUIDGenerator
+	embeddings   contract.IEmbeddingService
+	stories      contract.IStoryUsecase
+	entities     contract.INamedEntityUsecase
+	prompts      contract.IPromptRegistry
 }
 
-func NewNewsIngestionUsecase(geminiClient contract.IGeminiClient, repo contract.INewsRepository, uuidGen contract.IUUIDGenerator) contract.INewsIngestionService {
+func NewNewsIngestionUsecase(geminiClient contract.IGeminiClient, repo contract.INewsRepository, uuidGen contract.IUUIDGenerator, embeddings contract.IEmbeddingService, stories contract.IStoryUsecase, entities contract.INamedEntityUsecase, prompts contract.IPromptRegistry) contract.INewsIngestionService {
 	return &NewsIngestionUsecase{
 		geminiClient: geminiClient,
 		newsRepo:     repo,
 		uuidGen:      uuidGen,
+		embeddings:   embeddings,
+		stories:      stories,
+		entities:     entities,
+		prompts:      prompts,
 	}
 }
 
 // SaveAfterSummarize summarizes the news then saves it.
-func (uc *NewsIngestionUsecase) SaveAfterSummarize(news *entity.News) (*entity.News, entity.Summary, error) {
+func (uc *NewsIngestionUsecase) SaveAfterSummarize(ctx context.Context, news *entity.News) (*entity.News, entity.Summary, error) {
 	// Ensure the news has an ID generated via UUID generator
 	if news.ID == "" {
 		news.ID = uc.uuidGen.NewUUID()
 	}
 	// Generate summary based on language
-	summaryText, err := uc.geminiClient.Summarize(news.Body, news.Language)
+	summaryText, promptVersion, err := summarizeWithPrompt(ctx, uc.geminiClient, uc.prompts, news.ID, news.Body, news.Language)
 	if err != nil {
 		return nil, entity.Summary{}, err
 	}
+	news.SummaryPromptVersion = promptVersion
 
 	switch news.Language {
 	case "en":
@@ -48,13 +58,23 @@ func (uc *NewsIngestionUsecase) SaveAfterSummarize(news *entity.News) (*entity.N
 	if err := uc.newsRepo.Save(news); err != nil {
 		return nil, entity.Summary{}, err
 	}
+	if uc.embeddings != nil {
+		_ = uc.embeddings.IndexNews(ctx, news)
+	}
+	if uc.stories != nil {
+		_, _ = uc.stories.AssignNews(ctx, news)
+	}
+	if uc.entities != nil {
+		_, _ = uc.entities.ExtractForNews(ctx, news)
+	}
 
 	summary := entity.Summary{
-		NewsID:    news.ID,
-		Content:   summaryText,
-		Language:  news.Language,
-		CreatedAt: now,
-		UpdatedAt: now,
+		NewsID:        news.ID,
+		Content:       summaryText,
+		Language:      news.Language,
+		PromptVersion: promptVersion,
+		CreatedAt:     now,
+		UpdatedAt:     now,
 	}
 
 	return news, summary, nil
@@ -2,8 +2,11 @@ package usecase
 
 import (
 	"context"
+	"math"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
@@ -11,6 +14,15 @@ import (
 	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/localization"
 )
 
+const (
+	// rankingPool is how many of the newest articles trending re-ranks
+	rankingPool = 100
+	// rankingHalfLife is the age at which an article's ranking weight halves
+	rankingHalfLife = 24 * time.Hour
+	// reliabilityRefresh is how long source reliability factors are cached
+	reliabilityRefresh = time.Minute
+)
+
 type newsUsecase struct {
 	repo         contract.INewsRepository
 	userRepo     contract.IUserRepository
@@ -18,11 +30,16 @@ type newsUsecase struct {
 	analyticRepo contract.IAnalyticRepository
 	uuidGen      contract.IUUIDGenerator
 	SummarizerUC contract.ISummarizerService
-	translator   contract.ITranslationClient
+	translations contract.ITranslationPipeline
+	embeddings   contract.IEmbeddingService
+
+	factorMu        sync.Mutex
+	factors         map[string]float64
+	factorsLoadedAt time.Time
 }
 
-func NewNewsUsecase(repo contract.INewsRepository, userRepo contract.IUserRepository, sourceRepo contract.ISourceRepository, analyticRepo contract.IAnalyticRepository, uuidGen contract.IUUIDGenerator, summarizerUC contract.ISummarizerService, translator contract.ITranslationClient) contract.INewsUsecase {
-	return &newsUsecase{repo: repo, userRepo: userRepo, sourceRepo: sourceRepo, analyticRepo: analyticRepo, uuidGen: uuidGen, SummarizerUC: summarizerUC, translator: translator}
+func NewNewsUsecase(repo contract.INewsRepository, userRepo contract.IUserRepository, sourceRepo contract.ISourceRepository, analyticRepo contract.IAnalyticRepository, uuidGen contract.IUUIDGenerator, summarizerUC contract.ISummarizerService, translations contract.ITranslationPipeline, embeddings contract.IEmbeddingService) contract.INewsUsecase {
+	return &newsUsecase{repo: repo, userRepo: userRepo, sourceRepo: sourceRepo, analyticRepo: analyticRepo, uuidGen: uuidGen, SummarizerUC: summarizerUC, translations: translations, embeddings: embeddings}
 }
 
 func (u *newsUsecase) AdminCreateNews(ctx context.Context, title, body, language, sourceID string, topicIDs []string) (*entity.News, error) {
@@ -54,7 +71,7 @@ func (u *newsUsecase) AdminCreateNews(ctx context.Context, title, body, language
 	if err := u.repo.AdminCreateNews(ctx, news); err != nil {
 		return nil, err
 	}
-	if _, err := u.SummarizerUC.Summarize(news.ID); err != nil {
+	if _, err := u.SummarizerUC.Summarize(ctx, news.ID); err != nil {
 		return nil, err
 	}
 	// Reload updated record with summary field filled
@@ -62,60 +79,15 @@ func (u *newsUsecase) AdminCreateNews(ctx context.Context, title, body, language
 	if err == nil && updated != nil {
 		news = updated
 	}
-	// Translate missing counterparts via translator client
-	if u.translator != nil {
-		if news.TitleEN != "" && news.TitleAM == "" {
-			if t, err := u.translator.Translate(news.TitleEN, "en", "am"); err == nil {
-				news.TitleAM = t
-			}
-		}
-		if news.TitleAM != "" && news.TitleEN == "" {
-			if t, err := u.translator.Translate(news.TitleAM, "am", "en"); err == nil {
-				news.TitleEN = t
-			}
-		}
-		if news.BodyEN != "" && news.BodyAM == "" {
-			if t, err := u.translator.Translate(news.BodyEN, "en", "am"); err == nil {
-				news.BodyAM = t
-			}
-		}
-		if news.BodyAM != "" && news.BodyEN == "" {
-			if t, err := u.translator.Translate(news.BodyAM, "am", "en"); err == nil {
-				news.BodyEN = t
-			}
-		}
-		if news.SummaryEN != "" && news.SummaryAM == "" {
-			if t, err := u.translator.Translate(news.SummaryEN, "en", "am"); err == nil {
-				news.SummaryAM = t
-			}
-		}
-		if news.SummaryAM != "" && news.SummaryEN == "" {
-			if t, err := u.translator.Translate(news.SummaryAM, "am", "en"); err == nil {
-				news.SummaryEN = t
-			}
-		}
-	}
-	// Fallback mirror if translation failed
-	if news.SummaryEN == "" && news.SummaryAM != "" {
-		news.SummaryEN = news.SummaryAM
-	}
-	if news.SummaryAM == "" && news.SummaryEN != "" {
-		news.SummaryAM = news.SummaryEN
+	// Counterpart-language fields are filled by the background translation worker
+	if u.translations != nil {
+		u.translations.Enqueue(news)
 	}
-	if news.TitleEN == "" && news.TitleAM != "" {
-		news.TitleEN = news.TitleAM
-	}
-	if news.TitleAM == "" && news.TitleEN != "" {
-		news.TitleAM = news.TitleEN
-	}
-	if news.BodyEN == "" && news.BodyAM != "" {
-		news.BodyEN = news.BodyAM
-	}
-	if news.BodyAM == "" && news.BodyEN != "" {
-		news.BodyAM = news.BodyEN
-	}
-	// Persist mirrored updates
+	// Persist translation state
 	_ = u.repo.Update(news)
+	if u.embeddings != nil {
+		_ = u.embeddings.IndexNews(ctx, news)
+	}
 	if err := u.analyticRepo.IncrementTotalNews(ctx); err != nil {
 		return nil, err
 	}
@@ -171,20 +143,148 @@ func (u *newsUsecase) ListByTopicID(ctx context.Context, topicID string, page, l
 	return u.repo.FindByTopicID(ctx, topicID, page, limit)
 }
 
-// ListTrending returns paginated news sorted by recency (as a basic trending mechanism)
-func (u *newsUsecase) ListTrending(page, limit int) ([]*entity.News, int64, int, error) {
+// ListTrending returns paginated news ranked by recency and source reliability.
+// The first pages are re-ranked from a pool of the newest articles; deeper
+// pages continue chronologically after the pool.
+func (u *newsUsecase) ListTrending(ctx context.Context, page, limit int) ([]*entity.News, int64, int, error) {
 	if limit <= 0 {
 		limit = 10
 	}
-	return u.repo.FindTrending(page, limit)
+	if page < 1 {
+		page = 1
+	}
+	// a whole number of pages, so re-ranked and chronological pages never overlap
+	pool := (rankingPool + limit - 1) / limit * limit
+	if page*limit > pool {
+		return u.repo.FindTrending(page, limit)
+	}
+	list, total, _, err := u.repo.FindTrending(1, pool)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	u.rankByReliability(ctx, list)
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	start := (page - 1) * limit
+	if start >= len(list) {
+		return []*entity.News{}, total, totalPages, nil
+	}
+	end := start + limit
+	if end > len(list) {
+		end = len(list)
+	}
+	return list[start:end], total, totalPages, nil
 }
 
-// ListToday returns the top-N news items from today only
-func (u *newsUsecase) ListToday(limit int) ([]*entity.News, int64, int, error) {
+// ListToday returns the top-N news items from today only, ranked like trending
+func (u *newsUsecase) ListToday(ctx context.Context, limit int) ([]*entity.News, int64, int, error) {
 	if limit <= 0 {
 		limit = 4
 	}
-	return u.repo.FindToday(limit)
+	// rank a wider pool so reliable sources can move up into the top N
+	list, total, _, err := u.repo.FindToday(limit * 5)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	u.rankByReliability(ctx, list)
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+	return list, total, totalPages, nil
+}
+
+// rankByReliability orders news by recency weighted by the reliability of
+// their source: an article halves in weight every rankingHalfLife, and a
+// source scored 100 counts three times one scored 0. Sources not scored yet
+// count as neutral.
+func (u *newsUsecase) rankByReliability(ctx context.Context, list []*entity.News) {
+	if len(list) < 2 || u.sourceRepo == nil {
+		return
+	}
+	factor := u.reliabilityFactors(ctx)
+	now := time.Now()
+	weight := func(n *entity.News) float64 {
+		f, ok := factor[n.SourceID]
+		if !ok {
+			f = 1
+		}
+		age := now.Sub(n.PublishedAt).Hours()
+		if age < 0 {
+			age = 0
+		}
+		return f * math.Pow(0.5, age/rankingHalfLife.Hours())
+	}
+	sort.SliceStable(list, func(i, j int) bool { return weight(list[i]) > weight(list[j]) })
+}
+
+// reliabilityFactors returns the ranking factor of every scored source,
+// reloading them at most every reliabilityRefresh. When a reload fails the
+// previous factors are kept; without any, ranking falls back to recency.
+func (u *newsUsecase) reliabilityFactors(ctx context.Context) map[string]float64 {
+	u.factorMu.Lock()
+	defer u.factorMu.Unlock()
+	if !u.factorsLoadedAt.IsZero() && time.Since(u.factorsLoadedAt) < reliabilityRefresh {
+		return u.factors
+	}
+	lookupCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+	sources, err := u.sourceRepo.GetAll(lookupCtx)
+	if err == nil {
+		factors := make(map[string]float64, len(sources))
+		for _, src := range sources {
+			if src.Reliability != nil || src.ReliabilityOverride != nil || src.ReliabilityScore > 0 {
+				factors[src.ID] = 0.5 + src.ReliabilityScore/100
+			}
+		}
+		u.factors = factors
+	}
+	u.factorsLoadedAt = time.Now()
+	return u.factors
+}
+
+// ListRelated returns semantically similar news around the article's publication date
+func (u *newsUsecase) ListRelated(ctx context.Context, newsID string, limit int, window time.Duration) ([]*entity.News, error) {
+	if limit <= 0 {
+		limit = 5
+	}
+	if window <= 0 {
+		window = 7 * 24 * time.Hour
+	}
+	if u.embeddings == nil {
+		return []*entity.News{}, nil
+	}
+	news, err := u.repo.FindByID(newsID)
+	if err != nil {
+		return nil, err
+	}
+	filter := contract.VectorFilter{
+		PublishedAfter:  news.PublishedAt.Add(-window),
+		PublishedBefore: news.PublishedAt.Add(window),
+	}
+	matches, err := u.embeddings.SimilarTo(ctx, news, limit, filter)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(matches))
+	for _, m := range matches {
+		ids = append(ids, m.NewsID)
+	}
+	found, err := u.repo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	// keep similarity order rather than the repository's date order
+	byID := make(map[string]*entity.News, len(found))
+	for _, n := range found {
+		byID[n.ID] = n
+	}
+	related := make([]*entity.News, 0, len(found))
+	for _, id := range ids {
+		if n, ok := byID[id]; ok {
+			related = append(related, n)
+		}
+	}
+	return related, nil
 }
 
 var adminNewsPrefix = regexp.MustCompile(`(?i)^news:\s*`)
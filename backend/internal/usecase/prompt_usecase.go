@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// promptRefresh is how long active overrides are cached before reloading.
+const promptRefresh = 30 * time.Second
+
+// defaultPromptWeight is the traffic share of a version when none is given.
+const defaultPromptWeight = 100
+
+type promptUsecase struct {
+	repo     contract.IPromptRepository
+	uuidGen  contract.IUUIDGenerator
+	builtins []entity.PromptTemplate
+	guard    contract.ISafetyGuard
+
+	mu       sync.Mutex
+	active   []*entity.PromptTemplate
+	loadedAt time.Time
+}
+
+// NewPromptUsecase serves the built-in templates unless an admin override is
+// active. Overrides take precedence over built-ins; within each, a version for
+// the output language beats one for all languages. The guard, when set,
+// isolates the untrusted variables of every rendered prompt so scraped text
+// cannot pass itself off as instructions.
+func NewPromptUsecase(repo contract.IPromptRepository, builtins []entity.PromptTemplate, uuidGen contract.IUUIDGenerator, guard contract.ISafetyGuard) contract.IPromptUsecase {
+	return &promptUsecase{repo: repo, builtins: builtins, uuidGen: uuidGen, guard: guard}
+}
+
+func (u *promptUsecase) Render(ctx context.Context, name, lang, key string, vars map[string]string) (entity.RenderedPrompt, error) {
+	t, err := u.pick(ctx, name, lang, key)
+	if err != nil {
+		return entity.RenderedPrompt{}, err
+	}
+	filled := make(map[string]string, len(vars)+1)
+	filled["language"] = languageName(lang)
+	untrusted := t.Untrusted
+	if b, ok := u.builtin(name); ok {
+		untrusted = b.Untrusted
+	}
+	for k, v := range vars {
+		if u.guard != nil && containsString(untrusted, k) {
+			v = u.guard.Isolate(ctx, name, key, v)
+		}
+		filled[k] = v
+	}
+	return t.Render(filled)
+}
+
+func (u *promptUsecase) pick(ctx context.Context, name, lang, key string) (entity.PromptTemplate, error) {
+	overrides := u.activeOverrides(ctx)
+	for _, l := range []string{lang, ""} {
+		var candidates []entity.PromptTemplate
+		for _, o := range overrides {
+			if o.Name == name && o.Language == l {
+				candidates = append(candidates, *o)
+			}
+		}
+		if len(candidates) > 0 {
+			return chooseWeighted(candidates, key), nil
+		}
+	}
+	for _, l := range []string{lang, ""} {
+		for _, b := range u.builtins {
+			if b.Name == name && b.Language == l {
+				return b, nil
+			}
+		}
+	}
+	return entity.PromptTemplate{}, fmt.Errorf("unknown prompt %q", name)
+}
+
+// chooseWeighted splits traffic between active versions by weight. The same
+// key always lands on the same version; an empty key picks at random.
+func chooseWeighted(candidates []entity.PromptTemplate, key string) entity.PromptTemplate {
+	total := 0
+	for _, c := range candidates {
+		if c.Weight > 0 {
+			total += c.Weight
+		}
+	}
+	if total == 0 || len(candidates) == 1 {
+		return candidates[0]
+	}
+	n := rand.Intn(total)
+	if key != "" {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(candidates[0].Name + ":" + key))
+		n = int(h.Sum32() % uint32(total))
+	}
+	for _, c := range candidates {
+		if c.Weight <= 0 {
+			continue
+		}
+		if n < c.Weight {
+			return c
+		}
+		n -= c.Weight
+	}
+	return candidates[0]
+}
+
+// activeOverrides returns the cached active overrides, reloading them at most
+// once per promptRefresh. A failed reload keeps serving the last good set.
+func (u *promptUsecase) activeOverrides(ctx context.Context) []*entity.PromptTemplate {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if !u.loadedAt.IsZero() && time.Since(u.loadedAt) < promptRefresh {
+		return u.active
+	}
+	lookupCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+	active, err := u.repo.ListActive(lookupCtx)
+	if err == nil {
+		u.active = active
+	}
+	u.loadedAt = time.Now()
+	return u.active
+}
+
+func (u *promptUsecase) invalidate() {
+	u.mu.Lock()
+	u.loadedAt = time.Time{}
+	u.mu.Unlock()
+}
+
+// builtin returns the language-independent built-in of the prompt, which
+// defines the variables every version may use.
+func (u *promptUsecase) builtin(name string) (entity.PromptTemplate, bool) {
+	var found *entity.PromptTemplate
+	for i, b := range u.builtins {
+		if b.Name != name {
+			continue
+		}
+		if found == nil || b.Language == "" {
+			found = &u.builtins[i]
+		}
+	}
+	if found == nil {
+		return entity.PromptTemplate{}, false
+	}
+	return *found, true
+}
+
+func (u *promptUsecase) List(ctx context.Context, name string) ([]entity.PromptTemplate, error) {
+	out := []entity.PromptTemplate{}
+	for _, b := range u.builtins {
+		if name == "" || b.Name == name {
+			out = append(out, b)
+		}
+	}
+	stored, err := u.repo.List(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range stored {
+		if b, ok := u.builtin(t.Name); ok {
+			t.Variables, t.Required, t.Untrusted = b.Variables, b.Required, b.Untrusted
+		}
+		out = append(out, *t)
+	}
+	return out, nil
+}
+
+func (u *promptUsecase) CreateVersion(ctx context.Context, t entity.PromptTemplate, activate bool) (*entity.PromptTemplate, error) {
+	b, ok := u.builtin(t.Name)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown prompt %q", contract.ErrInvalidInput, t.Name)
+	}
+	if t.Language != "" && t.Language != "en" && t.Language != "am" {
+		return nil, fmt.Errorf("%w: language must be en, am or empty", contract.ErrInvalidInput)
+	}
+	if strings.TrimSpace(t.Text) == "" {
+		return nil, fmt.Errorf("%w: text is required", contract.ErrInvalidInput)
+	}
+	used := entity.PromptPlaceholders(t.Text)
+	for _, v := range used {
+		if !containsString(b.Variables, v) {
+			return nil, fmt.Errorf("%w: unknown variable {{%s}}; allowed: %s", contract.ErrInvalidInput, v, strings.Join(b.Variables, ", "))
+		}
+	}
+	for _, v := range b.Required {
+		if !containsString(used, v) {
+			return nil, fmt.Errorf("%w: variable {{%s}} is required", contract.ErrInvalidInput, v)
+		}
+	}
+
+	latest, err := u.repo.LatestVersion(ctx, t.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, bt := range u.builtins {
+		if bt.Name == t.Name && bt.Version > latest {
+			latest = bt.Version
+		}
+	}
+	if t.Weight <= 0 {
+		t.Weight = defaultPromptWeight
+	}
+	t.ID = u.uuidGen.NewUUID()
+	t.Version = latest + 1
+	t.Origin = entity.PromptOverride
+	t.Active = activate
+	if err := u.repo.Create(ctx, &t); err != nil {
+		return nil, err
+	}
+	u.invalidate()
+	t.Variables, t.Required, t.Untrusted = b.Variables, b.Required, b.Untrusted
+	return &t, nil
+}
+
+func (u *promptUsecase) SetActive(ctx context.Context, id string, active bool, weight int) (*entity.PromptTemplate, error) {
+	if weight <= 0 {
+		weight = defaultPromptWeight
+	}
+	if err := u.repo.SetActive(ctx, id, active, weight); err != nil {
+		return nil, err
+	}
+	u.invalidate()
+	t, err := u.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if b, ok := u.builtin(t.Name); ok {
+		t.Variables, t.Required, t.Untrusted = b.Variables, b.Required, b.Untrusted
+	}
+	return t, nil
+}
+
+// summarizeWithPrompt summarizes text in lang with the served "summarize"
+// prompt and returns the summary with the prompt version used.
+func summarizeWithPrompt(ctx context.Context, gemini contract.IGeminiClient, prompts contract.IPromptRegistry, key, text, lang string) (string, string, error) {
+	p, err := prompts.Render(ctx, "summarize", lang, key, map[string]string{"text": text})
+	if err != nil {
+		return "", "", err
+	}
+	out, err := gemini.Generate(contract.WithUsageOperation(ctx, "summarize"), p.Text)
+	if err != nil {
+		return "", "", err
+	}
+	return strings.TrimSpace(out), p.Version, nil
+}
+
+// classifyWithPrompt asks for up to topK topic labels in lang with the served
+// "classify_topics" prompt.
+func classifyWithPrompt(ctx context.Context, gemini contract.IGeminiClient, prompts contract.IPromptRegistry, key, text, lang string, topK int) ([]string, error) {
+	if topK <= 0 {
+		topK = 2
+	}
+	p, err := prompts.Render(ctx, "classify_topics", lang, key, map[string]string{"text": text, "count": strconv.Itoa(topK)})
+	if err != nil {
+		return nil, err
+	}
+	out, err := gemini.Generate(contract.WithUsageOperation(ctx, "classify_topics"), p.Text)
+	if err != nil {
+		return nil, err
+	}
+	var labels []string
+	if err := json.Unmarshal([]byte(extractJSON(out)), &labels); err == nil {
+		return labels, nil
+	}
+	// fallback: split by commas/newlines
+	for _, part := range strings.Split(strings.ReplaceAll(out, "\n", ","), ",") {
+		if s := strings.TrimSpace(part); s != "" {
+			labels = append(labels, s)
+		}
+	}
+	if len(labels) > topK {
+		labels = labels[:topK]
+	}
+	return labels, nil
+}
@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+const (
+	// previewTTL is how long a dry run can be approved
+	previewTTL = 24 * time.Hour
+	// approvalLease frees a preview whose approving replica died
+	approvalLease = 15 * time.Minute
+)
+
+// previewTranslations are translated while previewing so both languages can
+// be reviewed; bodies are left to the translation worker after approval.
+var previewTranslations = []string{
+	entity.FieldTitleAM, entity.FieldTitleEN,
+	entity.FieldSummaryAM, entity.FieldSummaryEN,
+}
+
+var errPreviewsDisabled = errors.New("ingestion previews are not configured")
+
+func (uc *providerIngestion) PreviewFromProvider(ctx context.Context, query string, topK int, userID string) (*entity.IngestionPreview, error) {
+	if uc.previews == nil {
+		return nil, errPreviewsDisabled
+	}
+	items, err := uc.provider.Search(ctx, query, topK)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	p := &entity.IngestionPreview{
+		ID:              uc.uuidGen.NewUUID(),
+		Query:           query,
+		TopK:            topK,
+		RequestedBy:     userID,
+		Items:           []entity.PreviewItem{},
+		Skipped:         []entity.PreviewSkip{},
+		TopicsToCreate:  []entity.PlannedTopic{},
+		SourcesToCreate: []entity.PlannedSource{},
+		CreatedAt:       now,
+		ExpiresAt:       now.Add(previewTTL),
+	}
+	queue, repeats := dedupeBatch(items)
+	drafts := make([]*draft, len(items))
+	reasons := make([]string, len(items))
+	for _, i := range repeats {
+		reasons[i] = skipRepeated
+	}
+	uc.runPool(ctx, queue, func(i int) {
+		ictx, cancel := context.WithTimeout(ctx, uc.opts.ItemTimeout)
+		defer cancel()
+		d, reason := uc.prepare(ictx, items[i])
+		if d != nil {
+			uc.translatePreview(ictx, d.news)
+		}
+		drafts[i], reasons[i] = d, reason
+	})
+	// a preview missing items would hide what the real run does
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	topics, sources := map[string]bool{}, map[string]bool{}
+	for i, it := range items {
+		d := drafts[i]
+		if d == nil {
+			if reasons[i] == "" {
+				// the item's own deadline passed
+				reasons[i] = skipTimedOut
+			}
+			p.Skipped = append(p.Skipped, entity.PreviewSkip{Title: it.Title, SourceURL: it.SourceURL, Reason: reasons[i]})
+			continue
+		}
+		item := entity.PreviewItem{News: *d.news, Topics: d.topics, Source: d.source, Status: entity.PreviewPending}
+		if item.Topics == nil {
+			item.Topics = []entity.PlannedTopic{}
+		}
+		p.Items = append(p.Items, item)
+		for _, t := range d.topics {
+			if t.Create && !topics[t.Slug] {
+				topics[t.Slug] = true
+				p.TopicsToCreate = append(p.TopicsToCreate, t)
+			}
+		}
+		if s := d.source; s != nil && s.Create && !sources[s.Slug] {
+			sources[s.Slug] = true
+			p.SourcesToCreate = append(p.SourcesToCreate, *s)
+		}
+	}
+	if err := uc.previews.Create(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// translatePreview fills the counterpart title and summary. Fields that
+// fail stay empty and are queued for the translation worker on approval.
+func (uc *providerIngestion) translatePreview(ctx context.Context, n *entity.News) {
+	if uc.translator == nil {
+		return
+	}
+	for _, key := range previewTranslations {
+		sourceKey := entity.TranslatableFields[key]
+		if *n.FieldValue(key) != "" || *n.FieldValue(sourceKey) == "" {
+			continue
+		}
+		out, err := uc.translator.Translate(ctx, *n.FieldValue(sourceKey), entity.FieldLanguage(sourceKey), entity.FieldLanguage(key))
+		if err != nil || out == "" {
+			continue
+		}
+		*n.FieldValue(key) = out
+		if n.Translations == nil {
+			n.Translations = map[string]entity.FieldTranslation{}
+		}
+		n.Translations[key] = entity.FieldTranslation{Status: entity.TranslationDone, SourceField: sourceKey, Attempts: 1, UpdatedAt: time.Now()}
+	}
+}
+
+func (uc *providerIngestion) GetPreview(ctx context.Context, id string) (*entity.IngestionPreview, error) {
+	if uc.previews == nil {
+		return nil, errPreviewsDisabled
+	}
+	return uc.previews.Get(ctx, id)
+}
+
+func (uc *providerIngestion) DiscardPreview(ctx context.Context, id string) error {
+	if uc.previews == nil {
+		return errPreviewsDisabled
+	}
+	return uc.previews.Delete(ctx, id)
+}
+
+// ApprovePreview saves the selected items with the worker pool. Items whose
+// URL was stored since the preview are marked duplicate rather than saved
+// twice. When ctx ends, unsaved items keep their status and can be approved
+// again.
+func (uc *providerIngestion) ApprovePreview(ctx context.Context, id string, newsIDs []string) (*contract.PreviewApproval, error) {
+	if uc.previews == nil {
+		return nil, errPreviewsDisabled
+	}
+	if len(newsIDs) == 0 {
+		return nil, fmt.Errorf("%w: select at least one item", contract.ErrInvalidInput)
+	}
+	p, err := uc.previews.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, nid := range newsIDs {
+		if previewItemIndex(p, nid) < 0 {
+			return nil, fmt.Errorf("%w: %s is not an item of this preview", contract.ErrInvalidInput, nid)
+		}
+	}
+	until := time.Now().UTC().Add(approvalLease)
+	// item statuses are read from the claimed preview: an approval that just
+	// finished may have saved some of the selection
+	if p, err = uc.previews.ClaimApproval(ctx, id, until); err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, fmt.Errorf("%w: this preview is already being approved", contract.ErrConflict)
+	}
+	var queue []int
+	for _, nid := range newsIDs {
+		i := previewItemIndex(p, nid)
+		if i < 0 {
+			continue
+		}
+		// failed items may be retried; saved and duplicate ones are final
+		if st := p.Items[i].Status; (st == entity.PreviewPending || st == entity.PreviewFailed) && !containsInt(queue, i) {
+			queue = append(queue, i)
+		}
+	}
+
+	saved := make([]string, len(p.Items))
+	uc.runPool(ctx, queue, func(i int) {
+		item := &p.Items[i]
+		ictx, cancel := context.WithTimeout(ctx, uc.opts.ItemTimeout)
+		defer cancel()
+		if item.News.SourceURL != "" {
+			if exists, err := uc.newsRepo.ExistsBySourceURL(ictx, item.News.SourceURL); err == nil && exists {
+				item.Status = entity.PreviewDuplicate
+				return
+			}
+		}
+		// the item is only updated once saved, so a failed attempt can be retried as previewed
+		n := item.News
+		n.CreatedAt, n.UpdatedAt = time.Now(), time.Now()
+		d := &draft{news: &n, topics: append([]entity.PlannedTopic(nil), item.Topics...)}
+		if item.Source != nil {
+			src := *item.Source
+			d.source = &src
+		}
+		newsID, failed := uc.store(ictx, d)
+		switch {
+		case newsID != "":
+			item.News, item.Topics, item.Source, item.Status, item.Error = n, d.topics, d.source, entity.PreviewSaved, ""
+			saved[i] = newsID
+		case failed:
+			item.Status, item.Error = entity.PreviewFailed, "save failed"
+		}
+	})
+
+	// the outcome is recorded even when the request was cancelled
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+	if err := uc.previews.FinishApproval(saveCtx, id, until, p.Items); err != nil {
+		return nil, err
+	}
+	res := &contract.PreviewApproval{Saved: []string{}, Preview: p}
+	for _, i := range queue {
+		switch {
+		case saved[i] != "":
+			res.Saved = append(res.Saved, saved[i])
+		case p.Items[i].Status == entity.PreviewDuplicate:
+			res.Duplicates++
+		case p.Items[i].Status == entity.PreviewFailed:
+			res.Failed++
+		}
+	}
+	return res, ctx.Err()
+}
+
+// previewItemIndex returns the index of the item previewing newsID, or -1.
+func previewItemIndex(p *entity.IngestionPreview, newsID string) int {
+	for i, item := range p.Items {
+		if item.News.ID == newsID {
+			return i
+		}
+	}
+	return -1
+}
+
+func containsInt(list []int, v int) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
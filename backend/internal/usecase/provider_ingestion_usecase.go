@@ -2,10 +2,11 @@ package usecase
 
 import (
 	"context"
-	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
+	"unicode"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
@@ -20,235 +21,480 @@ type providerIngestion struct {
 	newsRepo   contract.INewsRepository
 	uuidGen    contract.IUUIDGenerator
 	sourceRepo contract.ISourceRepository
+	embeddings contract.IEmbeddingService
+	stories    contract.IStoryUsecase
+	entities   contract.INamedEntityUsecase
+	// translations fills counterpart-language fields asynchronously
+	translations contract.ITranslationPipeline
+	prompts      contract.IPromptRegistry
+	// extractor fills in bodies the provider or feed left empty (may be nil)
+	extractor contract.IArticleExtractor
+	// media measures and filters article images (may be nil)
+	media contract.IMediaUsecase
+	// previews stores dry runs awaiting approval (may be nil)
+	previews contract.IIngestionPreviewRepository
+	opts     IngestionOptions
+
+	// catalogMu serializes topic and source get-or-create across workers
+	catalogMu sync.Mutex
+	// storyMu serializes story clustering across workers
+	storyMu sync.Mutex
+}
+
+const (
+	// enrichTimeout bounds embedding, clustering and entity extraction of a saved article.
+	enrichTimeout = 45 * time.Second
+	// minBodyWeight is the length below which a body is treated as a teaser
+	// and the full text is fetched from the source page. Ethiopic syllables
+	// count twice, as in the extractor.
+	minBodyWeight = 400
+	// extractTimeout bounds fetching one article page, including host rate limit waits
+	extractTimeout = 30 * time.Second
+)
+
+// IngestionOptions sets how many items are processed at once.
+type IngestionOptions struct {
+	// Workers is the number of items processed concurrently (default 4)
+	Workers int
+	// ItemTimeout bounds the summarize/classify/save pipeline of one item (default 90s)
+	ItemTimeout time.Duration
 }
 
-func NewProviderIngestionUsecase(provider contract.INewsProviderClient, gemini contract.IGeminiClient, translator contract.ITranslationClient, topics contract.ITopicRepository, newsRepo contract.INewsRepository, uuidGen contract.IUUIDGenerator, sourceRepo contract.ISourceRepository) contract.IProviderIngestionUsecase {
-	return &providerIngestion{provider: provider, gemini: gemini, translator: translator, topics: topics, newsRepo: newsRepo, uuidGen: uuidGen, sourceRepo: sourceRepo}
+func NewProviderIngestionUsecase(provider contract.INewsProviderClient, gemini contract.IGeminiClient, translator contract.ITranslationClient, topics contract.ITopicRepository, newsRepo contract.INewsRepository, uuidGen contract.IUUIDGenerator, sourceRepo contract.ISourceRepository, embeddings contract.IEmbeddingService, stories contract.IStoryUsecase, entities contract.INamedEntityUsecase, translations contract.ITranslationPipeline, prompts contract.IPromptRegistry, extractor contract.IArticleExtractor, media contract.IMediaUsecase, previews contract.IIngestionPreviewRepository, opts IngestionOptions) contract.IProviderIngestionUsecase {
+	if opts.Workers <= 0 {
+		opts.Workers = 4
+	}
+	if opts.ItemTimeout <= 0 {
+		opts.ItemTimeout = 90 * time.Second
+	}
+	return &providerIngestion{provider: provider, gemini: gemini, translator: translator, topics: topics, newsRepo: newsRepo, uuidGen: uuidGen, sourceRepo: sourceRepo, embeddings: embeddings, stories: stories, entities: entities, translations: translations, prompts: prompts, extractor: extractor, media: media, previews: previews, opts: opts}
 }
 
 func (uc *providerIngestion) IngestFromProvider(ctx context.Context, query string, topK int) ([]string, int, error) {
-	items, err := uc.provider.Search(query, topK)
-	fmt.Println(items)
+	items, err := uc.provider.Search(ctx, query, topK)
 	if err != nil {
 		return nil, 0, err
 	}
-	ids := make([]string, 0, len(items))
-	skipped := 0
-	for _, it := range items {
-		// Clean title prefix like "News:" (case-insensitive) and variants
-		cleanTitle := sanitizeTitle(it.Title)
-		// Body should be full original text (provider returns 'text' field). Since current contract.ProviderItem
-		// does not yet declare Text, some integrations may have temporarily used title+URL; we just store title as fallback.
-		body := strings.TrimSpace(it.Text)
-		if body == "" {
-			body = strings.TrimSpace(it.Title)
-		}
-		lang := it.Lang
-		if lang == "" {
-			lang = "en"
+	return uc.IngestItems(ctx, items)
+}
+
+// IngestItems runs the items through a pool of uc.opts.Workers workers. Each
+// item is saved as soon as it is processed, so when ctx ends the finished
+// articles are kept and returned with ctx.Err(); unfinished items are neither
+// saved nor counted as skipped.
+func (uc *providerIngestion) IngestItems(ctx context.Context, items []contract.ProviderItem) ([]string, int, error) {
+	report, err := uc.IngestBatch(ctx, items)
+	return report.IDs, report.SkippedTotal(), err
+}
+
+func (uc *providerIngestion) IngestBatch(ctx context.Context, items []contract.ProviderItem) (contract.IngestReport, error) {
+	type outcome struct {
+		id     string
+		reason string
+	}
+	results := make([]outcome, len(items))
+	queue, repeats := dedupeBatch(items)
+	for _, i := range repeats {
+		results[i].reason = skipRepeated
+	}
+	uc.runPool(ctx, queue, func(i int) {
+		id, reason := uc.ingestOne(ctx, items[i])
+		results[i] = outcome{id: id, reason: reason}
+	})
+
+	report := contract.IngestReport{IDs: make([]string, 0, len(items)), Skipped: map[string]int{}}
+	for _, r := range results {
+		switch {
+		case r.id != "":
+			report.IDs = append(report.IDs, r.id)
+		case r.reason != "":
+			report.Skipped[r.reason]++
 		}
+	}
+	return report, ctx.Err()
+}
 
-		// Summarize original language body with richer multi-sentence requirement.
-		// Post-process to ensure at least 5 sentences, each broken into two lines for readability.
-		summaryRaw, err := uc.gemini.Summarize(body, lang)
-		if err != nil {
-			skipped++
+// dedupeBatch returns the indexes of the items to process and of later
+// repeats of an URL. Workers run side by side, so repeats within the batch
+// are dropped up front.
+func dedupeBatch(items []contract.ProviderItem) (queue, repeats []int) {
+	seen := map[string]bool{}
+	queue = make([]int, 0, len(items))
+	for i, it := range items {
+		if it.SourceURL != "" && seen[it.SourceURL] {
+			repeats = append(repeats, i)
 			continue
 		}
-		summary := enforceMultiLineFiveSentence(summaryRaw)
+		seen[it.SourceURL] = true
+		queue = append(queue, i)
+	}
+	return queue, repeats
+}
 
-		// Classify to topics (raw labels) then map strictly to allowed domain topics
-		rawLabels, _ := uc.gemini.ClassifyTopics(body, lang, 4)
-		allowedSlugsSet := map[string]struct{}{}
-		for _, lbl := range rawLabels {
-			if lbl == "" {
-				continue
-			}
-			if mapped := mapLabelToAllowed(lbl); mapped != "" {
-				allowedSlugsSet[mapped] = struct{}{}
+// runPool calls fn for each queued index on uc.opts.Workers workers. Indexes
+// not handed out when ctx ends are not processed.
+func (uc *providerIngestion) runPool(ctx context.Context, queue []int, fn func(i int)) {
+	next := make(chan int)
+	workers := uc.opts.Workers
+	if workers > len(queue) {
+		workers = len(queue)
+	}
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				fn(i)
 			}
+		}()
+	}
+feed:
+	for _, i := range queue {
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			break feed
 		}
-		// Ensure we have topic IDs only for allowed topics; lazily create missing allowed topics (once)
-		// (Creation limited to the predefined whitelist only)
-		topicIDs := make([]string, 0, len(allowedSlugsSet))
-		for slug := range allowedSlugsSet {
-			if existing, err := uc.topics.GetTopicBySlug(ctx, slug); err == nil && existing != nil && existing.ID != "" {
-				topicIDs = append(topicIDs, existing.ID)
-				continue
-			}
-			// Create with bilingual label (Amharic left same placeholder until separate localization strategy)
-			labelEN := allowedTopics[slug]
-			if labelEN == "" {
-				continue
-			}
-			labelAM, err := uc.translator.Translate(labelEN, "en", "am")
-			if err != nil{
-				continue
-			}
-						
-			t := &entity.Topic{ID: uc.uuidGen.NewUUID(), Slug: slug, Label: entity.BilingualField{EN: labelEN, AM: labelAM}}
-			if err := uc.topics.CreateTopic(ctx, t); err == nil {
-				topicIDs = append(topicIDs, t.ID)
-			}
+	}
+	close(next)
+	wg.Wait()
+}
+
+// extractPage fetches the source page when the item has no body, only a
+// teaser or no pictures. It returns nil when extraction is disabled or
+// fails; failures only cost the fuller body and the images.
+func (uc *providerIngestion) extractPage(ctx context.Context, it contract.ProviderItem, body string) *contract.ExtractedArticle {
+	if uc.extractor == nil || it.SourceURL == "" {
+		return nil
+	}
+	if textWeight(body) >= minBodyWeight && (uc.media == nil || it.ImageURL != "" || len(it.Images) > 0) {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, extractTimeout)
+	defer cancel()
+	a, err := uc.extractor.Extract(ctx, it.SourceURL)
+	if err != nil {
+		return nil
+	}
+	return a
+}
+
+// textWeight measures text length with Ethiopic syllables counted twice, so
+// Amharic and English bodies compare on roughly equal terms.
+func textWeight(s string) int {
+	w := 0
+	for _, r := range s {
+		switch {
+		case unicode.Is(unicode.Ethiopic, r):
+			w += 2
+		case !unicode.IsSpace(r):
+			w++
 		}
+	}
+	return w
+}
 
-		// parse published time
-		var published time.Time
-		if it.PublishedDate != "" {
-			if tm, err := time.Parse(time.RFC3339, it.PublishedDate); err == nil {
-				published = tm
-			}
+// ingestOne summarizes, classifies and saves one item. It returns the new
+// article ID, or the reason the item was dropped. Both are empty when ctx
+// ended first.
+func (uc *providerIngestion) ingestOne(ctx context.Context, it contract.ProviderItem) (id, reason string) {
+	if ctx.Err() != nil {
+		return "", ""
+	}
+	ctx, cancel := context.WithTimeout(ctx, uc.opts.ItemTimeout)
+	defer cancel()
+	d, reason := uc.prepare(ctx, it)
+	if d == nil {
+		return "", reason
+	}
+	id, failed := uc.store(ctx, d)
+	if failed {
+		return "", skipSaveFailed
+	}
+	return id, ""
+}
+
+// Reasons an item is not ingested
+const (
+	skipStored   = "already_stored"
+	skipRepeated = "repeated_in_batch"
+	skipSummary  = "summary_failed"
+	skipTimedOut = "timed_out"
+	// skipSaveFailed is only reported by ingestion; previews do not save
+	skipSaveFailed = "save_failed"
+)
+
+// draft is an article ready to be saved, with the topics and source it is
+// attributed to. Those marked Create do not exist yet; nothing is written
+// until the draft is stored.
+type draft struct {
+	news   *entity.News
+	topics []entity.PlannedTopic
+	source *entity.PlannedSource
+}
+
+// prepare runs the pipeline of one item up to, but not including, any write:
+// dedupe, extraction, summary, classification, images and source resolution.
+// It returns the skip reason when the item is dropped, and neither when ctx
+// ended first.
+func (uc *providerIngestion) prepare(ctx context.Context, it contract.ProviderItem) (*draft, string) {
+	// Feeds and the provider repeat entries across polls; keep the first copy
+	if it.SourceURL != "" {
+		if exists, err := uc.newsRepo.ExistsBySourceURL(ctx, it.SourceURL); err == nil && exists {
+			return nil, skipStored
 		}
-		if published.IsZero() {
-			published = time.Now().UTC()
+	}
+	// Clean title prefix like "News:" (case-insensitive) and variants
+	cleanTitle := sanitizeTitle(it.Title)
+	// Body should be the full original text. Missing or teaser bodies are
+	// completed from the source page; the title remains the last resort.
+	body := strings.TrimSpace(it.Text)
+	lang := it.Lang
+	extracted := uc.extractPage(ctx, it, body)
+	if extracted != nil && textWeight(extracted.Text) > textWeight(body) {
+		body = extracted.Text
+		if lang == "" {
+			lang = extracted.Lang
 		}
+	}
+	if body == "" {
+		body = strings.TrimSpace(it.Title)
+	}
+	if lang == "" {
+		lang = "en"
+	}
 
-		// If after classification we have <2 topics, deterministically add fallback topics based on heuristics.
-		if len(topicIDs) < 2 {
-			fallbacks := inferFallbackTopics(cleanTitle, body)
-			for _, slug := range fallbacks {
-				if len(topicIDs) >= 2 {
-					break
-				}
-				// ensure allowed and not already included
-				if _, ok := allowedTopics[slug]; !ok {
-					continue
-				}
-				already := false
-				for _, id := range topicIDs { // we only have IDs; need to confirm not duplicate by slug creation attempt
-					// cheap: attempt to fetch by slug and compare id
-					if existing, err := uc.topics.GetTopicBySlug(ctx, slug); err == nil && existing != nil && existing.ID == id {
-						already = true
-						break
-					}
-				}
-				if already {
-					continue
-				}
-				if existing, err := uc.topics.GetTopicBySlug(ctx, slug); err == nil && existing != nil && existing.ID != "" {
-					topicIDs = append(topicIDs, existing.ID)
-					continue
-				}
-				// create
-				labelEN := allowedTopics[slug]
-				t := &entity.Topic{ID: uc.uuidGen.NewUUID(), Slug: slug, Label: entity.BilingualField{EN: labelEN, AM: labelEN}}
-				if err := uc.topics.CreateTopic(ctx, t); err == nil {
-					topicIDs = append(topicIDs, t.ID)
-				}
-			}
+	// Summarize original language body with richer multi-sentence requirement.
+	// Post-process to ensure at least 5 sentences, each broken into two lines for readability.
+	promptKey := firstNonEmpty(it.ID, it.SourceURL, cleanTitle)
+	summaryRaw, promptVersion, err := summarizeWithPrompt(ctx, uc.gemini, uc.prompts, promptKey, body, lang)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ""
 		}
+		return nil, skipSummary
+	}
+	summary := enforceMultiLineFiveSentence(summaryRaw)
 
-		// Pre-translate title/body/summary into both languages
-		newsID := uc.uuidGen.NewUUID()
-		eth := localization.ToEthiopian(published)
-		// Resolve or create source
-		sourceID := ""
-		if it.SourceSite != "" && uc.sourceRepo != nil {
-			slug := slugify(it.SourceSite)
-			if existing, err := uc.sourceRepo.GetBySlug(ctx, slug); err == nil && existing != nil && existing.ID != "" {
-				sourceID = existing.ID
-			} else {
-				// create new source skeleton
-				s := &entity.Source{
-					ID:               uc.uuidGen.NewUUID(),
-					Slug:             slug,
-					Name:             it.SourceSite,
-					Description:      "", // optional
-					URL:              it.SourceURL,
-					LogoURL:          "",
-					Languages:        entity.SetLanguageType(lang),
-					ReliabilityScore: 0,
-				}
-				if err := uc.sourceRepo.CreateSource(ctx, s); err == nil {
-					sourceID = s.ID
-				}
-			}
+	// Classify to topics (raw labels) then map strictly to allowed domain topics
+	rawLabels, _ := classifyWithPrompt(ctx, uc.gemini, uc.prompts, promptKey, body, lang, 4)
+	allowedSlugsSet := map[string]struct{}{}
+	for _, lbl := range rawLabels {
+		if lbl == "" {
+			continue
 		}
-
-		n := &entity.News{
-			ID:                     newsID,
-			Title:                  cleanTitle,
-			Body:                   body,
-			SourceURL:              it.SourceURL,
-			Language:               lang,
-			SourceID:               sourceID,
-			Topics:                 topicIDs,
-			PublishedAt:            published,
-			PublishedDateLocalized: eth.FormatYYYYMMDD(),
-			CreatedAt:              time.Now(),
-			UpdatedAt:              time.Now(),
+		if mapped := mapLabelToAllowed(lbl); mapped != "" {
+			allowedSlugsSet[mapped] = struct{}{}
 		}
-		// Store originals in their language-specific fields
-		if lang == "en" {
-			n.TitleEN, n.BodyEN, n.SummaryEN = cleanTitle, body, summary
-		} else if lang == "am" {
-			n.TitleAM, n.BodyAM, n.SummaryAM = cleanTitle, body, summary
-		} else {
-			n.TitleEN, n.BodyEN, n.SummaryEN = cleanTitle, body, summary
+	}
+	// Topics come from the predefined whitelist only; missing ones are
+	// planned with a translated label and created when the article is stored.
+	d := &draft{}
+	for slug := range allowedSlugsSet {
+		if t, ok := uc.planTopic(ctx, slug, true); ok {
+			d.topics = append(d.topics, t)
 		}
+	}
 
-		// Translate into counterpart language
-		if uc.translator != nil {
-			if n.TitleEN != "" && n.TitleAM == "" {
-				if t, err := uc.translator.Translate(n.TitleEN, "en", "am"); err == nil {
-					n.TitleAM = t
-				}
-			}
-			if n.TitleAM != "" && n.TitleEN == "" {
-				if t, err := uc.translator.Translate(n.TitleAM, "am", "en"); err == nil {
-					n.TitleEN = t
-				}
-			}
-			if n.BodyEN != "" && n.BodyAM == "" {
-				if t, err := uc.translator.Translate(n.BodyEN, "en", "am"); err == nil {
-					n.BodyAM = t
-				}
-			}
-			if n.BodyAM != "" && n.BodyEN == "" {
-				if t, err := uc.translator.Translate(n.BodyAM, "am", "en"); err == nil {
-					n.BodyEN = t
-				}
+	// parse published time
+	var published time.Time
+	if it.PublishedDate != "" {
+		if tm, err := time.Parse(time.RFC3339, it.PublishedDate); err == nil {
+			published = tm
+		}
+	}
+	if published.IsZero() {
+		published = time.Now().UTC()
+	}
+
+	// If after classification we have <2 topics, deterministically add fallback topics based on heuristics.
+	if len(d.topics) < 2 {
+		for _, slug := range inferFallbackTopics(cleanTitle, body) {
+			if len(d.topics) >= 2 {
+				break
 			}
-			if n.SummaryEN != "" && n.SummaryAM == "" {
-				if t, err := uc.translator.Translate(n.SummaryEN, "en", "am"); err == nil {
-					n.SummaryAM = t
-				}
+			if d.hasTopic(slug) {
+				continue
 			}
-			if n.SummaryAM != "" && n.SummaryEN == "" {
-				if t, err := uc.translator.Translate(n.SummaryAM, "am", "en"); err == nil {
-					n.SummaryEN = t
-				}
+			if t, ok := uc.planTopic(ctx, slug, false); ok {
+				d.topics = append(d.topics, t)
 			}
 		}
-		// Fallback mirroring if translation missing
-		if n.SummaryEN == "" && n.SummaryAM != "" {
-			n.SummaryEN = n.SummaryAM
-		}
-		if n.SummaryAM == "" && n.SummaryEN != "" {
-			n.SummaryAM = n.SummaryEN
-		}
-		if n.TitleEN == "" && n.TitleAM != "" {
-			n.TitleEN = n.TitleAM
-		}
-		if n.TitleAM == "" && n.TitleEN != "" {
-			n.TitleAM = n.TitleEN
+	}
+
+	eth := localization.ToEthiopian(published)
+	// Resolve the source, planning a skeleton when the site is new
+	sourceID := it.SourceID
+	if sourceID == "" {
+		d.source = uc.planSource(ctx, it, lang)
+		if d.source != nil {
+			sourceID = d.source.ID
 		}
-		if n.BodyEN == "" && n.BodyAM != "" {
-			n.BodyEN = n.BodyAM
+	}
+
+	// Lead image and gallery, measured through the image proxy
+	var images []entity.NewsImage
+	if uc.media != nil {
+		images = uc.media.PrepareImages(ctx, imageRefs(it, extracted))
+	}
+	n := &entity.News{
+		ID:                     uc.uuidGen.NewUUID(),
+		Title:                  cleanTitle,
+		Body:                   body,
+		SourceURL:              it.SourceURL,
+		Language:               lang,
+		SourceID:               sourceID,
+		Topics:                 d.topicIDs(),
+		PublishedAt:            published,
+		PublishedDateLocalized: eth.FormatYYYYMMDD(),
+		SummaryPromptVersion:   promptVersion,
+		Images:                 images,
+		CreatedAt:              time.Now(),
+		UpdatedAt:              time.Now(),
+	}
+	// Store originals in their language-specific fields
+	if lang == "en" {
+		n.TitleEN, n.BodyEN, n.SummaryEN = cleanTitle, body, summary
+	} else if lang == "am" {
+		n.TitleAM, n.BodyAM, n.SummaryAM = cleanTitle, body, summary
+	} else {
+		n.TitleEN, n.BodyEN, n.SummaryEN = cleanTitle, body, summary
+	}
+	d.news = n
+	return d, ""
+}
+
+// planTopic resolves a whitelisted topic by slug, or plans its creation.
+// Model-picked topics get an Amharic label from the translator; fallback
+// topics reuse the English one.
+func (uc *providerIngestion) planTopic(ctx context.Context, slug string, translateLabel bool) (entity.PlannedTopic, bool) {
+	if existing, err := uc.topics.GetTopicBySlug(ctx, slug); err == nil && existing != nil && existing.ID != "" {
+		return entity.PlannedTopic{ID: existing.ID, Slug: slug, Label: existing.Label}, true
+	}
+	labelEN := allowedTopics[slug]
+	if labelEN == "" {
+		return entity.PlannedTopic{}, false
+	}
+	labelAM := labelEN
+	if translateLabel {
+		am, err := uc.translator.Translate(ctx, labelEN, "en", "am")
+		if err != nil {
+			return entity.PlannedTopic{}, false
 		}
-		if n.BodyAM == "" && n.BodyEN != "" {
-			n.BodyAM = n.BodyEN
+		labelAM = am
+	}
+	return entity.PlannedTopic{ID: uc.uuidGen.NewUUID(), Slug: slug, Label: entity.BilingualField{EN: labelEN, AM: labelAM}, Create: true}, true
+}
+
+// planSource resolves the item's site to a source, or plans a skeleton
+// source for a site seen for the first time.
+func (uc *providerIngestion) planSource(ctx context.Context, it contract.ProviderItem, lang string) *entity.PlannedSource {
+	if it.SourceSite == "" || uc.sourceRepo == nil {
+		return nil
+	}
+	slug := slugify(it.SourceSite)
+	if existing, err := uc.sourceRepo.GetBySlug(ctx, slug); err == nil && existing != nil && existing.ID != "" {
+		return &entity.PlannedSource{ID: existing.ID, Slug: slug, Name: existing.Name, URL: existing.URL, Languages: existing.Languages}
+	}
+	return &entity.PlannedSource{ID: uc.uuidGen.NewUUID(), Slug: slug, Name: it.SourceSite, URL: it.SourceURL, Languages: entity.SetLanguageType(lang), Create: true}
+}
+
+func (d *draft) hasTopic(slug string) bool {
+	for _, t := range d.topics {
+		if t.Slug == slug {
+			return true
 		}
+	}
+	return false
+}
 
-		// (Ethiopian localized date already set earlier)
+func (d *draft) topicIDs() []string {
+	ids := make([]string, 0, len(d.topics))
+	for _, t := range d.topics {
+		ids = append(ids, t.ID)
+	}
+	return ids
+}
 
-		if err := uc.newsRepo.Save(n); err != nil {
-			skipped++
-			continue
+// store creates the catalog entries a draft needs, then saves and enriches
+// the article. It returns skipped=true when the save failed; both results
+// are empty when ctx ended first.
+func (uc *providerIngestion) store(ctx context.Context, d *draft) (id string, skipped bool) {
+	// an item cut off mid-way is not saved; the next run picks it up again
+	if ctx.Err() != nil {
+		return "", false
+	}
+	uc.createCatalog(ctx, d)
+	n := d.news
+	// Counterpart-language fields are filled by the background translation worker
+	if uc.translations != nil {
+		uc.translations.Enqueue(n)
+	}
+	if err := uc.newsRepo.Save(n); err != nil {
+		return "", true
+	}
+	// The article is stored; finish enriching it even if the run's deadline
+	// passes meanwhile.
+	enrichCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), enrichTimeout)
+	defer cancel()
+	// Embedding is best-effort; missing vectors are filled by the backfill job
+	if uc.embeddings != nil {
+		_ = uc.embeddings.IndexNews(enrichCtx, n)
+	}
+	// Cluster into a multi-source story (uses the embedding when available).
+	// One article at a time, so two copies of an event arriving together
+	// join the same story.
+	if uc.stories != nil {
+		uc.storyMu.Lock()
+		_, _ = uc.stories.AssignNews(enrichCtx, n)
+		uc.storyMu.Unlock()
+	}
+	// Link people, organizations and places mentioned in the article
+	if uc.entities != nil {
+		_, _ = uc.entities.ExtractForNews(enrichCtx, n)
+	}
+	return n.ID, false
+}
+
+// createCatalog creates the planned topics and source of a draft and points
+// the article at the stored IDs. Workers share the catalog, so each planned
+// entry is looked up again under one lock: another item may have created it
+// since it was planned. Topics that cannot be created are dropped, as is a
+// source that cannot be created.
+func (uc *providerIngestion) createCatalog(ctx context.Context, d *draft) {
+	uc.catalogMu.Lock()
+	defer uc.catalogMu.Unlock()
+	kept := d.topics[:0]
+	for _, t := range d.topics {
+		if t.Create {
+			if existing, err := uc.topics.GetTopicBySlug(ctx, t.Slug); err == nil && existing != nil && existing.ID != "" {
+				t.ID = existing.ID
+			} else if err := uc.topics.CreateTopic(ctx, &entity.Topic{ID: t.ID, Slug: t.Slug, Label: t.Label}); err != nil {
+				continue
+			}
+			t.Create = false
+		}
+		kept = append(kept, t)
+	}
+	d.topics = kept
+	d.news.Topics = d.topicIDs()
+
+	s := d.source
+	if s == nil || !s.Create {
+		return
+	}
+	if existing, err := uc.sourceRepo.GetBySlug(ctx, s.Slug); err == nil && existing != nil && existing.ID != "" {
+		s.ID = existing.ID
+	} else {
+		src := &entity.Source{ID: s.ID, Slug: s.Slug, Name: s.Name, URL: s.URL, Languages: s.Languages}
+		if err := uc.sourceRepo.CreateSource(ctx, src); err != nil {
+			d.news.SourceID = ""
+			return
 		}
-		ids = append(ids, n.ID)
 	}
-	return ids, skipped, nil
+	s.Create = false
+	d.news.SourceID = s.ID
 }
 
 func slugify(s string) string {
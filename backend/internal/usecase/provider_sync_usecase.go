@@ -0,0 +1,252 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+const (
+	// providerSyncName keys the sync state of the external news provider
+	providerSyncName = "news_provider"
+	providerPageSize = 50
+	// maxSyncPages is a safety stop; a sync that hits it records the gap it left
+	maxSyncPages     = 40
+	maxBackfillPages = 400
+	maxBackfillRange = 90 * 24 * time.Hour
+	backfillTimeout  = 2 * time.Hour
+)
+
+type providerSync struct {
+	provider  contract.INewsProviderClient
+	states    contract.IProviderSyncRepository
+	ingestion contract.IProviderIngestionUsecase
+	logger    contract.IAppLogger
+	// query is passed to the provider on every page
+	query string
+}
+
+// NewProviderSyncUsecase pulls provider items incrementally: each sync pages
+// back from the newest item to the persisted cursor.
+func NewProviderSyncUsecase(provider contract.INewsProviderClient, states contract.IProviderSyncRepository, ingestion contract.IProviderIngestionUsecase, logger contract.IAppLogger, query string) contract.IProviderSyncUsecase {
+	return &providerSync{provider: provider, states: states, ingestion: ingestion, logger: logger, query: query}
+}
+
+func (uc *providerSync) State(ctx context.Context) (*entity.ProviderSyncState, error) {
+	state, err := uc.states.Get(ctx, providerSyncName)
+	if errors.Is(err, contract.ErrNotFound) {
+		return &entity.ProviderSyncState{Provider: providerSyncName}, nil
+	}
+	return state, err
+}
+
+func (uc *providerSync) Sync(ctx context.Context) (contract.ProviderSyncResult, error) {
+	var res contract.ProviderSyncResult
+	state, err := uc.State(ctx)
+	if err != nil {
+		return res, err
+	}
+	cursor, cursorID := state.CursorPublishedAt, state.CursorID
+	// the first sync only takes the newest page instead of the whole archive
+	first := cursor.IsZero()
+	newest, newestID := cursor, cursorID
+	var oldest time.Time
+	seen := map[string]bool{}
+	failed := 0
+	var runErr error
+	for page := 0; page < maxSyncPages; page++ {
+		items, err := uc.provider.FetchPage(ctx, contract.ProviderQuery{Query: uc.query, Limit: providerPageSize, Offset: page * providerPageSize, Since: cursor})
+		if err != nil {
+			runErr = err
+			break
+		}
+		res.Pages++
+		fresh := make([]contract.ProviderItem, 0, len(items))
+		reached, repeated := false, 0
+		for _, it := range items {
+			key := firstNonEmpty(it.ID, it.SourceURL, it.Title)
+			if seen[key] {
+				repeated++
+				continue
+			}
+			seen[key] = true
+			published := parseProviderDate(it.PublishedDate)
+			if !published.IsZero() {
+				if !cursor.IsZero() && (published.Before(cursor) || published.Equal(cursor) && it.ID == cursorID) {
+					reached = true
+					continue
+				}
+				if published.After(newest) {
+					newest, newestID = published, it.ID
+				}
+				if oldest.IsZero() || published.Before(oldest) {
+					oldest = published
+				}
+			}
+			fresh = append(fresh, it)
+		}
+		if len(items) > 0 && repeated == len(items) {
+			runErr = errRepeatedPage(page)
+			break
+		}
+		res.Fetched += len(fresh)
+		report, err := uc.ingestion.IngestBatch(ctx, fresh)
+		res.Ingested = append(res.Ingested, report.IDs...)
+		res.Skipped += report.SkippedTotal()
+		// stored items above the cursor were saved by a sync that failed
+		// further down, so paging carries on to the cursor regardless
+		failed += report.SkippedTotal() - report.Skipped[skipStored] - report.Skipped[skipRepeated]
+		if err != nil {
+			runErr = err
+			break
+		}
+		if first || reached || len(items) < providerPageSize {
+			res.CaughtUp = true
+			break
+		}
+	}
+
+	state.LastSyncAt = time.Now().UTC()
+	state.LastPages, state.LastFetched, state.LastIngested, state.LastSkipped = res.Pages, res.Fetched, len(res.Ingested), res.Skipped
+	state.TotalIngested += len(res.Ingested)
+	state.CaughtUp = res.CaughtUp
+	switch {
+	case runErr != nil:
+		// keep the cursor; the next sync refetches and skips what was saved
+		state.LastStatus, state.LastError = "error", runErr.Error()
+	case failed > 0:
+		// keep the cursor so the failed items are fetched again
+		state.LastStatus = "partial"
+		state.LastError = fmt.Sprintf("%d items failed to ingest; the next sync retries them", failed)
+		uc.logger.Errorf("provider sync: %s", state.LastError)
+	case !res.CaughtUp:
+		state.LastStatus = "partial"
+		state.LastError = fmt.Sprintf("stopped after %d pages; backfill %s to %s to close the gap", res.Pages, cursor.Format(time.RFC3339), oldest.Format(time.RFC3339))
+		uc.logger.Errorf("provider sync: %s", state.LastError)
+		state.CursorPublishedAt, state.CursorID = newest, newestID
+	default:
+		state.LastStatus, state.LastError = "ok", ""
+		state.CursorPublishedAt, state.CursorID = newest, newestID
+	}
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := uc.states.SaveSync(saveCtx, state); err != nil {
+		uc.logger.Errorf("provider sync: save state: %v", err)
+	}
+	return res, runErr
+}
+
+func (uc *providerSync) StartBackfill(ctx context.Context, from, to time.Time, by string) (*entity.ProviderBackfill, error) {
+	from, to = from.UTC(), to.UTC()
+	if !from.Before(to) {
+		return nil, fmt.Errorf("%w: from must be before to", contract.ErrInvalidInput)
+	}
+	if to.Sub(from) > maxBackfillRange {
+		return nil, fmt.Errorf("%w: backfill at most %d days at a time", contract.ErrInvalidInput, int(maxBackfillRange.Hours()/24))
+	}
+	now := time.Now().UTC()
+	b := &entity.ProviderBackfill{From: from, To: to, Status: entity.BackfillRunning, RequestedBy: by, StartedAt: now, Deadline: now.Add(backfillTimeout + time.Minute)}
+	ok, err := uc.states.StartBackfill(ctx, providerSyncName, b)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: a backfill is already running", contract.ErrConflict)
+	}
+	runCtx := contract.WithUsageActor(context.WithoutCancel(ctx), contract.UsageActor{Job: "provider_backfill"})
+	go uc.runBackfill(runCtx, b)
+	return b, nil
+}
+
+// runBackfill pages through [From, To) newest first, ingesting page by page
+// and saving progress after each one.
+func (uc *providerSync) runBackfill(ctx context.Context, b *entity.ProviderBackfill) {
+	ctx, cancel := context.WithTimeout(ctx, backfillTimeout)
+	defer cancel()
+	seen := map[string]bool{}
+	var runErr error
+	for page := 0; page < maxBackfillPages; page++ {
+		items, err := uc.provider.FetchPage(ctx, contract.ProviderQuery{Query: uc.query, Limit: providerPageSize, Offset: page * providerPageSize, Since: b.From, Until: b.To})
+		if err != nil {
+			runErr = err
+			break
+		}
+		b.Pages++
+		fresh := make([]contract.ProviderItem, 0, len(items))
+		pastRange, repeated := 0, 0
+		for _, it := range items {
+			key := firstNonEmpty(it.ID, it.SourceURL, it.Title)
+			if seen[key] {
+				repeated++
+				continue
+			}
+			seen[key] = true
+			// undated items cannot be placed in the range
+			published := parseProviderDate(it.PublishedDate)
+			if published.IsZero() || !published.Before(b.To) {
+				continue
+			}
+			if published.Before(b.From) {
+				pastRange++
+				continue
+			}
+			fresh = append(fresh, it)
+		}
+		if len(items) > 0 && repeated == len(items) {
+			runErr = errRepeatedPage(page)
+			break
+		}
+		b.Fetched += len(fresh)
+		ids, skipped, err := uc.ingestion.IngestItems(ctx, fresh)
+		b.Ingested += len(ids)
+		b.Skipped += skipped
+		uc.saveBackfill(ctx, b)
+		if err != nil {
+			runErr = err
+			break
+		}
+		if len(items) < providerPageSize || pastRange > 0 {
+			break
+		}
+	}
+	finished := time.Now().UTC()
+	b.FinishedAt = &finished
+	b.Status = entity.BackfillDone
+	if runErr != nil {
+		b.Status, b.Error = entity.BackfillFailed, runErr.Error()
+		uc.logger.Errorf("provider backfill %s..%s: %v", b.From.Format(time.RFC3339), b.To.Format(time.RFC3339), runErr)
+	} else {
+		uc.logger.Infof("provider backfill %s..%s done: pages=%d ingested=%d skipped=%d", b.From.Format(time.RFC3339), b.To.Format(time.RFC3339), b.Pages, b.Ingested, b.Skipped)
+	}
+	uc.saveBackfill(ctx, b)
+}
+
+func (uc *providerSync) saveBackfill(ctx context.Context, b *entity.ProviderBackfill) {
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := uc.states.SaveBackfill(saveCtx, providerSyncName, b); err != nil {
+		uc.logger.Errorf("provider backfill: save progress: %v", err)
+	}
+}
+
+// errRepeatedPage stops a run whose page only repeats items already seen: the
+// provider ignored the offset, so paging on would never reach older items.
+func errRepeatedPage(page int) error {
+	return fmt.Errorf("provider page %d only repeats earlier items; it does not page by offset", page+1)
+}
+
+var providerDateLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02"}
+
+// parseProviderDate returns the zero time for missing or unknown formats.
+func parseProviderDate(s string) time.Time {
+	for _, layout := range providerDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC()
+		}
+	}
+	return time.Time{}
+}
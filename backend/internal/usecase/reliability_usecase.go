@@ -0,0 +1,360 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+const (
+	// reliabilityWindow is the period of articles and reports scored; feed
+	// health counts every poll since the feed was added
+	reliabilityWindow = 30 * 24 * time.Hour
+	// maxScoredArticles bounds the articles read per source
+	maxScoredArticles = 500
+	// reliabilityPrior is the score of a source nothing is known about yet
+	reliabilityPrior = 60.0
+	// reliabilityPriorWeight is how many articles' worth of evidence the prior
+	// counts for; a source with this many articles sits halfway between
+	reliabilityPriorWeight = 10.0
+	// reportPenalty scales the share of reported articles: 20% reported is 0
+	reportPenalty = 5.0
+	// clickbaitPenalty scales the share of clickbait headlines: 50% is 0
+	clickbaitPenalty = 2.0
+	maxReportNote    = 500
+)
+
+// Component names and weights of the reliability score. Weights of the
+// components available for a source are renormalized to sum to 1.
+var reliabilityWeights = []struct {
+	name   string
+	weight float64
+}{
+	{"ingestion_health", 0.20},
+	{"body_completeness", 0.20},
+	{"originality", 0.15},
+	{"headline_quality", 0.15},
+	{"reader_reports", 0.30},
+}
+
+var (
+	clickbaitRe = regexp.MustCompile(`(?i)you won'?t believe|what happened next|will (blow your mind|shock you|surprise you)|shocking|jaw[- ]dropping|mind[- ]blowing|must see|goes viral|this is why|here'?s why|the reason why|nobody (knows|expected)|can'?t stop|unbelievable|secret (that|they)|^\d+ (things|reasons|ways|facts|photos|signs)\b|አስደንጋጭ|ያልተጠበቀ|ጉድ|ይመልከቱ|ተጋለጠ|ሚስጥሩ|አነጋጋሪ`)
+	// titleNoiseRe strips punctuation before comparing headlines
+	titleNoiseRe = regexp.MustCompile(`[^\p{L}\p{N}]+`)
+)
+
+type reliabilityUsecase struct {
+	sources contract.ISourceRepository
+	news    contract.INewsRepository
+	feeds   contract.IFeedStateRepository
+	reports contract.INewsReportRepository
+	uuidGen contract.IUUIDGenerator
+	logger  contract.IAppLogger
+}
+
+// NewReliabilityUsecase scores sources and records reader reports.
+func NewReliabilityUsecase(sources contract.ISourceRepository, news contract.INewsRepository, feeds contract.IFeedStateRepository, reports contract.INewsReportRepository, uuidGen contract.IUUIDGenerator, logger contract.IAppLogger) contract.IReliabilityUsecase {
+	return &reliabilityUsecase{sources: sources, news: news, feeds: feeds, reports: reports, uuidGen: uuidGen, logger: logger}
+}
+
+func (uc *reliabilityUsecase) List(ctx context.Context) ([]entity.Source, error) {
+	sources, err := uc.sources.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(sources, func(i, j int) bool { return sources[i].ReliabilityScore < sources[j].ReliabilityScore })
+	return sources, nil
+}
+
+func (uc *reliabilityUsecase) RecomputeAll(ctx context.Context) (contract.ReliabilityRunResult, error) {
+	var res contract.ReliabilityRunResult
+	sources, err := uc.sources.GetAll(ctx)
+	if err != nil {
+		return res, err
+	}
+	states, reports, err := uc.signals(ctx)
+	if err != nil {
+		return res, err
+	}
+	for i := range sources {
+		if ctx.Err() != nil {
+			return res, ctx.Err()
+		}
+		res.Sources++
+		if err := uc.score(ctx, &sources[i], states, reports); err != nil {
+			res.Failed++
+			uc.logger.Errorf("reliability %s: %v", sources[i].Slug, err)
+			continue
+		}
+		res.Scored++
+		if sources[i].ReliabilityOverride != nil {
+			res.Overridden++
+		}
+	}
+	return res, nil
+}
+
+func (uc *reliabilityUsecase) Recompute(ctx context.Context, slug string) (*entity.Source, error) {
+	src, err := uc.sources.GetBySlug(ctx, slug)
+	if err != nil || src == nil {
+		return nil, contract.ErrNotFound
+	}
+	states, reports, err := uc.signals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.score(ctx, src, states, reports); err != nil {
+		return nil, err
+	}
+	return src, nil
+}
+
+func (uc *reliabilityUsecase) SetOverride(ctx context.Context, slug string, score *float64, note, userID string) (*entity.Source, error) {
+	src, err := uc.sources.GetBySlug(ctx, slug)
+	if err != nil || src == nil {
+		return nil, contract.ErrNotFound
+	}
+	var override *entity.ReliabilityOverride
+	if score != nil {
+		if *score < 0 || *score > 100 || math.IsNaN(*score) {
+			return nil, fmt.Errorf("%w: score must be between 0 and 100", contract.ErrInvalidInput)
+		}
+		override = &entity.ReliabilityOverride{Score: *score, Note: strings.TrimSpace(note), SetBy: userID, SetAt: time.Now().UTC()}
+	}
+	if err := uc.sources.SetReliabilityOverride(ctx, slug, override); err != nil {
+		return nil, err
+	}
+	src.ReliabilityOverride = override
+	// the effective score changes now rather than at the next scoring run
+	if src.Reliability == nil {
+		return uc.Recompute(ctx, slug)
+	}
+	src.Reliability.Score = effectiveScore(src.Reliability.ComputedScore, override)
+	src.ReliabilityScore = src.Reliability.Score
+	if err := uc.sources.UpdateReliability(ctx, slug, src.ReliabilityScore, src.Reliability); err != nil {
+		return nil, err
+	}
+	return src, nil
+}
+
+func (uc *reliabilityUsecase) Report(ctx context.Context, userID, newsID string, reason entity.ReportReason, note string) (*entity.NewsReport, error) {
+	if !entity.ValidReportReason(reason) {
+		return nil, fmt.Errorf("%w: unknown report reason %q", contract.ErrInvalidInput, reason)
+	}
+	note = strings.TrimSpace(note)
+	if len([]rune(note)) > maxReportNote {
+		return nil, fmt.Errorf("%w: note must be at most %d characters", contract.ErrInvalidInput, maxReportNote)
+	}
+	n, err := uc.news.FindByID(newsID)
+	if err != nil || n == nil {
+		return nil, contract.ErrNotFound
+	}
+	report := &entity.NewsReport{
+		ID:        uc.uuidGen.NewUUID(),
+		NewsID:    n.ID,
+		SourceID:  n.SourceID,
+		UserID:    userID,
+		Reason:    reason,
+		Note:      note,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := uc.reports.Create(ctx, report); err != nil {
+		if errors.Is(err, contract.ErrConflict) {
+			return nil, fmt.Errorf("%w: article already reported", contract.ErrConflict)
+		}
+		return nil, err
+	}
+	return report, nil
+}
+
+// signals loads the feed states and report counts shared by every source.
+func (uc *reliabilityUsecase) signals(ctx context.Context) (map[string][]*entity.FeedState, map[string]contract.ReportCount, error) {
+	list, err := uc.feeds.List(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	states := map[string][]*entity.FeedState{}
+	for _, s := range list {
+		states[s.SourceID] = append(states[s.SourceID], s)
+	}
+	reports, err := uc.reports.CountBySource(ctx, time.Now().Add(-reliabilityWindow))
+	if err != nil {
+		return nil, nil, err
+	}
+	return states, reports, nil
+}
+
+// score computes and stores the breakdown of one source.
+func (uc *reliabilityUsecase) score(ctx context.Context, src *entity.Source, states map[string][]*entity.FeedState, reports map[string]contract.ReportCount) error {
+	articles, err := uc.news.FindBySourceSince(ctx, src.ID, time.Now().Add(-reliabilityWindow), maxScoredArticles)
+	if err != nil {
+		return err
+	}
+	if src.Reliability == nil && src.ReliabilityOverride == nil && src.ReliabilityScore > 0 {
+		// a score set by hand before scoring existed is kept as an override
+		override := &entity.ReliabilityOverride{Score: src.ReliabilityScore, Note: "set by hand before automatic scoring", SetAt: time.Now().UTC()}
+		if err := uc.sources.SetReliabilityOverride(ctx, src.Slug, override); err != nil {
+			return err
+		}
+		src.ReliabilityOverride = override
+	}
+	b := scoreReliability(articles, states[src.ID], reports[src.ID], time.Now().UTC())
+	b.Score = effectiveScore(b.ComputedScore, src.ReliabilityOverride)
+	if err := uc.sources.UpdateReliability(ctx, src.Slug, b.Score, b); err != nil {
+		return err
+	}
+	src.Reliability, src.ReliabilityScore = b, b.Score
+	return nil
+}
+
+func effectiveScore(computed float64, override *entity.ReliabilityOverride) float64 {
+	if override != nil {
+		return override.Score
+	}
+	return computed
+}
+
+// scoreReliability combines the signals of one source into a 0-100 score.
+// With few articles the score is pulled towards reliabilityPrior, so a new
+// source is neither buried nor promoted by its first handful of items.
+func scoreReliability(articles []*entity.News, feeds []*entity.FeedState, reports contract.ReportCount, now time.Time) *entity.ReliabilityBreakdown {
+	n := len(articles)
+	values := map[string]entity.ReliabilityComponent{}
+
+	polls, failures := 0, 0
+	for _, f := range feeds {
+		polls += f.TotalPolls
+		failures += f.TotalFailures
+	}
+	if polls > 0 {
+		values["ingestion_health"] = entity.ReliabilityComponent{
+			Score:  100 * (1 - float64(failures)/float64(polls)),
+			Detail: fmt.Sprintf("%d of %d feed polls failed", failures, polls),
+		}
+	}
+
+	if n > 0 {
+		empty, dup, bait := 0, 0, 0
+		seen := map[string]bool{}
+		for _, a := range articles {
+			if body := strings.TrimSpace(a.Body); textWeight(body) < minBodyWeight || strings.EqualFold(body, strings.TrimSpace(a.Title)) {
+				empty++
+			}
+			key := normalizeTitle(a.Title)
+			if key != "" && seen[key] {
+				dup++
+			}
+			seen[key] = true
+			if isClickbait(a.Title) {
+				bait++
+			}
+		}
+		values["body_completeness"] = entity.ReliabilityComponent{
+			Score:  100 * (1 - share(empty, n)),
+			Detail: fmt.Sprintf("%d of %d articles had an empty or teaser body", empty, n),
+		}
+		values["originality"] = entity.ReliabilityComponent{
+			Score:  100 * (1 - share(dup, n)),
+			Detail: fmt.Sprintf("%d of %d articles repeated an earlier headline", dup, n),
+		}
+		values["headline_quality"] = entity.ReliabilityComponent{
+			Score:  100 * (1 - math.Min(1, clickbaitPenalty*share(bait, n))),
+			Detail: fmt.Sprintf("%d of %d headlines looked like clickbait", bait, n),
+		}
+		reported := reports.Articles
+		if reported > n {
+			reported = n
+		}
+		values["reader_reports"] = entity.ReliabilityComponent{
+			Score:  100 * (1 - math.Min(1, reportPenalty*share(reported, n))),
+			Detail: fmt.Sprintf("%d reports against %d of %d articles", reports.Reports, reports.Articles, n),
+		}
+	}
+
+	b := &entity.ReliabilityBreakdown{
+		WindowDays: int(reliabilityWindow.Hours() / 24),
+		Articles:   n,
+		ComputedAt: now,
+	}
+	total, weight := 0.0, 0.0
+	for _, w := range reliabilityWeights {
+		c, ok := values[w.name]
+		c.Name = w.name
+		c.Available = ok
+		if ok {
+			c.Score = round1(c.Score)
+			c.Weight = w.weight
+			total += c.Score * w.weight
+			weight += w.weight
+		} else if w.name == "ingestion_health" {
+			c.Detail = "no polled feeds"
+		} else {
+			c.Detail = "no articles in the window"
+		}
+		b.Components = append(b.Components, c)
+	}
+	raw := reliabilityPrior
+	if weight > 0 {
+		raw = total / weight
+	}
+	b.Confidence = float64(n) / (float64(n) + reliabilityPriorWeight)
+	if n == 0 && polls > 0 {
+		// feed polls alone say little about content: at most half the weight
+		b.Confidence = 0.5 * float64(polls) / (float64(polls) + 2*reliabilityPriorWeight)
+	}
+	b.ComputedScore = round1(reliabilityPrior + (raw-reliabilityPrior)*b.Confidence)
+	b.Confidence = math.Round(b.Confidence*100) / 100
+	for i := range b.Components {
+		if b.Components[i].Available {
+			b.Components[i].Weight = math.Round(b.Components[i].Weight/weight*1000) / 1000
+		}
+	}
+	return b
+}
+
+func share(part, whole int) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return float64(part) / float64(whole)
+}
+
+func round1(v float64) float64 {
+	return math.Round(v*10) / 10
+}
+
+// normalizeTitle lowercases a headline and drops punctuation and spacing.
+func normalizeTitle(title string) string {
+	return strings.TrimSpace(titleNoiseRe.ReplaceAllString(strings.ToLower(title), " "))
+}
+
+// isClickbait flags sensational headlines: bait phrases in English or
+// Amharic, multiple exclamation marks, or shouting in capitals.
+func isClickbait(title string) bool {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return false
+	}
+	if clickbaitRe.MatchString(title) || strings.Contains(title, "!!") || strings.Contains(title, "?!") {
+		return true
+	}
+	upper, letters := 0, 0
+	for _, r := range title {
+		if r < unicode.MaxASCII && unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+	return letters >= 12 && float64(upper) > 0.6*float64(letters)
+}
@@ -0,0 +1,616 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/localization"
+)
+
+const (
+	defaultReprocessRate = 30
+	maxReprocessRate     = 600
+	reprocessBatch       = 20
+	// reprocessLease outlives one article plus the wait at the lowest rate
+	reprocessLease       = 5 * time.Minute
+	reprocessItemTimeout = 2 * time.Minute
+	// reprocessSaveEvery bounds how stale the reported progress is and how
+	// long a pause or cancel request waits
+	reprocessSaveEvery   = 5 * time.Second
+	maxReprocessFailures = 20
+)
+
+type reprocessUsecase struct {
+	jobs         contract.IReprocessJobRepository
+	news         contract.INewsRepository
+	summaries    contract.ISummaryRepository
+	topics       contract.ITopicRepository
+	sources      contract.ISourceRepository
+	gemini       contract.IGeminiClient
+	prompts      contract.IPromptRegistry
+	translations contract.ITranslationPipeline
+	embeddings   contract.IEmbeddingService
+	uuidGen      contract.IUUIDGenerator
+	logger       contract.IAppLogger
+	// owner names this process in job leases
+	owner string
+}
+
+// NewReprocessUsecase re-runs summarize, classify, translate, date
+// localization and embedding over stored articles with the production
+// prompts and topic mapper.
+func NewReprocessUsecase(jobs contract.IReprocessJobRepository, news contract.INewsRepository, summaries contract.ISummaryRepository, topics contract.ITopicRepository, sources contract.ISourceRepository, gemini contract.IGeminiClient, prompts contract.IPromptRegistry, translations contract.ITranslationPipeline, embeddings contract.IEmbeddingService, uuidGen contract.IUUIDGenerator, logger contract.IAppLogger, owner string) contract.IReprocessUsecase {
+	return &reprocessUsecase{jobs: jobs, news: news, summaries: summaries, topics: topics, sources: sources, gemini: gemini, prompts: prompts, translations: translations, embeddings: embeddings, uuidGen: uuidGen, logger: logger, owner: owner}
+}
+
+func (uc *reprocessUsecase) Create(ctx context.Context, req contract.ReprocessRequest, by string) (*entity.ReprocessJob, error) {
+	stages, err := reprocessStages(req.Stages)
+	if err != nil {
+		return nil, err
+	}
+	f := req.Filter
+	if f.From != nil {
+		from := f.From.UTC()
+		f.From = &from
+	}
+	if f.To != nil {
+		to := f.To.UTC()
+		f.To = &to
+	}
+	switch {
+	case f.From != nil && f.To != nil && !f.From.Before(*f.To):
+		return nil, fmt.Errorf("%w: from must be before to", contract.ErrInvalidInput)
+	case f.Missing != "" && !entity.ValidMissingField(f.Missing):
+		return nil, fmt.Errorf("%w: missing must be one of summary, topics, translation, localized_date", contract.ErrInvalidInput)
+	case f.Language != "" && f.Language != "en" && f.Language != "am":
+		return nil, fmt.Errorf("%w: language must be en or am", contract.ErrInvalidInput)
+	case f.Topic != "" && f.Missing == entity.MissingTopics:
+		return nil, fmt.Errorf("%w: a topic filter cannot select articles missing topics", contract.ErrInvalidInput)
+	}
+	f.SourceID, f.TopicID = "", ""
+	if f.Source != "" {
+		src, err := uc.sources.GetBySlug(ctx, f.Source)
+		if err != nil || src == nil {
+			return nil, fmt.Errorf("%w: unknown source %q", contract.ErrInvalidInput, f.Source)
+		}
+		f.SourceID = src.ID
+	}
+	if f.Topic != "" {
+		t, err := uc.topics.GetTopicBySlug(ctx, f.Topic)
+		if err != nil || t == nil {
+			return nil, fmt.Errorf("%w: unknown topic %q", contract.ErrInvalidInput, f.Topic)
+		}
+		f.TopicID = t.ID
+	}
+	rate := req.RatePerMin
+	if rate == 0 {
+		rate = defaultReprocessRate
+	}
+	if rate < 0 || rate > maxReprocessRate {
+		return nil, fmt.Errorf("%w: rate_per_min must be between 1 and %d", contract.ErrInvalidInput, maxReprocessRate)
+	}
+	total, err := uc.news.CountForReprocess(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	j := &entity.ReprocessJob{
+		ID:          uc.uuidGen.NewUUID(),
+		Filter:      f,
+		Stages:      stages,
+		RatePerMin:  rate,
+		Status:      entity.ReprocessRunning,
+		RequestedBy: by,
+		Total:       int(total),
+		StageCounts: map[string]int{},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := uc.jobs.Create(ctx, j); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// reprocessStages validates the requested stages and puts them in run order.
+func reprocessStages(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return nil, fmt.Errorf("%w: select at least one stage", contract.ErrInvalidInput)
+	}
+	want := map[string]bool{}
+	for _, s := range requested {
+		if !entity.ValidReprocessStage(s) {
+			return nil, fmt.Errorf("%w: unknown stage %q", contract.ErrInvalidInput, s)
+		}
+		want[s] = true
+	}
+	stages := make([]string, 0, len(want))
+	for _, s := range entity.ReprocessStages {
+		if want[s] {
+			stages = append(stages, s)
+		}
+	}
+	return stages, nil
+}
+
+func (uc *reprocessUsecase) Start(ctx context.Context, req contract.ReprocessRequest, by string) (*entity.ReprocessJob, error) {
+	j, err := uc.Create(ctx, req, by)
+	if err != nil {
+		return nil, err
+	}
+	uc.runInBackground(ctx, j.ID)
+	return j, nil
+}
+
+func (uc *reprocessUsecase) runInBackground(ctx context.Context, id string) {
+	runCtx := contract.WithUsageActor(context.WithoutCancel(ctx), contract.UsageActor{Job: "news_reprocess"})
+	go func() {
+		// a conflict means another runner already has the job
+		if _, err := uc.Run(runCtx, id); err != nil && !errors.Is(err, contract.ErrConflict) {
+			uc.logger.Errorf("reprocess %s: %v", id, err)
+		}
+	}()
+}
+
+func (uc *reprocessUsecase) Run(ctx context.Context, id string) (*entity.ReprocessJob, error) {
+	j, err := uc.jobs.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if j.Status == entity.ReprocessPaused || j.Status == entity.ReprocessFailed {
+		if _, err := uc.jobs.SetStatus(ctx, id, entity.ReprocessRunning, []string{entity.ReprocessPaused, entity.ReprocessFailed}); err != nil {
+			return nil, err
+		}
+	}
+	owner := uc.owner + "-" + uc.uuidGen.NewUUID()[:8]
+	claimed, err := uc.jobs.Claim(ctx, id, owner, time.Now().UTC().Add(reprocessLease))
+	if err != nil {
+		return nil, err
+	}
+	// the claimed document holds the latest cursor and status
+	if j, err = uc.jobs.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	if !claimed {
+		if j.Status != entity.ReprocessRunning {
+			return j, fmt.Errorf("%w: the job is %s", contract.ErrConflict, j.Status)
+		}
+		return j, fmt.Errorf("%w: the job is being run elsewhere", contract.ErrConflict)
+	}
+	uc.process(ctx, j, owner)
+	return j, nil
+}
+
+// process visits the matching articles after j.Cursor at j.RatePerMin,
+// saving progress every few seconds. It stops when the store says the job
+// was paused or cancelled; an article cut off by ctx is redone on resume.
+func (uc *reprocessUsecase) process(ctx context.Context, j *entity.ReprocessJob, owner string) {
+	if j.StageCounts == nil {
+		j.StageCounts = map[string]int{}
+	}
+	interval := time.Minute / time.Duration(j.RatePerMin)
+	next, lastSave := time.Now(), time.Now()
+	var runErr error
+	lost, budget := false, false
+	// save reports whether the job should go on
+	save := func() bool {
+		saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer cancel()
+		status, err := uc.jobs.SaveProgress(saveCtx, j, owner, time.Now().UTC().Add(reprocessLease))
+		switch {
+		case errors.Is(err, contract.ErrConflict):
+			lost = true
+			return false
+		case err != nil:
+			uc.logger.Errorf("reprocess %s: save progress: %v", j.ID, err)
+		case status != entity.ReprocessRunning:
+			j.Status = status
+			return false
+		}
+		lastSave = time.Now()
+		return true
+	}
+run:
+	for {
+		batch, err := uc.news.FindForReprocess(ctx, j.Filter, j.Cursor, reprocessBatch)
+		if err != nil {
+			runErr = err
+			break
+		}
+		if len(batch) == 0 {
+			j.Status = entity.ReprocessDone
+			break
+		}
+		for _, n := range batch {
+			if !sleepUntil(ctx, next) {
+				break run
+			}
+			next = time.Now().Add(interval)
+			changed, failures, err := uc.reprocessOne(ctx, j.Stages, n.ID)
+			if ctx.Err() != nil {
+				break run
+			}
+			if errors.Is(err, contract.ErrBudgetExceeded) {
+				budget = true
+				break run
+			}
+			j.Cursor = n.ID
+			j.Processed++
+			if len(changed) > 0 {
+				j.Updated++
+			}
+			for _, stage := range changed {
+				j.StageCounts[stage]++
+			}
+			if len(failures) > 0 {
+				j.Failed++
+				j.Failures = append(j.Failures, failures...)
+				if extra := len(j.Failures) - maxReprocessFailures; extra > 0 {
+					j.Failures = j.Failures[extra:]
+				}
+			}
+			if time.Since(lastSave) >= reprocessSaveEvery && !save() {
+				break run
+			}
+		}
+		if !save() {
+			break
+		}
+	}
+	if lost {
+		uc.logger.Warnf("reprocess %s: lease lost after %d articles", j.ID, j.Processed)
+		return
+	}
+
+	now := time.Now().UTC()
+	switch {
+	case j.Status == entity.ReprocessDone:
+		j.FinishedAt = &now
+	case runErr != nil:
+		j.Status, j.Error, j.FinishedAt = entity.ReprocessFailed, runErr.Error(), &now
+	case budget:
+		j.Status, j.Error = entity.ReprocessPaused, "AI budget exhausted; resume the job later"
+	case ctx.Err() != nil:
+		j.Status, j.Error = entity.ReprocessPaused, "interrupted"
+	}
+	releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := uc.jobs.Release(releaseCtx, j, owner); err != nil {
+		uc.logger.Errorf("reprocess %s: release: %v", j.ID, err)
+	}
+	uc.logger.Infof("reprocess %s %s: processed=%d/%d updated=%d failed=%d", j.ID, j.Status, j.Processed, j.Total, j.Updated, j.Failed)
+}
+
+// reprocessOne reads the article again and runs the stages on it, saving only
+// the fields they changed, and only while the texts it rewrote are as it read
+// them. A failing stage does not stop the others. It returns the stages that
+// changed the article, and ErrBudgetExceeded when AI calls are out of
+// budget, in which case the article is left for the resumed job.
+func (uc *reprocessUsecase) reprocessOne(ctx context.Context, stages []string, id string) ([]string, []entity.ReprocessFailure, error) {
+	ctx, cancel := context.WithTimeout(ctx, reprocessItemTimeout)
+	defer cancel()
+	var failures []entity.ReprocessFailure
+	fail := func(stage string, err error) {
+		failures = append(failures, entity.ReprocessFailure{NewsID: id, Stage: stage, Error: err.Error(), At: time.Now().UTC()})
+	}
+	n, err := uc.news.FindByID(id)
+	if errors.Is(err, contract.ErrNotFound) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		fail("load", err)
+		return nil, failures, nil
+	}
+	read := map[string]string{}
+	for key := range entity.TranslatableFields {
+		read[key] = *n.FieldValue(key)
+	}
+	var changed, fields []string
+	embed := false
+	for _, stage := range stages {
+		var err error
+		var touched []string
+		switch stage {
+		case entity.StageSummarize:
+			touched, err = uc.resummarize(ctx, n)
+		case entity.StageClassify:
+			touched, err = uc.reclassify(ctx, n)
+		case entity.StageTranslate:
+			touched = uc.retranslate(ctx, n)
+		case entity.StageLocalizeDate:
+			touched = relocalizeDate(n)
+		case entity.StageEmbed:
+			// after the save, so the vector matches the stored text
+			embed = true
+			continue
+		}
+		if errors.Is(err, contract.ErrBudgetExceeded) {
+			return nil, nil, err
+		}
+		if err != nil {
+			fail(stage, err)
+			continue
+		}
+		if len(touched) > 0 {
+			changed = append(changed, stage)
+			fields = append(fields, touched...)
+		}
+	}
+	var texts []string
+	if len(changed) > 0 {
+		expect := map[string]interface{}{}
+		for _, f := range fields {
+			if prev, ok := read[f]; ok {
+				texts = append(texts, f)
+				expect[f] = prev
+			}
+		}
+		if err := uc.news.UpdateFields(ctx, n, dedupe(fields), expect); err != nil {
+			if errors.Is(err, contract.ErrConflict) {
+				err = errors.New("article changed while it was reprocessed; run the job again to retry it")
+			}
+			fail("save", err)
+			return nil, failures, nil
+		}
+	}
+	// the cached variants and the vector were made from the old summary
+	summarized := containsString(changed, entity.StageSummarize)
+	if summarized && uc.summaries != nil {
+		if err := uc.summaries.DeleteByNews(ctx, n.ID); err != nil {
+			fail(entity.StageSummarize, err)
+		}
+	}
+	// vectors are computed from the English text
+	if (embed || summarized || englishChanged(texts)) && uc.embeddings != nil {
+		if err := uc.embeddings.IndexNews(ctx, n); err != nil {
+			if errors.Is(err, contract.ErrBudgetExceeded) {
+				return changed, failures, err
+			}
+			fail(entity.StageEmbed, err)
+		} else if embed {
+			changed = append(changed, entity.StageEmbed)
+		}
+	}
+	return changed, failures, nil
+}
+
+// originalLanguage is the language the article was published in; other
+// languages are stored in the English fields, as at ingestion.
+func originalLanguage(n *entity.News) string {
+	if n.Language == "am" {
+		return "am"
+	}
+	return "en"
+}
+
+func originalBody(n *entity.News) string {
+	if originalLanguage(n) == "am" {
+		return firstNonEmpty(n.BodyAM, n.Body, n.Title)
+	}
+	return firstNonEmpty(n.BodyEN, n.Body, n.Title)
+}
+
+// counterpartFields are the machine-translated fields of an article.
+func counterpartFields(n *entity.News) []string {
+	if originalLanguage(n) == "am" {
+		return []string{entity.FieldTitleEN, entity.FieldSummaryEN, entity.FieldBodyEN}
+	}
+	return []string{entity.FieldTitleAM, entity.FieldSummaryAM, entity.FieldBodyAM}
+}
+
+// resummarize rewrites the original-language summary with the served prompt
+// and queues the counterpart summary for translation, even an edited one:
+// it translated the old summary.
+func (uc *reprocessUsecase) resummarize(ctx context.Context, n *entity.News) ([]string, error) {
+	lang := originalLanguage(n)
+	out, version, err := summarizeWithPrompt(ctx, uc.gemini, uc.prompts, n.ID, originalBody(n), lang)
+	if err != nil {
+		return nil, err
+	}
+	summary := enforceMultiLineFiveSentence(out)
+	key := entity.FieldSummaryEN
+	if lang == "am" {
+		key = entity.FieldSummaryAM
+	}
+	if summary == "" || *n.FieldValue(key) == summary {
+		return nil, nil
+	}
+	*n.FieldValue(key) = summary
+	n.SummaryPromptVersion = version
+	counterpart := entity.TranslatableFields[key]
+	now := time.Now()
+	if n.Translations == nil {
+		n.Translations = map[string]entity.FieldTranslation{}
+	}
+	n.Translations[counterpart] = entity.FieldTranslation{Status: entity.TranslationPending, SourceField: key, UpdatedAt: now}
+	n.TranslationDueAt = &now
+	return []string{key, "summary_prompt_version", "translations." + counterpart, "translation_due_at"}, nil
+}
+
+// retranslate replaces the machine translations of the article and returns
+// the fields it changed, with the translation entries whose state moved.
+func (uc *reprocessUsecase) retranslate(ctx context.Context, n *entity.News) []string {
+	if uc.translations == nil {
+		return nil
+	}
+	keys := counterpartFields(n)
+	before := make(map[string]entity.FieldTranslation, len(keys))
+	for _, key := range keys {
+		before[key] = n.Translations[key]
+	}
+	fields := uc.translations.Retranslate(ctx, n, keys)
+	for _, key := range keys {
+		if n.Translations[key] != before[key] {
+			fields = append(fields, "translations."+key, "translation_due_at")
+		}
+	}
+	return fields
+}
+
+// reclassify maps fresh model labels to the topic whitelist, with the same
+// fallbacks as ingestion. Topics missing from the catalog are skipped rather
+// than created, and an article keeps its topics when none resolve.
+func (uc *reprocessUsecase) reclassify(ctx context.Context, n *entity.News) ([]string, error) {
+	body := originalBody(n)
+	labels, err := classifyWithPrompt(ctx, uc.gemini, uc.prompts, n.ID, body, originalLanguage(n), 4)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	seen := map[string]bool{}
+	add := func(slug string) {
+		if slug == "" || seen[slug] {
+			return
+		}
+		seen[slug] = true
+		if t, err := uc.topics.GetTopicBySlug(ctx, slug); err == nil && t != nil && t.ID != "" {
+			ids = append(ids, t.ID)
+		}
+	}
+	for _, lbl := range labels {
+		add(mapLabelToAllowed(lbl))
+	}
+	if len(ids) < 2 {
+		for _, slug := range inferFallbackTopics(firstNonEmpty(n.Title, n.TitleEN, n.TitleAM), body) {
+			if len(ids) >= 2 {
+				break
+			}
+			add(slug)
+		}
+	}
+	if len(ids) == 0 || sameStrings(ids, n.Topics) {
+		return nil, nil
+	}
+	n.Topics = ids
+	return []string{"topics"}, nil
+}
+
+func relocalizeDate(n *entity.News) []string {
+	if n.PublishedAt.IsZero() {
+		return nil
+	}
+	localized := localization.ToEthiopian(n.PublishedAt).FormatYYYYMMDD()
+	if localized == n.PublishedDateLocalized {
+		return nil
+	}
+	n.PublishedDateLocalized = localized
+	return []string{"published_date_localized"}
+}
+
+// dedupe drops repeated entries, keeping the first.
+func dedupe(list []string) []string {
+	out := make([]string, 0, len(list))
+	for _, s := range list {
+		if !containsString(out, s) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// sameStrings compares two lists as sets.
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]bool, len(a))
+	for _, s := range a {
+		set[s] = true
+	}
+	for _, s := range b {
+		if !set[s] {
+			return false
+		}
+	}
+	return true
+}
+
+// sleepUntil waits for t and reports false when ctx ends first.
+func sleepUntil(ctx context.Context, t time.Time) bool {
+	d := time.Until(t)
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (uc *reprocessUsecase) Get(ctx context.Context, id string) (*entity.ReprocessJob, error) {
+	return uc.jobs.Get(ctx, id)
+}
+
+func (uc *reprocessUsecase) List(ctx context.Context, status string, limit int) ([]*entity.ReprocessJob, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	return uc.jobs.List(ctx, status, limit)
+}
+
+func (uc *reprocessUsecase) Pause(ctx context.Context, id string) (*entity.ReprocessJob, error) {
+	return uc.transition(ctx, id, entity.ReprocessPaused, entity.ReprocessRunning)
+}
+
+func (uc *reprocessUsecase) Cancel(ctx context.Context, id string) (*entity.ReprocessJob, error) {
+	return uc.transition(ctx, id, entity.ReprocessCancelled, entity.ReprocessRunning, entity.ReprocessPaused, entity.ReprocessFailed)
+}
+
+func (uc *reprocessUsecase) Resume(ctx context.Context, id string) (*entity.ReprocessJob, error) {
+	j, err := uc.jobs.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case j.Active(time.Now()):
+		return j, nil
+	case j.Status == entity.ReprocessRunning:
+		// its runner died; claim it below
+	default:
+		if j, err = uc.transition(ctx, id, entity.ReprocessRunning, entity.ReprocessPaused, entity.ReprocessFailed); err != nil {
+			return nil, err
+		}
+	}
+	uc.runInBackground(ctx, id)
+	return j, nil
+}
+
+func (uc *reprocessUsecase) ResumeStale(ctx context.Context) (int, error) {
+	jobs, err := uc.jobs.List(ctx, entity.ReprocessRunning, 50)
+	if err != nil {
+		return 0, err
+	}
+	now, started := time.Now(), 0
+	for _, j := range jobs {
+		if j.Active(now) {
+			continue
+		}
+		uc.runInBackground(ctx, j.ID)
+		started++
+	}
+	return started, nil
+}
+
+// transition moves a job in one of the from statuses to status.
+func (uc *reprocessUsecase) transition(ctx context.Context, id, status string, from ...string) (*entity.ReprocessJob, error) {
+	ok, err := uc.jobs.SetStatus(ctx, id, status, from)
+	if err != nil {
+		return nil, err
+	}
+	j, err := uc.jobs.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: the job is %s", contract.ErrConflict, j.Status)
+	}
+	return j, nil
+}
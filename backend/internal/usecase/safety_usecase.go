@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// safetyExcerptLen bounds the text stored with a safety event.
+const safetyExcerptLen = 200
+
+// untrustedOpen and untrustedClose enclose scraped text in prompts; the
+// prompts tell the model that anything between them is data, not instructions.
+const (
+	untrustedOpen  = "<untrusted>"
+	untrustedClose = "</untrusted>"
+)
+
+type safetyRule struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// injectionRules match attempts to override the model's instructions. They
+// apply both to user messages and to scraped article text.
+var injectionRules = []safetyRule{
+	{"ignore_instructions", regexp.MustCompile(`(?i)\b(ignore|disregard|forget|override)\b.{0,40}\b(previous|prior|above|earlier|all|your|system)\b.{0,20}\b(instructions?|rules|prompts?|directions|guidelines)\b`)},
+	{"reveal_prompt", regexp.MustCompile(`(?i)\b(reveal|show|print|repeat|leak|output|tell me)\b.{0,40}\b(system prompt|hidden prompt|initial prompt|initial instructions|your instructions)\b`)},
+	{"role_override", regexp.MustCompile(`(?i)\b(you are now|act as|pretend to be|from now on,? you)\b.{0,40}\b(unrestricted|unfiltered|jailbroken|DAN|no (rules|restrictions|limits))\b|\b(enable|enter|activate|switch to)\b.{0,20}\b(developer|DAN|jailbreak) mode\b`)},
+	{"role_marker", regexp.MustCompile(`(?im)^\s*(system|assistant|developer)\s*:|<\|?(im_start|im_end|system|endoftext)\|?>|</?untrusted>`)},
+	{"ignore_instructions_am", regexp.MustCompile(`(መመሪያ|ትዕዛዝ)[^\n]{0,30}(ችላ|ተው|እርሳ)|(ችላ በል|ተው|እርሳ)[^\n]{0,30}(መመሪያ|ትዕዛዝ)`)},
+	{"reveal_prompt_am", regexp.MustCompile(`የ?ስርዓት(ህ|ዎ)? (መመሪያ|ጥያቄ|ትዕዛዝ)`)},
+}
+
+// harmfulRequestRules match requests outside anything a news assistant may
+// help with, regardless of how they are phrased.
+var harmfulRequestRules = []safetyRule{
+	{"weapons_instructions", regexp.MustCompile(`(?i)\b(how (to|do i|can i|would i)|instructions? (for|to|on)|steps? to|recipe for)\b.{0,40}\b(make|build|assemble|synthesi[sz]e|produce)\b.{0,30}\b(bombs?|explosives?|nerve agents?|bioweapons?|chemical weapons?|pipe bombs?|meth(amphetamine)?)\b`)},
+	{"weapons_instructions_am", regexp.MustCompile(`(ቦምብ|ፈንጂ)[^\n]{0,30}(እንዴት|መስራት|መሥራት|ማዘጋጀት)|(እንዴት|መስራት|መሥራት|ማዘጋጀት)[^\n]{0,30}(ቦምብ|ፈንጂ)`)},
+}
+
+// outputRules match replies that must not reach the user: a model echoing its
+// prompt scaffolding, or giving harmful instructions despite the prompt.
+var outputRules = []safetyRule{
+	{"prompt_leak", regexp.MustCompile(`(?i)</?untrusted>|\b(my|the) (system prompt|hidden instructions|initial instructions)\b (is|are|says|reads)`)},
+	{"harmful_instructions", regexp.MustCompile(`(?i)\b(detonator|ammonium nitrate|pipe bomb|nerve agent|sarin)\b.{0,80}\b(mix|combine|attach|pack|heat|dissolve)\b`)},
+}
+
+type safetyUsecase struct {
+	repo   contract.ISafetyEventRepository
+	uuid   contract.IUUIDGenerator
+	logger contract.IAppLogger
+}
+
+// NewSafetyUsecase guards the AI features against prompt injection in user
+// messages and scraped content, and against unsafe model replies.
+func NewSafetyUsecase(repo contract.ISafetyEventRepository, uuidGen contract.IUUIDGenerator, logger contract.IAppLogger) contract.ISafetyUsecase {
+	return &safetyUsecase{repo: repo, uuid: uuidGen, logger: logger}
+}
+
+func (u *safetyUsecase) Isolate(ctx context.Context, feature, key, text string) string {
+	if strings.TrimSpace(text) == "" {
+		return text
+	}
+	text, _ = u.Redact(ctx, feature, key, text)
+	return untrustedOpen + "\n" + text + "\n" + untrustedClose
+}
+
+func (u *safetyUsecase) Redact(ctx context.Context, feature, key, text string) (string, bool) {
+	found := false
+	for _, r := range injectionRules {
+		loc := r.pattern.FindStringIndex(text)
+		if loc == nil {
+			continue
+		}
+		u.Log(ctx, &entity.SafetyEvent{
+			Stage:   entity.SafetyContent,
+			Action:  entity.SafetyRedacted,
+			Rule:    r.name,
+			Feature: feature,
+			Key:     key,
+			Excerpt: text[loc[0]:loc[1]],
+		})
+		text = r.pattern.ReplaceAllString(text, "[removed]")
+		found = true
+	}
+	return text, found
+}
+
+func (u *safetyUsecase) CheckInput(ctx context.Context, feature, key, message string) error {
+	return u.check(ctx, entity.SafetyInput, feature, key, message, injectionRules, harmfulRequestRules)
+}
+
+func (u *safetyUsecase) ModerateOutput(ctx context.Context, feature, key, reply string) error {
+	return u.check(ctx, entity.SafetyOutput, feature, key, reply, outputRules)
+}
+
+func (u *safetyUsecase) check(ctx context.Context, stage entity.SafetyStage, feature, key, text string, ruleSets ...[]safetyRule) error {
+	for _, rules := range ruleSets {
+		for _, r := range rules {
+			if loc := r.pattern.FindStringIndex(text); loc != nil {
+				u.Log(ctx, &entity.SafetyEvent{
+					Stage:   stage,
+					Action:  entity.SafetyBlocked,
+					Rule:    r.name,
+					Feature: feature,
+					Key:     key,
+					Excerpt: text[loc[0]:loc[1]],
+				})
+				return fmt.Errorf("%w: %s", contract.ErrUnsafeContent, r.name)
+			}
+		}
+	}
+	return nil
+}
+
+func (u *safetyUsecase) Refusal(lang string) string {
+	if lang == "am" {
+		return "ይቅርታ፣ ይህን ጥያቄ ማስተናገድ አልችልም። ስለ ዜናዎች ብቻ ነው መመለስ የምችለው።"
+	}
+	return "Sorry, I can't help with that request. I can only answer questions about the news."
+}
+
+// Log stores the event with the acting user or client taken from ctx. It
+// never fails the request it was raised from.
+func (u *safetyUsecase) Log(ctx context.Context, e *entity.SafetyEvent) {
+	actor := contract.UsageActorFrom(ctx)
+	e.ID = u.uuid.NewUUID()
+	e.UserID, e.ClientIP = actor.UserID, actor.ClientIP
+	e.Excerpt = truncateText(e.Excerpt, safetyExcerptLen)
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now().UTC()
+	}
+	if u.logger != nil {
+		u.logger.Warnf("safety: %s %s by rule %s in %s (key=%s actor=%s)", e.Stage, e.Action, e.Rule, e.Feature, e.Key, actor.Key())
+	}
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 3*time.Second)
+	defer cancel()
+	if err := u.repo.Save(saveCtx, e); err != nil && u.logger != nil {
+		u.logger.Errorf("safety event not saved: %v", err)
+	}
+}
+
+func (u *safetyUsecase) ListEvents(ctx context.Context, stage entity.SafetyStage, page, limit int) ([]*entity.SafetyEvent, int64, int, error) {
+	return u.repo.List(ctx, stage, page, limit)
+}
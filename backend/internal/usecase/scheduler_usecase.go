@@ -0,0 +1,264 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/cron"
+)
+
+const (
+	// defaultJobTimeout bounds runs of jobs registered without a timeout
+	defaultJobTimeout = 10 * time.Minute
+	// jobLeaseMargin keeps the lease a little past the run timeout so a slow
+	// finish is not mistaken for a dead replica
+	jobLeaseMargin = time.Minute
+	// maxSchedulerSleep makes the loop re-check at least this often
+	maxSchedulerSleep = time.Minute
+)
+
+type registeredJob struct {
+	spec     contract.JobSpec
+	schedule *cron.Schedule
+	next     time.Time
+}
+
+type scheduler struct {
+	repo   contract.IJobRepository
+	logger contract.IAppLogger
+	loc    *time.Location
+	// owner identifies this replica in job leases
+	owner string
+
+	mu   sync.Mutex
+	jobs map[string]*registeredJob
+}
+
+// NewScheduler fires jobs on cron schedules evaluated in loc. Every replica
+// runs the loop; a lease on the job document lets only one of them run each
+// firing.
+func NewScheduler(repo contract.IJobRepository, logger contract.IAppLogger, loc *time.Location, owner string) contract.IScheduler {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return &scheduler{repo: repo, logger: logger, loc: loc, owner: owner, jobs: map[string]*registeredJob{}}
+}
+
+func (s *scheduler) Register(spec contract.JobSpec) error {
+	sched, err := cron.Parse(spec.Schedule)
+	if err != nil {
+		return fmt.Errorf("%w: job %s: %v", contract.ErrInvalidInput, spec.Name, err)
+	}
+	if sched.Next(time.Now().In(s.loc)).IsZero() {
+		return fmt.Errorf("%w: job %s: schedule %q never fires", contract.ErrInvalidInput, spec.Name, spec.Schedule)
+	}
+	if spec.Timeout <= 0 {
+		spec.Timeout = defaultJobTimeout
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, dup := s.jobs[spec.Name]; dup {
+		return fmt.Errorf("%w: job %s registered twice", contract.ErrInvalidInput, spec.Name)
+	}
+	s.jobs[spec.Name] = &registeredJob{spec: spec, schedule: sched}
+	return nil
+}
+
+func (s *scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	now := time.Now().In(s.loc)
+	for name, job := range s.jobs {
+		ensureCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		if err := s.repo.Ensure(ensureCtx, name, job.spec.Schedule); err != nil {
+			s.logger.Errorf("scheduler: register %s: %v", name, err)
+		}
+		cancel()
+		job.next = job.schedule.Next(now)
+	}
+	s.mu.Unlock()
+
+	for {
+		timer := time.NewTimer(s.sleep())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		now := time.Now().In(s.loc)
+		s.mu.Lock()
+		for _, job := range s.jobs {
+			if job.next.IsZero() || job.next.After(now) {
+				continue
+			}
+			tick := job.next
+			job.next = job.schedule.Next(now)
+			go s.fire(ctx, job, tick)
+		}
+		s.mu.Unlock()
+	}
+}
+
+// sleep returns the time until the earliest firing, at most maxSchedulerSleep.
+func (s *scheduler) sleep() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := maxSchedulerSleep
+	for _, job := range s.jobs {
+		if job.next.IsZero() {
+			continue
+		}
+		if until := time.Until(job.next); until < d {
+			d = until
+		}
+	}
+	if d < 0 {
+		d = 0
+	}
+	return d
+}
+
+// fire runs the firing at tick if this replica wins its lease.
+func (s *scheduler) fire(ctx context.Context, job *registeredJob, tick time.Time) {
+	name := job.spec.Name
+	claimCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	ok, err := s.repo.Claim(claimCtx, name, s.owner, tick.UTC(), time.Now().UTC().Add(job.spec.Timeout+jobLeaseMargin), false)
+	cancel()
+	if err != nil {
+		s.logger.Errorf("scheduler: claim %s: %v", name, err)
+		return
+	}
+	if !ok {
+		// paused, still running, or another replica took this firing
+		return
+	}
+	s.run(ctx, job, "schedule")
+}
+
+// run executes one job run under its timeout and records the outcome.
+func (s *scheduler) run(ctx context.Context, job *registeredJob, trigger string) {
+	name := job.spec.Name
+	runCtx, cancel := context.WithTimeout(contract.WithUsageActor(ctx, contract.UsageActor{Job: name}), job.spec.Timeout)
+	defer cancel()
+	start := time.Now().UTC()
+	result, err := s.call(runCtx, job.spec.Run)
+	run := entity.JobRun{StartedAt: start, Duration: time.Since(start), Trigger: trigger, Result: result, Err: err}
+	if err != nil {
+		s.logger.Errorf("job %s (%s) failed after %s: %v", name, trigger, run.Duration.Round(time.Millisecond), err)
+	} else {
+		s.logger.Infof("job %s (%s) done in %s: %s", name, trigger, run.Duration.Round(time.Millisecond), result)
+	}
+	// the outcome is recorded even when the scheduler is shutting down
+	finishCtx, cancelFinish := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancelFinish()
+	if err := s.repo.Finish(finishCtx, name, s.owner, run); err != nil {
+		s.logger.Errorf("scheduler: finish %s: %v", name, err)
+	}
+}
+
+// call turns a panicking job into a failed run so its lease is released.
+func (s *scheduler) call(ctx context.Context, fn contract.JobFunc) (result string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return fn(ctx)
+}
+
+func (s *scheduler) List(ctx context.Context) ([]contract.JobStatus, error) {
+	stored, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byName := map[string]*entity.ScheduledJob{}
+	for _, j := range stored {
+		byName[j.Name] = j
+	}
+	s.mu.Lock()
+	names := make([]string, 0, len(s.jobs))
+	for name := range s.jobs {
+		names = append(names, name)
+	}
+	s.mu.Unlock()
+	sort.Strings(names)
+	out := make([]contract.JobStatus, 0, len(names))
+	for _, name := range names {
+		out = append(out, s.status(name, byName[name]))
+	}
+	return out, nil
+}
+
+func (s *scheduler) SetPaused(ctx context.Context, name string, paused bool, by string) (*contract.JobStatus, error) {
+	if s.job(name) == nil {
+		return nil, contract.ErrNotFound
+	}
+	if err := s.repo.SetPaused(ctx, name, paused, by); err != nil {
+		return nil, err
+	}
+	return s.load(ctx, name)
+}
+
+func (s *scheduler) Trigger(ctx context.Context, name, by string) (*contract.JobStatus, error) {
+	job := s.job(name)
+	if job == nil {
+		return nil, contract.ErrNotFound
+	}
+	// a trigger at startup may come before Start stored the job
+	if err := s.repo.Ensure(ctx, name, job.spec.Schedule); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	ok, err := s.repo.Claim(ctx, name, s.owner, now, now.Add(job.spec.Timeout+jobLeaseMargin), true)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: job %s is already running", contract.ErrConflict, name)
+	}
+	// the run outlives the admin's request
+	go s.run(context.WithoutCancel(ctx), job, "manual:"+by)
+	return s.load(ctx, name)
+}
+
+func (s *scheduler) job(name string) *registeredJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.jobs[name]
+}
+
+func (s *scheduler) load(ctx context.Context, name string) (*contract.JobStatus, error) {
+	stored, err := s.repo.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	st := s.status(name, stored)
+	return &st, nil
+}
+
+// status merges the registered spec with the stored state, which is nil for
+// a job the loop has not recorded yet.
+func (s *scheduler) status(name string, stored *entity.ScheduledJob) contract.JobStatus {
+	s.mu.Lock()
+	job := s.jobs[name]
+	next := job.next
+	if next.IsZero() {
+		next = job.schedule.Next(time.Now().In(s.loc))
+	}
+	s.mu.Unlock()
+	st := contract.JobStatus{Description: job.spec.Description, Timezone: s.loc.String()}
+	if stored != nil {
+		st.ScheduledJob = *stored
+	}
+	st.Name, st.Schedule = name, job.spec.Schedule
+	st.Running = st.ScheduledJob.Running(time.Now())
+	if !st.Paused && !next.IsZero() {
+		st.NextRunAt = &next
+	}
+	return st
+}
@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
@@ -44,6 +46,23 @@ func (uc *sourceUsecase) CreateSource(ctx context.Context, source *entity.Source
 	if urlExists {
 		return errors.New("source with URL already exists")
 	}
+	if len(source.FeedURLs) > 0 {
+		feeds, err := cleanFeedURLs(source.FeedURLs)
+		if err != nil {
+			return err
+		}
+		source.FeedURLs = feeds
+	}
+	if source.PollIntervalMinutes < 0 || (source.PollIntervalMinutes > 0 && time.Duration(source.PollIntervalMinutes)*time.Minute < minFeedPollInterval) {
+		return fmt.Errorf("%w: poll interval must be at least %d minutes", contract.ErrInvalidInput, int(minFeedPollInterval.Minutes()))
+	}
+	// a score given by hand is an editorial judgement the scoring job keeps
+	if source.ReliabilityScore < 0 || source.ReliabilityScore > 100 {
+		return fmt.Errorf("%w: reliability score must be between 0 and 100", contract.ErrInvalidInput)
+	}
+	if source.ReliabilityScore > 0 {
+		source.ReliabilityOverride = &entity.ReliabilityOverride{Score: source.ReliabilityScore, Note: "set when the source was created", SetAt: time.Now().UTC()}
+	}
 	// inc total source count
 	if err := uc.analyticRepo.IncrementTotalSource(ctx); err != nil {
 		return err
@@ -0,0 +1,305 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+	"github.com/RealEskalate/G6-NewsBrief/internal/infrastructure/vectorindex"
+)
+
+const (
+	// storyWindow is how far back a story may still absorb new articles
+	storyWindow = 48 * time.Hour
+	// storyStrongSimilarity joins a story on embedding similarity alone
+	storyStrongSimilarity = 0.86
+	// storyWeakSimilarity joins a story only when titles also overlap
+	storyWeakSimilarity = 0.75
+	storyTitleOverlap   = 0.3
+	// storyTitleOnlyOverlap is used when no embedding is available
+	storyTitleOnlyOverlap = 0.6
+	// storySynthesisArticles bounds the articles sent to the model for synthesis
+	storySynthesisArticles = 6
+	maxStoryKeyTerms       = 40
+	// storyUpdateAttempts bounds retries when articles join a story concurrently
+	storyUpdateAttempts = 5
+	// trendingStoryWindow is how recent a story must be to trend
+	trendingStoryWindow = 48 * time.Hour
+)
+
+type storyUsecase struct {
+	stories  contract.IStoryRepository
+	newsRepo contract.INewsRepository
+	index    contract.IVectorIndex
+	gemini   contract.IGeminiClient
+	prompts  contract.IPromptRegistry
+	uuidGen  contract.IUUIDGenerator
+}
+
+func NewStoryUsecase(stories contract.IStoryRepository, newsRepo contract.INewsRepository, index contract.IVectorIndex, gemini contract.IGeminiClient, prompts contract.IPromptRegistry, uuidGen contract.IUUIDGenerator) contract.IStoryUsecase {
+	return &storyUsecase{stories: stories, newsRepo: newsRepo, index: index, gemini: gemini, prompts: prompts, uuidGen: uuidGen}
+}
+
+// AssignNews clusters the article incrementally: it is compared to stories active
+// within the time window by embedding and title similarity and joins the best
+// match, otherwise it starts a new single-article story.
+func (u *storyUsecase) AssignNews(ctx context.Context, news *entity.News) (*entity.Story, error) {
+	if news.StoryID != "" {
+		return u.stories.FindByID(ctx, news.StoryID)
+	}
+	var vector []float32
+	if u.index != nil {
+		if e, err := u.index.Get(ctx, news.ID); err == nil {
+			vector = e.Vector
+		}
+	}
+	terms := titleTerms(firstNonEmpty(news.TitleEN, news.Title))
+
+	candidates, err := u.stories.FindActiveSince(ctx, news.PublishedAt.Add(-storyWindow))
+	if err != nil {
+		return nil, err
+	}
+	var best *entity.Story
+	bestScore := 0.0
+	for _, s := range candidates {
+		score, ok := storyMatch(s, vector, terms)
+		if ok && score > bestScore {
+			best, bestScore = s, score
+		}
+	}
+
+	if best == nil {
+		story := newSingleArticleStory(u.uuidGen.NewUUID(), news, vector, terms)
+		if err := u.stories.Save(ctx, story); err != nil {
+			return nil, err
+		}
+		news.StoryID = story.ID
+		return story, u.newsRepo.UpdateFields(ctx, news, []string{"story_id"}, nil)
+	}
+
+	for attempt := 1; ; attempt++ {
+		addToStory(best, news, vector, terms)
+		err := u.stories.Update(ctx, best)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, contract.ErrConflict) || attempt == storyUpdateAttempts {
+			return nil, err
+		}
+		// another article joined meanwhile; add this one to the stored story
+		if best, err = u.stories.FindByID(ctx, best.ID); err != nil {
+			return nil, err
+		}
+	}
+	// A second source makes this a multi-source story: (re)generate the neutral headline and summary
+	// (on failure the previous text is kept and the next article retries)
+	if len(best.NewsIDs) >= 2 && u.synthesize(ctx, best) == nil {
+		_ = u.stories.SetSynthesis(ctx, best.ID, best.Headline, best.Summary, best.PromptVersion)
+	}
+	news.StoryID = best.ID
+	return best, u.newsRepo.UpdateFields(ctx, news, []string{"story_id"}, nil)
+}
+
+func (u *storyUsecase) GetStory(ctx context.Context, id string) (*entity.Story, []*entity.News, error) {
+	story, err := u.stories.FindByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	articles, err := u.newsRepo.FindByIDs(ctx, story.NewsIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return story, articles, nil
+}
+
+// TrendingStories ranks recent stories by how many sources and articles cover them.
+func (u *storyUsecase) TrendingStories(ctx context.Context, since time.Time, page, limit int) ([]*entity.Story, int64, int, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+	if since.IsZero() {
+		since = time.Now().Add(-trendingStoryWindow)
+	}
+	return u.stories.ListTrending(ctx, since, page, limit)
+}
+
+func (u *storyUsecase) ListStories(ctx context.Context, since time.Time, page, limit int) ([]*entity.Story, int64, int, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+	return u.stories.List(ctx, since, page, limit)
+}
+
+// storyMatch scores an article against a story and reports whether it belongs to it.
+func storyMatch(s *entity.Story, vector []float32, terms []string) (float64, bool) {
+	overlap := termOverlap(terms, s.KeyTerms)
+	if len(vector) == 0 || len(s.Centroid) == 0 {
+		return overlap, overlap >= storyTitleOnlyOverlap
+	}
+	sim := vectorindex.Cosine(vector, s.Centroid)
+	switch {
+	case sim >= storyStrongSimilarity:
+		return sim, true
+	case sim >= storyWeakSimilarity && overlap >= storyTitleOverlap:
+		return sim, true
+	}
+	return sim, false
+}
+
+func newSingleArticleStory(id string, news *entity.News, vector []float32, terms []string) *entity.Story {
+	story := &entity.Story{
+		ID:               id,
+		Headline:         entity.BilingualField{EN: firstNonEmpty(news.TitleEN, news.Title), AM: news.TitleAM},
+		Summary:          entity.BilingualField{EN: news.SummaryEN, AM: news.SummaryAM},
+		NewsIDs:          []string{news.ID},
+		Centroid:         vector,
+		KeyTerms:         terms,
+		ArticleCount:     1,
+		FirstPublishedAt: news.PublishedAt,
+		LastPublishedAt:  news.PublishedAt,
+	}
+	if news.SourceID != "" {
+		story.SourceIDs = []string{news.SourceID}
+	}
+	story.SourceCount = len(story.SourceIDs)
+	return story
+}
+
+func addToStory(s *entity.Story, news *entity.News, vector []float32, terms []string) {
+	if containsString(s.NewsIDs, news.ID) {
+		return
+	}
+	n := float32(len(s.NewsIDs))
+	if len(vector) > 0 {
+		if len(s.Centroid) != len(vector) {
+			s.Centroid = vector
+		} else {
+			// running mean of member vectors
+			for i := range s.Centroid {
+				s.Centroid[i] = (s.Centroid[i]*n + vector[i]) / (n + 1)
+			}
+		}
+	}
+	s.NewsIDs = append(s.NewsIDs, news.ID)
+	s.ArticleCount = len(s.NewsIDs)
+	if news.SourceID != "" && !containsString(s.SourceIDs, news.SourceID) {
+		s.SourceIDs = append(s.SourceIDs, news.SourceID)
+	}
+	s.SourceCount = len(s.SourceIDs)
+	for _, t := range terms {
+		if len(s.KeyTerms) >= maxStoryKeyTerms {
+			break
+		}
+		if !containsString(s.KeyTerms, t) {
+			s.KeyTerms = append(s.KeyTerms, t)
+		}
+	}
+	if news.PublishedAt.Before(s.FirstPublishedAt) {
+		s.FirstPublishedAt = news.PublishedAt
+	}
+	if news.PublishedAt.After(s.LastPublishedAt) {
+		s.LastPublishedAt = news.PublishedAt
+	}
+}
+
+// synthesize asks the model for a neutral bilingual headline and a combined summary of all sources.
+func (u *storyUsecase) synthesize(ctx context.Context, s *entity.Story) error {
+	ids := s.NewsIDs
+	if len(ids) > storySynthesisArticles {
+		ids = ids[len(ids)-storySynthesisArticles:]
+	}
+	articles, err := u.newsRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if len(articles) < 2 {
+		return errors.New("not enough articles to synthesize")
+	}
+	var b strings.Builder
+	for i, n := range articles {
+		fmt.Fprintf(&b, "Article %d: %s\n%s\n\n", i+1, firstNonEmpty(n.TitleEN, n.Title), firstNonEmpty(n.SummaryEN, n.BodyEN, n.Body))
+	}
+	p, err := u.prompts.Render(ctx, "story_synthesis", "en", s.ID, map[string]string{"articles": strings.TrimSpace(b.String())})
+	if err != nil {
+		return err
+	}
+	raw, err := u.gemini.Generate(contract.WithUsageOperation(ctx, "story_summary"), p.Text)
+	if err != nil {
+		return err
+	}
+	var out struct {
+		HeadlineEN string `json:"headline_en"`
+		HeadlineAM string `json:"headline_am"`
+		SummaryEN  string `json:"summary_en"`
+		SummaryAM  string `json:"summary_am"`
+	}
+	if err := json.Unmarshal([]byte(extractJSON(raw)), &out); err != nil {
+		return err
+	}
+	if out.HeadlineEN == "" || out.SummaryEN == "" {
+		return errors.New("incomplete story synthesis")
+	}
+	s.Headline = entity.BilingualField{EN: out.HeadlineEN, AM: out.HeadlineAM}
+	s.Summary = entity.BilingualField{EN: out.SummaryEN, AM: out.SummaryAM}
+	s.PromptVersion = p.Version
+	return nil
+}
+
+var titleStopwords = map[string]struct{}{
+	"the": {}, "and": {}, "for": {}, "with": {}, "from": {}, "that": {}, "this": {}, "are": {}, "was": {},
+	"has": {}, "have": {}, "its": {}, "into": {}, "over": {}, "after": {}, "amid": {}, "says": {}, "new": {},
+}
+
+// titleTerms returns normalized, de-duplicated title tokens (letters/digits, 3+ characters).
+func titleTerms(title string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	out := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if len([]rune(f)) < 3 {
+			continue
+		}
+		if _, stop := titleStopwords[f]; stop {
+			continue
+		}
+		if !containsString(out, f) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// termOverlap returns the share of the article's title terms found in the story's terms.
+// Unlike Jaccard it does not decay as a story accumulates terms from more articles.
+func termOverlap(terms, storyTerms []string) float64 {
+	if len(terms) == 0 || len(storyTerms) == 0 {
+		return 0
+	}
+	set := make(map[string]struct{}, len(storyTerms))
+	for _, t := range storyTerms {
+		set[t] = struct{}{}
+	}
+	inter := 0
+	for _, t := range terms {
+		if _, ok := set[t]; ok {
+			inter++
+		}
+	}
+	return float64(inter) / float64(len(terms))
+}
+
+func containsString(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
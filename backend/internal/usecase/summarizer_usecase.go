@@ -1,25 +1,52 @@
 package usecase
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
 	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
 )
 
+// summaryTimeout bounds one generation of a summary variant
+const summaryTimeout = 2 * time.Minute
+
 type SummarizerUsecase struct {
 	geminiClient contract.IGeminiClient
 	newsRepo     contract.INewsRepository
+	summaryRepo  contract.ISummaryRepository
+	uuidGen      contract.IUUIDGenerator
+	prompts      contract.IPromptRegistry
+
+	// generating holds the variant generations in flight, keyed by news, type and language
+	generateMu sync.Mutex
+	generating map[string]*summaryCall
+}
+
+type summaryCall struct {
+	done    chan struct{}
+	summary entity.Summary
+	err     error
 }
 
-func NewsSummarizerUsecase(geminiClient contract.IGeminiClient, repo contract.INewsRepository) contract.ISummarizerService {
+func NewsSummarizerUsecase(geminiClient contract.IGeminiClient, repo contract.INewsRepository, summaryRepo contract.ISummaryRepository, uuidGen contract.IUUIDGenerator, prompts contract.IPromptRegistry) contract.ISummarizerService {
 	return &SummarizerUsecase{
 		geminiClient: geminiClient,
 		newsRepo:     repo,
+		summaryRepo:  summaryRepo,
+		uuidGen:      uuidGen,
+		prompts:      prompts,
+		generating:   map[string]*summaryCall{},
 	}
 }
 
-func (uc *SummarizerUsecase) Summarize(newsID string) (entity.Summary, error) {
+func (uc *SummarizerUsecase) Summarize(ctx context.Context, newsID string) (entity.Summary, error) {
 	// Fetch news first
 	news, err := uc.newsRepo.FindByID(newsID)
 	if err != nil {
@@ -27,18 +54,22 @@ func (uc *SummarizerUsecase) Summarize(newsID string) (entity.Summary, error) {
 	}
 
 	// Call Gemini API to generate summaries
-	summaryText, err := uc.geminiClient.Summarize(news.Body, news.Language)
+	summaryText, promptVersion, err := summarizeWithPrompt(ctx, uc.geminiClient, uc.prompts, news.ID, news.Body, news.Language)
 	if err != nil {
 		return entity.Summary{}, err
 	}
 
 	// Create Summary entity
 	summary := entity.Summary{
-		NewsID:    news.ID,
-		Content:   summaryText,
-		Language:  news.Language,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		ID:            uc.uuidGen.NewUUID(),
+		NewsID:        news.ID,
+		Type:          entity.SummaryDetailed,
+		Content:       summaryText,
+		Language:      news.Language,
+		Model:         uc.geminiClient.Model(),
+		PromptVersion: promptVersion,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
 	}
 
 	// Save summary to news entity
@@ -48,12 +79,169 @@ func (uc *SummarizerUsecase) Summarize(newsID string) (entity.Summary, error) {
 	case "am":
 		news.SummaryAM = summaryText
 	}
+	summary.SourceHash = summarySourceHash(news)
 
+	news.SummaryPromptVersion = promptVersion
 	news.UpdatedAt = time.Now()
 
 	if err := uc.newsRepo.Update(news); err != nil {
 		return entity.Summary{}, err
 	}
+	// Keep the legacy summary as the cached detailed variant of the original language
+	if uc.summaryRepo != nil {
+		_ = uc.summaryRepo.Upsert(ctx, &summary)
+	}
+
+	return summary, nil
+}
+
+// GetSummary returns a cached summary variant, generating it on first request
+// and again once the article text has changed. Concurrent requests for the
+// same variant share one generation.
+func (uc *SummarizerUsecase) GetSummary(ctx context.Context, newsID string, summaryType entity.SummaryType, lang string) (entity.Summary, error) {
+	if _, ok := entity.ParseSummaryType(string(summaryType)); !ok {
+		return entity.Summary{}, fmt.Errorf("unsupported summary type: %s", summaryType)
+	}
+	news, err := uc.newsRepo.FindByID(newsID)
+	if err != nil {
+		return entity.Summary{}, err
+	}
+	if lang == "" {
+		lang = news.Language
+	}
+	if lang != "en" && lang != "am" {
+		return entity.Summary{}, fmt.Errorf("unsupported language: %s", lang)
+	}
+
+	hash := summarySourceHash(news)
+	cached, err := uc.summaryRepo.Find(ctx, newsID, summaryType, lang)
+	if err == nil && cached != nil && cached.SourceHash == hash {
+		return *cached, nil
+	}
+	if err != nil && !errors.Is(err, contract.ErrNotFound) {
+		return entity.Summary{}, err
+	}
+
+	key := newsID + "|" + string(summaryType) + "|" + lang
+	uc.generateMu.Lock()
+	c, ok := uc.generating[key]
+	if !ok {
+		c = &summaryCall{done: make(chan struct{})}
+		uc.generating[key] = c
+		genCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), summaryTimeout)
+		go func() {
+			defer cancel()
+			c.summary, c.err = uc.generateSummary(genCtx, news, summaryType, lang, hash)
+			uc.generateMu.Lock()
+			delete(uc.generating, key)
+			uc.generateMu.Unlock()
+			close(c.done)
+		}()
+	}
+	uc.generateMu.Unlock()
+	select {
+	case <-c.done:
+		return c.summary, c.err
+	case <-ctx.Done():
+		return entity.Summary{}, ctx.Err()
+	}
+}
+
+// generateSummary generates and stores one summary variant of the article.
+func (uc *SummarizerUsecase) generateSummary(ctx context.Context, news *entity.News, summaryType entity.SummaryType, lang, hash string) (entity.Summary, error) {
+	// The variant is generated from the original text in every language
+	name := "summary_" + string(summaryType)
+	prompt, err := uc.prompts.Render(ctx, name, lang, news.ID, map[string]string{"text": newsBodyFor(news, news.Language)})
+	if err != nil {
+		return entity.Summary{}, err
+	}
+	raw, err := uc.geminiClient.Generate(contract.WithUsageOperation(ctx, name), prompt.Text)
+	if err != nil {
+		return entity.Summary{}, err
+	}
 
+	now := time.Now()
+	summary := entity.Summary{
+		ID:            uc.uuidGen.NewUUID(),
+		NewsID:        news.ID,
+		Type:          summaryType,
+		Language:      lang,
+		Model:         uc.geminiClient.Model(),
+		PromptVersion: prompt.Version,
+		SourceHash:    hash,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	switch summaryType {
+	case entity.SummaryBullets:
+		summary.Bullets = parseBullets(raw)
+		summary.Content = "- " + strings.Join(summary.Bullets, "\n- ")
+	case entity.SummaryHeadline:
+		summary.Content = strings.Trim(firstLine(raw), "\"'*# ")
+	default:
+		summary.Content = strings.TrimSpace(raw)
+	}
+	if err := uc.summaryRepo.Upsert(ctx, &summary); err != nil {
+		return entity.Summary{}, err
+	}
 	return summary, nil
 }
+
+// summarySourceHash fingerprints the original-language body and summary the
+// variants are made from, so a rewritten article no longer matches its variants.
+func summarySourceHash(n *entity.News) string {
+	summary := n.SummaryEN
+	if n.Language == "am" {
+		summary = n.SummaryAM
+	}
+	sum := sha256.Sum256([]byte(newsBodyFor(n, n.Language) + "\x00" + summary))
+	return hex.EncodeToString(sum[:])
+}
+
+// newsBodyFor returns the best available body text for the given language.
+func newsBodyFor(n *entity.News, lang string) string {
+	switch {
+	case lang == "am" && n.BodyAM != "":
+		return n.BodyAM
+	case lang == "en" && n.BodyEN != "":
+		return n.BodyEN
+	case n.Body != "":
+		return n.Body
+	}
+	return n.Title
+}
+
+// languageName maps a language code to the name used in prompts.
+func languageName(code string) string {
+	switch code {
+	case "am":
+		return "Amharic"
+	default:
+		return "English"
+	}
+}
+
+// parseBullets splits a model reply into bullet items, stripping list markers.
+func parseBullets(s string) []string {
+	out := []string{}
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimLeft(line, "-*•· ")
+		// numbered lists: "1." / "1)"
+		if i := strings.IndexAny(line, ".)"); i > 0 && i <= 2 && strings.Trim(line[:i], "0123456789") == "" {
+			line = strings.TrimSpace(line[i+1:])
+		}
+		if line != "" {
+			out = append(out, line)
+		}
+	}
+	return out
+}
+
+func firstLine(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.Index(s, "\n"); i >= 0 {
+		return strings.TrimSpace(s[:i])
+	}
+	return s
+}
@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// maxTranslationAttempts is how often a field is tried before the source text is mirrored.
+const maxTranslationAttempts = 4
+
+// translationBackoff is the delay before retry n (1-based); the last value repeats.
+var translationBackoff = []time.Duration{time.Minute, 5 * time.Minute, 30 * time.Minute}
+
+// translationLease is how long a claimed article is hidden from other
+// workers; one that is not written back by then is due again.
+const translationLease = 10 * time.Minute
+
+// translationOrder fixes the order fields are translated in (titles first, so
+// they are ready soonest).
+var translationOrder = []string{
+	entity.FieldTitleAM, entity.FieldTitleEN,
+	entity.FieldSummaryAM, entity.FieldSummaryEN,
+	entity.FieldBodyAM, entity.FieldBodyEN,
+}
+
+type translationPipeline struct {
+	translator contract.ITranslationClient
+	newsRepo   contract.INewsRepository
+	embeddings contract.IEmbeddingService
+	revisions  contract.IRevisionRepository
+	uuidGen    contract.IUUIDGenerator
+}
+
+func NewTranslationPipeline(translator contract.ITranslationClient, newsRepo contract.INewsRepository, embeddings contract.IEmbeddingService, revisions contract.IRevisionRepository, uuidGen contract.IUUIDGenerator) contract.ITranslationPipeline {
+	return &translationPipeline{translator: translator, newsRepo: newsRepo, embeddings: embeddings, revisions: revisions, uuidGen: uuidGen}
+}
+
+func (p *translationPipeline) Enqueue(news *entity.News) {
+	now := time.Now()
+	queued := false
+	for _, key := range translationOrder {
+		sourceKey := entity.TranslatableFields[key]
+		if *news.FieldValue(key) != "" || *news.FieldValue(sourceKey) == "" {
+			continue
+		}
+		if _, tracked := news.Translations[key]; tracked {
+			continue
+		}
+		if news.Translations == nil {
+			news.Translations = map[string]entity.FieldTranslation{}
+		}
+		news.Translations[key] = entity.FieldTranslation{Status: entity.TranslationPending, SourceField: sourceKey, UpdatedAt: now}
+		queued = true
+	}
+	if queued {
+		news.TranslationDueAt = &now
+	}
+}
+
+func (p *translationPipeline) ProcessDue(ctx context.Context, limit int) (int, error) {
+	processed := 0
+	for processed < limit {
+		if ctx.Err() != nil {
+			return processed, ctx.Err()
+		}
+		n, err := p.newsRepo.ClaimTranslationDue(ctx, time.Now(), time.Now().Add(translationLease))
+		if err != nil {
+			return processed, err
+		}
+		if n == nil {
+			break
+		}
+		lease := *n.TranslationDueAt
+		before := make(map[string]entity.FieldTranslation, len(n.Translations))
+		for key, state := range n.Translations {
+			before[key] = state
+		}
+		changed, err := p.saveTranslated(ctx, n, before, p.translate(ctx, n))
+		if err != nil {
+			return processed, err
+		}
+		// leave translation_due_at alone if the article was queued again meanwhile
+		if err := p.newsRepo.UpdateFields(ctx, n, []string{"translation_due_at"}, map[string]interface{}{"translation_due_at": lease}); err != nil && !errors.Is(err, contract.ErrConflict) {
+			return processed, err
+		}
+		p.recordRevisions(ctx, n, changed)
+		if englishChanged(changed) && p.embeddings != nil {
+			_ = p.embeddings.IndexNews(ctx, n)
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// saveTranslated writes each field the worker touched, unless it was changed
+// since the article was claimed (say, corrected by an editor), and returns
+// the changed fields that were saved.
+func (p *translationPipeline) saveTranslated(ctx context.Context, n *entity.News, before map[string]entity.FieldTranslation, changed []string) ([]string, error) {
+	var saved []string
+	for _, key := range translationOrder {
+		prev, state := before[key], n.Translations[key]
+		if prev == state {
+			continue
+		}
+		err := p.newsRepo.UpdateFields(ctx, n, []string{key, "translations." + key}, map[string]interface{}{
+			"translations." + key + ".status":   string(prev.Status),
+			"translations." + key + ".attempts": prev.Attempts,
+		})
+		if errors.Is(err, contract.ErrConflict) {
+			continue
+		}
+		if err != nil {
+			return saved, err
+		}
+		if containsString(changed, key) {
+			saved = append(saved, key)
+		}
+	}
+	return saved, nil
+}
+
+func (p *translationPipeline) Retranslate(ctx context.Context, n *entity.News, fields []string) []string {
+	now := time.Now()
+	for _, key := range fields {
+		sourceKey, ok := entity.TranslatableFields[key]
+		if !ok || *n.FieldValue(sourceKey) == "" {
+			continue
+		}
+		if n.Translations[key].Status == entity.TranslationEdited {
+			continue
+		}
+		if n.Translations == nil {
+			n.Translations = map[string]entity.FieldTranslation{}
+		}
+		// the current text stays until a new translation replaces it
+		n.Translations[key] = entity.FieldTranslation{Status: entity.TranslationPending, SourceField: sourceKey, UpdatedAt: now}
+	}
+	changed := p.translate(ctx, n)
+	p.recordRevisions(ctx, n, changed)
+	return changed
+}
+
+// recordRevisions starts or extends the revision history of machine-translated fields.
+func (p *translationPipeline) recordRevisions(ctx context.Context, n *entity.News, changed []string) {
+	if p.revisions == nil {
+		return
+	}
+	for _, key := range changed {
+		_ = p.revisions.Save(ctx, &entity.FieldRevision{
+			ID:      p.uuidGen.NewUUID(),
+			NewsID:  n.ID,
+			Field:   key,
+			Content: *n.FieldValue(key),
+			Origin:  entity.RevisionMachine,
+		})
+	}
+}
+
+// englishChanged reports whether an English field is among the changed ones;
+// embeddings are computed from the English text.
+func englishChanged(changed []string) bool {
+	for _, key := range changed {
+		if entity.FieldLanguage(key) == "en" {
+			return true
+		}
+	}
+	return false
+}
+
+// translate works through the article's pending and retryable fields and
+// reschedules it for the earliest retry, returning the fields it translated.
+func (p *translationPipeline) translate(ctx context.Context, n *entity.News) []string {
+	now := time.Now()
+	var next *time.Time
+	var changed []string
+	for _, key := range translationOrder {
+		state, ok := n.Translations[key]
+		if !ok || (state.Status != entity.TranslationPending && state.Status != entity.TranslationFailed) {
+			continue
+		}
+		if state.Status == entity.TranslationFailed {
+			if retryAt := state.UpdatedAt.Add(backoffFor(state.Attempts)); retryAt.After(now) {
+				next = earliest(next, retryAt)
+				continue
+			}
+		}
+		state.Attempts++
+		state.UpdatedAt = now
+		source, target := n.FieldValue(state.SourceField), n.FieldValue(key)
+		if source == nil || target == nil {
+			continue
+		}
+		tgtLang := entity.FieldLanguage(key)
+		translated, err := p.translator.Translate(ctx, *source, entity.FieldLanguage(state.SourceField), tgtLang)
+		if errors.Is(err, contract.ErrBudgetExceeded) {
+			// out of budget is not the field's fault; retry later without spending an attempt
+			state.Attempts--
+			next = earliest(next, now.Add(backoffFor(1)))
+			n.Translations[key] = state
+			continue
+		}
+		switch {
+		case err == nil && translated != "":
+			*target = translated
+			state.Status, state.LastError = entity.TranslationDone, ""
+			changed = append(changed, key)
+		case state.Attempts >= maxTranslationAttempts:
+			// keep the article readable; the status tells clients this is untranslated
+			*target = *source
+			state.Status = entity.TranslationMirrored
+			if err != nil {
+				state.LastError = err.Error()
+			}
+		default:
+			state.Status = entity.TranslationFailed
+			if err != nil {
+				state.LastError = err.Error()
+			}
+			next = earliest(next, now.Add(backoffFor(state.Attempts)))
+		}
+		n.Translations[key] = state
+	}
+	n.TranslationDueAt = next
+	n.UpdatedAt = now
+	return changed
+}
+
+func backoffFor(attempts int) time.Duration {
+	if attempts <= 0 {
+		return 0
+	}
+	if attempts > len(translationBackoff) {
+		return translationBackoff[len(translationBackoff)-1]
+	}
+	return translationBackoff[attempts-1]
+}
+
+func earliest(cur *time.Time, t time.Time) *time.Time {
+	if cur == nil || t.Before(*cur) {
+		return &t
+	}
+	return cur
+}
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -20,11 +21,11 @@ func NewsTranslatorUsecase(client contract.ITranslationClient, repo contract.INe
 	}
 }
 
-func (uc *TranslatorUsecase) Translate(text, sourceLang, targetLang string) (string, error) {
-	return uc.translatorClient.Translate(text, sourceLang, targetLang)
+func (uc *TranslatorUsecase) Translate(ctx context.Context, text, sourceLang, targetLang string) (string, error) {
+	return uc.translatorClient.Translate(ctx, text, sourceLang, targetLang)
 }
 
-func (uc *TranslatorUsecase) TranslateNews(news entity.News) (entity.News, error) {
+func (uc *TranslatorUsecase) TranslateNews(ctx context.Context, news entity.News) (entity.News, error) {
 	// Translate whichever summary exists to the other language.
 	var (
 		srcText string
@@ -32,12 +33,21 @@ func (uc *TranslatorUsecase) TranslateNews(news entity.News) (entity.News, error
 		tgtLang string
 	)
 
+	// A mirrored field holds a copy of the other language and counts as missing.
+	summaryEN, summaryAM := news.SummaryEN, news.SummaryAM
+	if news.Translations[entity.FieldSummaryEN].Status == entity.TranslationMirrored {
+		summaryEN = ""
+	}
+	if news.Translations[entity.FieldSummaryAM].Status == entity.TranslationMirrored {
+		summaryAM = ""
+	}
+
 	switch {
-	case news.SummaryEN != "" && news.SummaryAM == "":
-		srcText, srcLang, tgtLang = news.SummaryEN, "en", "am"
-	case news.SummaryAM != "" && news.SummaryEN == "":
-		srcText, srcLang, tgtLang = news.SummaryAM, "am", "en"
-	case news.SummaryEN != "" && news.SummaryAM != "":
+	case summaryEN != "" && summaryAM == "":
+		srcText, srcLang, tgtLang = summaryEN, "en", "am"
+	case summaryAM != "" && summaryEN == "":
+		srcText, srcLang, tgtLang = summaryAM, "am", "en"
+	case summaryEN != "" && summaryAM != "":
 		// Both summaries exist; nothing to do.
 		news.UpdatedAt = time.Now()
 		return news, nil
@@ -45,18 +55,25 @@ func (uc *TranslatorUsecase) TranslateNews(news entity.News) (entity.News, error
 		return news, errors.New("no summary available to translate")
 	}
 
-	translated, err := uc.translatorClient.Translate(srcText, srcLang, tgtLang)
+	translated, err := uc.translatorClient.Translate(ctx, srcText, srcLang, tgtLang)
 	if err != nil {
 		return news, err
 	}
 
+	field, sourceField := entity.FieldSummaryAM, entity.FieldSummaryEN
 	if tgtLang == "en" {
 		news.SummaryEN = translated
+		field, sourceField = entity.FieldSummaryEN, entity.FieldSummaryAM
 	} else {
 		news.SummaryAM = translated
 	}
 	news.UpdatedAt = time.Now()
-	if err := uc.newsRepo.Update(&news); err != nil {
+	if news.Translations == nil {
+		news.Translations = map[string]entity.FieldTranslation{}
+	}
+	state := news.Translations[field]
+	news.Translations[field] = entity.FieldTranslation{Status: entity.TranslationDone, SourceField: sourceField, Attempts: state.Attempts + 1, UpdatedAt: news.UpdatedAt}
+	if err := uc.newsRepo.UpdateFields(ctx, &news, []string{field, "translations." + field}, nil); err != nil {
 		return news, err
 	}
 
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/contract"
+	"github.com/RealEskalate/G6-NewsBrief/internal/domain/entity"
+)
+
+// usageGlobalKey is the budget bucket shared by every caller.
+const usageGlobalKey = "global"
+
+// maxUsageReportDays bounds the admin report period.
+const maxUsageReportDays = 92
+
+// UsageBudget holds the daily AI limits. A zero value disables that limit.
+// Tokens count Gemini tokens; calls count every metered request, including
+// embeddings and machine translations.
+type UsageBudget struct {
+	UserTokens      int64
+	UserCalls       int64
+	AnonymousTokens int64
+	AnonymousCalls  int64
+	GlobalTokens    int64
+	GlobalCalls     int64
+}
+
+type UsageUsecase struct {
+	repo    contract.IUsageRepository
+	uuidGen contract.IUUIDGenerator
+	budget  UsageBudget
+	logger  contract.IAppLogger
+}
+
+func NewUsageUsecase(repo contract.IUsageRepository, uuidGen contract.IUUIDGenerator, budget UsageBudget, logger contract.IAppLogger) contract.IUsageUsecase {
+	return &UsageUsecase{repo: repo, uuidGen: uuidGen, budget: budget, logger: logger}
+}
+
+// Allow checks today's counters of the service and of the actor in ctx.
+// Background jobs are only subject to the global budget. When the counters
+// cannot be read the call is allowed: an accounting outage must not take the
+// AI features down.
+func (u *UsageUsecase) Allow(ctx context.Context) error {
+	actor := contract.UsageActorFrom(ctx)
+	keys := []string{usageGlobalKey}
+	if key := actor.Key(); key != "" {
+		keys = append(keys, key)
+	}
+	lookupCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+	counters, err := u.repo.GetDaily(lookupCtx, usageDay(time.Now()), keys)
+	if err != nil {
+		if u.logger != nil {
+			u.logger.Errorf("usage budget lookup failed: %v", err)
+		}
+		return nil
+	}
+	if overBudget(counters[usageGlobalKey], u.budget.GlobalTokens, u.budget.GlobalCalls) {
+		return contract.ErrBudgetExceeded
+	}
+	switch {
+	case actor.Job != "":
+		return nil
+	case actor.UserID != "":
+		if overBudget(counters[actor.Key()], u.budget.UserTokens, u.budget.UserCalls) {
+			return contract.ErrBudgetExceeded
+		}
+	case actor.ClientIP != "":
+		if overBudget(counters[actor.Key()], u.budget.AnonymousTokens, u.budget.AnonymousCalls) {
+			return contract.ErrBudgetExceeded
+		}
+	}
+	return nil
+}
+
+func overBudget(c entity.UsageCounter, tokens, calls int64) bool {
+	return (tokens > 0 && c.Tokens >= tokens) || (calls > 0 && c.Calls >= calls)
+}
+
+// Record stores the call and adds it to the daily counters. It runs on its own
+// context so calls cancelled by the client are still accounted for.
+func (u *UsageUsecase) Record(ctx context.Context, usage *entity.LLMUsage) {
+	actor := contract.UsageActorFrom(ctx)
+	now := time.Now()
+	usage.ID = u.uuidGen.NewUUID()
+	usage.UserID, usage.ClientIP, usage.Job = actor.UserID, actor.ClientIP, actor.Job
+	usage.Day = usageDay(now)
+	usage.CreatedAt = now.UTC()
+
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := u.repo.Save(saveCtx, usage); err != nil && u.logger != nil {
+		u.logger.Errorf("usage record failed: %v", err)
+	}
+	keys := []string{usageGlobalKey}
+	if key := actor.Key(); key != "" {
+		keys = append(keys, key)
+	}
+	if err := u.repo.IncrementDaily(saveCtx, usage.Day, keys, 1, int64(usage.TotalTokens)); err != nil && u.logger != nil {
+		u.logger.Errorf("usage counter update failed: %v", err)
+	}
+}
+
+// Report summarizes usage by day and operation between from and to (inclusive).
+func (u *UsageUsecase) Report(ctx context.Context, from, to time.Time) (entity.UsageReport, error) {
+	if to.Before(from) {
+		from, to = to, from
+	}
+	if to.Sub(from) > maxUsageReportDays*24*time.Hour {
+		from = to.AddDate(0, 0, -maxUsageReportDays)
+	}
+	report := entity.UsageReport{From: usageDay(from), To: usageDay(to), DailyTokenCap: u.budget.GlobalTokens}
+	rows, err := u.repo.Report(ctx, report.From, report.To)
+	if err != nil {
+		return entity.UsageReport{}, err
+	}
+	consumers, err := u.repo.TopConsumers(ctx, report.From, report.To, 10)
+	if err != nil {
+		return entity.UsageReport{}, err
+	}
+	report.Rows, report.TopConsumers = rows, consumers
+	for _, r := range rows {
+		report.TotalCalls += r.Calls
+		report.TotalTokens += r.TotalTokens
+	}
+	today := usageDay(time.Now())
+	counters, err := u.repo.GetDaily(ctx, today, []string{usageGlobalKey})
+	if err != nil {
+		return entity.UsageReport{}, err
+	}
+	report.Today = counters[usageGlobalKey]
+	report.Today.Key, report.Today.Day = usageGlobalKey, today
+	return report, nil
+}
+
+// usageDay returns the UTC day bucket of t.
+func usageDay(t time.Time) string {
+	return t.UTC().Format(entity.UsageDayLayout)
+}
@@ -464,6 +464,32 @@ func (uc *UserUsecase) PromoteUser(ctx context.Context, userID string) (*entity.
 	return user, nil
 }
 
+// SetRole assigns a role to a user, e.g. to grant editorial access.
+func (uc *UserUsecase) SetRole(ctx context.Context, userID string, role entity.UserRole) (*entity.User, error) {
+	switch role {
+	case entity.UserRoleUser, entity.UserRoleEditor, entity.UserRoleAdmin:
+	default:
+		return nil, errors.New("invalid role")
+	}
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err.Error() == errUserNotFound {
+			return nil, errors.New("user not found")
+		}
+		uc.logger.Errorf("failed to retrieve user for role change: %v", err)
+		return nil, errors.New(errInternalServer)
+	}
+	if user.Role == role {
+		return user, nil
+	}
+	user.Role = role
+	if _, err := uc.userRepo.UpdateUser(ctx, user); err != nil {
+		uc.logger.Errorf("failed to set role of user %s: %v", userID, err)
+		return nil, errors.New("failed to update user role")
+	}
+	return user, nil
+}
+
 // DemoteUser demotes an Admin back to a regular user (member).
 func (uc *UserUsecase) DemoteUser(ctx context.Context, userID string) (*entity.User, error) {
 	user, err := uc.userRepo.GetUserByID(ctx, userID)
@@ -1,11 +1,11 @@
-from fastapi import APIRouter, HTTPException, Depends
+from fastapi import APIRouter, HTTPException, Depends, Query
 import chromadb
 from app.services.crawler import crawl_news
 from app.services.news_api import fetch_news_api
 from app.services.telegram import fetch_telegram
 from app.services.cleaner import clean_news_data
 from app.services.brief_generator import generate_brief
-from app.services.vector_db import VectorDBService
+from app.services.vector_db import VectorDBService, parse_iso
 from app.models.news import CrawlRequest, NewsAPIRequest, TelegramRequest, CleanedNews, VectorSearchQuery
 from app.dependencies import get_vector_db
 from typing import List, Dict, Optional
@@ -64,10 +64,24 @@ async def get_brief(query: str, top_k: int = 5, vector_db: chromadb.Client = Dep
         raise HTTPException(status_code=500, detail=str(e))
 
 @router.get("/stored", response_model=List[Dict])
-async def get_stored_articles(limit: int = 10, vector_db: chromadb.Client = Depends(get_vector_db)):
-    """Retrieve stored articles from ChromaDB."""
+async def get_stored_articles(
+    limit: int = Query(10, ge=1, le=500),
+    offset: int = Query(0, ge=0),
+    since: Optional[str] = None,
+    until: Optional[str] = None,
+    q: str = "",
+    vector_db: chromadb.Client = Depends(get_vector_db)
+):
+    """Retrieve a page of stored articles from ChromaDB, newest published first.
+
+    since (inclusive) and until (exclusive) are ISO 8601 timestamps; q keeps
+    articles whose title or text contains it.
+    """
+    since_dt, until_dt = parse_iso(since) if since else None, parse_iso(until) if until else None
+    if (since and not since_dt) or (until and not until_dt):
+        raise HTTPException(status_code=400, detail="since and until must be ISO 8601 timestamps")
     try:
-        articles = VectorDBService(vector_db).get_articles(limit)
+        articles = VectorDBService(vector_db).get_articles(limit, offset, since_dt, until_dt, q.strip())
         return articles
     except Exception as e:
         raise HTTPException(status_code=500, detail=str(e))
\ No newline at end of file
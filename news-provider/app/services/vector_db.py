@@ -1,19 +1,51 @@
 from app.services.lang_detector import detect_language
 import chromadb
-from typing import List, Dict
-from datetime import datetime
+from typing import List, Dict, Optional
+from datetime import datetime, timezone
 import logging
 
 logging.basicConfig(level=logging.INFO, format="%(asctime)s - %(levelname)s - %(message)s")
 logger = logging.getLogger(__name__)
 
+EPOCH = datetime(1970, 1, 1, tzinfo=timezone.utc)
+
+
+def parse_iso(value: str) -> Optional[datetime]:
+    """Parse an ISO 8601 timestamp; naive times are taken as UTC."""
+    try:
+        parsed = datetime.fromisoformat(value.replace("Z", "+00:00"))
+    except (AttributeError, ValueError):
+        return None
+    if parsed.tzinfo is None:
+        parsed = parsed.replace(tzinfo=timezone.utc)
+    return parsed
+
+
+def article_date(article: Dict) -> datetime:
+    """The published date of an article, else its crawl time."""
+    return parse_iso(article["published_date"]) or parse_iso(article["crawl_timestamp"]) or EPOCH
+
+
 class VectorDBService:
     def __init__(self, client: chromadb.Client):
         self.client = client
         self.collection = client.get_or_create_collection("news_articles")
 
-    def get_articles(self, limit: int = 10) -> List[Dict]:
-        """Retrieve articles from ChromaDB, sorted by crawl_timestamp (newest first)."""
+    def get_articles(
+        self,
+        limit: int = 10,
+        offset: int = 0,
+        since: Optional[datetime] = None,
+        until: Optional[datetime] = None,
+        query: str = "",
+    ) -> List[Dict]:
+        """Retrieve a page of articles from ChromaDB, newest published first.
+
+        Articles without a usable published date are dated by their crawl time.
+        Ties are broken by ID so pages are stable across requests. since is
+        inclusive and until exclusive; query keeps articles whose title or text
+        contains it (case-insensitive).
+        """
         try:
             # get all docs (ids come for free)
             results = self.collection.get(include=["metadatas", "documents"])
@@ -33,23 +65,24 @@ class VectorDBService:
                     "lang": meta.get("lang", detect_language(results["documents"][i]))
                 })
 
-            # ✅ Sort by crawl_timestamp descending (latest first)
-            articles.sort(
-                key=lambda x: datetime.fromisoformat(
-                    x["crawl_timestamp"].replace("Z", "+00:00")
-                ),
-                reverse=True
-            )
+            dated = [(article_date(a), a) for a in articles]
+            if since:
+                dated = [(d, a) for d, a in dated if d >= since]
+            if until:
+                dated = [(d, a) for d, a in dated if d < until]
+            if query:
+                needle = query.lower()
+                dated = [(d, a) for d, a in dated if needle in a["title"].lower() or needle in a["text"].lower()]
 
-            # ✅ Apply limit after sorting
-            articles = articles[:limit]
+            dated.sort(key=lambda x: (x[0], x[1]["id"]), reverse=True)
+            articles = [a for _, a in dated[offset:offset + limit]]
 
-            logger.info(f"Retrieved {len(articles)} articles (sorted by crawl_timestamp DESC) from ChromaDB")
+            logger.info(f"Retrieved {len(articles)} articles (offset {offset}, sorted by published_date DESC) from ChromaDB")
             return articles
         except Exception as e:
             logger.error(f"Failed to retrieve articles: {e}")
             return []
-        
+
     def add_article(self, article: Dict):
         """Add a single article to ChromaDB."""
         try: